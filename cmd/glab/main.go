@@ -57,6 +57,14 @@ func main() {
 		os.Exit(2)
 	}
 
+	// GLAB_PROFILE is held to the same rules as the --profile flag
+	if profile := os.Getenv("GLAB_PROFILE"); profile != "" {
+		if err := config.ValidateProfileName(profile); err != nil {
+			fmt.Fprintf(os.Stderr, "invalid GLAB_PROFILE: %s\n", err)
+			os.Exit(2)
+		}
+	}
+
 	// Set Debug mode from config if not previously set by debugMode
 	debug := debugMode == "true" || debugMode == "1"
 	if !debug {
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"errors"
+	"strings"
+)
+
+// GraphQLErrors is the part of GraphQL responses shared by all queries, to embed in the structs
+// of the responses. Errors in the query are returned with a successful HTTP status, so they must
+// be checked in the response.
+type GraphQLErrors struct {
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// Err returns the first error of the query, or nil when there is none.
+func (e GraphQLErrors) Err() error {
+	if len(e.Errors) > 0 {
+		return errors.New(e.Errors[0].Message)
+	}
+	return nil
+}
+
+// MutationErr returns the errors in the payload of a mutation, like a validation error of the
+// input, or nil when there are none.
+func MutationErr(errs []string) error {
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, ", "))
+	}
+	return nil
+}
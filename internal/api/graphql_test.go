@@ -0,0 +1,30 @@
+//go:build !integration
+
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQLErrors(t *testing.T) {
+	var resp struct {
+		Data struct {
+			Project *struct{} `json:"project"`
+		} `json:"data"`
+		GraphQLErrors
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"data": {"project": null}, "errors": [{"message": "boom"}, {"message": "bang"}]}`), &resp))
+	require.EqualError(t, resp.Err(), "boom")
+
+	resp.Errors = nil
+	assert.NoError(t, resp.Err())
+}
+
+func TestMutationErr(t *testing.T) {
+	require.EqualError(t, MutationErr([]string{"Title can't be blank", "Weight is invalid"}), "Title can't be blank, Weight is invalid")
+	assert.NoError(t, MutationErr(nil))
+}
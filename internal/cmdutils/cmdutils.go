@@ -61,8 +61,7 @@ var listProjectMembers = func(client *gitlab.Client, projectID any, opts *gitlab
 // LoadGitLabTemplate finds and loads the GitLab template from the working git directory
 // Follows the format officially supported by GitLab
 // https://docs.gitlab.com/user/project/description_templates/#set-a-default-template-for-merge-requests-and-issues.
-//
-// TODO: load from remote repository if repo is overridden by -R flag
+// Use TemplateSource to load templates of a repository that isn't checked out.
 func LoadGitLabTemplate(tmplType, tmplName string) (string, error) {
 	wdir, err := git.ToplevelDir()
 	if err != nil {
@@ -0,0 +1,64 @@
+package cmdutils
+
+import (
+	"fmt"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+// GroupOrRepoClient returns the client for the host of the base repository, and the base
+// repository. When a group is given, the command can also run outside of a repository: the
+// client is then for the default host, and the repository is nil.
+func GroupOrRepoClient(apiClient func(repoHost string) (*api.Client, error), baseRepo func() (glrepo.Interface, error), group string) (*gitlab.Client, glrepo.Interface, error) {
+	repo, err := baseRepo()
+	if err != nil && group == "" {
+		return nil, nil, err
+	}
+
+	var repoHost string
+	if repo != nil {
+		repoHost = repo.RepoHost()
+	}
+	c, err := apiClient(repoHost)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.Lab(), repo, nil
+}
+
+// GroupFromFlags returns the client like GroupOrRepoClient, and the group of a command with a
+// --group flag: the given group, or the group of the base repository.
+func GroupFromFlags(apiClient func(repoHost string) (*api.Client, error), baseRepo func() (glrepo.Interface, error), group string) (*gitlab.Client, string, error) {
+	client, repo, err := GroupOrRepoClient(apiClient, baseRepo, group)
+	if err != nil {
+		return nil, "", err
+	}
+	if group != "" {
+		return client, group, nil
+	}
+
+	group, err = ProjectGroup(client, repo.FullName())
+	if err != nil {
+		return nil, "", err
+	}
+	if group == "" {
+		return nil, "", fmt.Errorf("project %s is not in a group. Use --group to select a group.", repo.FullName())
+	}
+	return client, group, nil
+}
+
+// ProjectGroup returns the full path of the group of the project, or "" when the project is in
+// the personal namespace of a user.
+func ProjectGroup(client *gitlab.Client, project string) (string, error) {
+	p, err := api.GetProject(client, project)
+	if err != nil {
+		return "", err
+	}
+	if p.Namespace == nil || p.Namespace.Kind != api.NamespaceKindGroup {
+		return "", nil
+	}
+	return p.Namespace.FullPath, nil
+}
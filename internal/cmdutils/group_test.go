@@ -0,0 +1,80 @@
+//go:build !integration
+
+package cmdutils
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+func TestGroupFromFlags(t *testing.T) {
+	noRepo := func() (glrepo.Interface, error) { return nil, errors.New("not a git repository") }
+	inRepo := func() (glrepo.Interface, error) {
+		return glrepo.NewWithHost("OWNER", "REPO", "gitlab.example.com"), nil
+	}
+
+	setup := func(t *testing.T) (*gitlabtesting.TestClient, func(string) (*api.Client, error), *string) {
+		tc := gitlabtesting.NewTestClient(t)
+		var gotHost string
+		apiClient := func(repoHost string) (*api.Client, error) {
+			gotHost = repoHost
+			return api.NewClient(
+				func(*http.Client) (gitlab.AuthSource, error) {
+					return gitlab.AccessTokenAuthSource{Token: "test-token"}, nil
+				},
+				api.WithGitLabClient(tc.Client),
+			)
+		}
+		return tc, apiClient, &gotHost
+	}
+
+	t.Run("given group outside of a repository", func(t *testing.T) {
+		_, apiClient, gotHost := setup(t)
+
+		client, group, err := GroupFromFlags(apiClient, noRepo, "plan")
+		require.NoError(t, err)
+		assert.NotNil(t, client)
+		assert.Equal(t, "plan", group)
+		assert.Empty(t, *gotHost)
+	})
+
+	t.Run("no group outside of a repository", func(t *testing.T) {
+		_, apiClient, _ := setup(t)
+
+		_, _, err := GroupFromFlags(apiClient, noRepo, "")
+		require.EqualError(t, err, "not a git repository")
+	})
+
+	t.Run("group of the repository", func(t *testing.T) {
+		tc, apiClient, gotHost := setup(t)
+		tc.MockProjects.EXPECT().
+			GetProject("OWNER/REPO", gomock.Any()).
+			Return(&gitlab.Project{PathWithNamespace: "OWNER/REPO", Namespace: &gitlab.ProjectNamespace{Kind: "group", FullPath: "plan/team"}}, nil, nil)
+
+		_, group, err := GroupFromFlags(apiClient, inRepo, "")
+		require.NoError(t, err)
+		assert.Equal(t, "plan/team", group)
+		assert.Equal(t, "gitlab.example.com", *gotHost)
+	})
+
+	t.Run("repository of a user", func(t *testing.T) {
+		tc, apiClient, _ := setup(t)
+		tc.MockProjects.EXPECT().
+			GetProject("OWNER/REPO", gomock.Any()).
+			Return(&gitlab.Project{PathWithNamespace: "OWNER/REPO", Namespace: &gitlab.ProjectNamespace{Kind: "user", FullPath: "OWNER"}}, nil, nil)
+
+		_, _, err := GroupFromFlags(apiClient, inRepo, "")
+		require.EqualError(t, err, "project OWNER/REPO is not in a group. Use --group to select a group.")
+	})
+}
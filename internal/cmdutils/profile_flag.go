@@ -0,0 +1,38 @@
+package cmdutils
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+type profileValue struct {
+	value string
+}
+
+func (p *profileValue) Type() string {
+	return "string"
+}
+
+func (p *profileValue) String() string {
+	return p.value
+}
+
+func (p *profileValue) Set(v string) error {
+	v = strings.TrimSpace(v)
+	if err := config.ValidateProfileName(v); err != nil {
+		return err
+	}
+	p.value = v
+	config.SetProfileOverride(v)
+	return nil
+}
+
+// AddGlobalProfileFlag adds the --profile flag to the command and all of its children.
+// The flag is applied while parsing, so it takes effect before any hooks run
+// and regardless of which subcommand overrides PersistentPreRunE.
+func AddGlobalProfileFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().Var(&profileValue{}, "profile", "Use the named authentication profile for this command. Overrides GLAB_PROFILE.")
+}
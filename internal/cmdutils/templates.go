@@ -0,0 +1,212 @@
+package cmdutils
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+// templateAPITypes maps the template directories of a repository to the template types of the project templates API.
+var templateAPITypes = map[string]string{
+	IssueTemplate:        "issues",
+	MergeRequestTemplate: "merge_requests",
+}
+
+// TemplateSource lists and loads the description templates of a project. Templates are read
+// from the local checkout if it is a clone of the project, and through the project templates API otherwise.
+type TemplateSource struct {
+	Client *gitlab.Client
+	Repo   glrepo.Interface
+	Local  bool
+}
+
+// NewTemplateSource returns a template source for the repository. The local checkout is used
+// only if one of its remotes points to the repository, so templates of the right project are
+// used when the repository is overridden with --repo.
+func NewTemplateSource(client *gitlab.Client, repo glrepo.Interface, remotes func() (glrepo.Remotes, error)) *TemplateSource {
+	source := &TemplateSource{Client: client, Repo: repo}
+
+	if rs, err := remotes(); err == nil {
+		if r, err := rs.FindByRepo(repo.RepoOwner(), repo.RepoName()); err == nil && glrepo.IsSame(r.Repo, repo) {
+			source.Local = true
+		}
+	}
+
+	return source
+}
+
+// List returns the names of the templates of the type.
+func (s *TemplateSource) List(tmplType string) ([]string, error) {
+	if s.Local {
+		names, err := ListGitLabTemplates(tmplType)
+		if err != nil || len(names) > 0 {
+			return names, err
+		}
+	}
+
+	var names []string
+	opts := &gitlab.ListProjectTemplatesOptions{ListOptions: gitlab.ListOptions{PerPage: 100}}
+	for {
+		templates, resp, err := s.Client.ProjectTemplates.ListTemplates(s.Repo.FullName(), templateAPITypes[tmplType], opts)
+		if err != nil {
+			return nil, fmt.Errorf("error listing templates: %w", err)
+		}
+
+		for _, t := range templates {
+			names = append(names, t.Name)
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+
+	slices.Sort(names)
+	return names, nil
+}
+
+// Load returns the contents of the template.
+func (s *TemplateSource) Load(tmplType, name string) (string, error) {
+	if s.Local {
+		contents, err := LoadGitLabTemplate(tmplType, name)
+		if err != nil || contents != "" {
+			return contents, err
+		}
+	}
+
+	name = strings.TrimSuffix(name, ".md")
+	template, resp, err := s.Client.ProjectTemplates.GetProjectTemplate(s.Repo.FullName(), templateAPITypes[tmplType], name)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "", fmt.Errorf("template %q not found.", name)
+		}
+		return "", fmt.Errorf("error getting template %q: %w", name, err)
+	}
+
+	return strings.TrimSpace(template.Content), nil
+}
+
+// QuickActions are the quick actions of a description that glab applies when creating an issue or merge request.
+type QuickActions struct {
+	Labels       []string
+	Assignees    []string
+	Reviewers    []string
+	Milestone    string
+	Weight       *int64
+	Confidential bool
+}
+
+// QuickActionTarget is the kind of item that a description is for, which decides the quick
+// actions that glab applies.
+type QuickActionTarget int
+
+const (
+	QuickActionsForIssue QuickActionTarget = iota
+	QuickActionsForMergeRequest
+)
+
+// ExtractQuickActions removes the /label, /assign, and /milestone quick actions from the
+// description, and returns them. For issues, /weight and /confidential are also removed, and
+// for merge requests /assign_reviewer. Other quick actions, and quick actions in code blocks,
+// are left in the description for GitLab to apply.
+func ExtractQuickActions(description string, target QuickActionTarget) (string, QuickActions) {
+	var actions QuickActions
+	var lines []string
+	inCode := false
+
+	for line := range strings.SplitSeq(description, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+		}
+		if inCode || !strings.HasPrefix(trimmed, "/") {
+			lines = append(lines, line)
+			continue
+		}
+
+		command, args, _ := strings.Cut(trimmed[1:], " ")
+		values := quickActionArgs(args)
+
+		switch command {
+		case "label", "labels":
+			actions.Labels = append(actions.Labels, trimPrefixes(values, "~")...)
+		case "assign":
+			if slices.Contains(values, "me") {
+				// GitLab resolves "me" to the author
+				lines = append(lines, line)
+				continue
+			}
+			actions.Assignees = append(actions.Assignees, trimPrefixes(values, "@")...)
+		case "assign_reviewer", "reviewer", "request_review":
+			if target != QuickActionsForMergeRequest {
+				lines = append(lines, line)
+				continue
+			}
+			actions.Reviewers = append(actions.Reviewers, trimPrefixes(values, "@")...)
+		case "milestone":
+			if len(values) == 0 {
+				lines = append(lines, line)
+				continue
+			}
+			actions.Milestone = strings.TrimPrefix(values[0], "%")
+		case "weight":
+			weight, err := strconv.ParseInt(strings.TrimSpace(args), 10, 64)
+			if err != nil || target != QuickActionsForIssue {
+				lines = append(lines, line)
+				continue
+			}
+			actions.Weight = &weight
+		case "confidential":
+			if target != QuickActionsForIssue {
+				lines = append(lines, line)
+				continue
+			}
+			actions.Confidential = true
+		default:
+			lines = append(lines, line)
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n")), actions
+}
+
+// quickActionArgs splits the arguments of a quick action on spaces, keeping quoted values like ~"needs review" together.
+func quickActionArgs(args string) []string {
+	var values []string
+	var current strings.Builder
+	quoted := false
+
+	for _, r := range args {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case (r == ' ' || r == ',') && !quoted:
+			if current.Len() > 0 {
+				values = append(values, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		values = append(values, current.String())
+	}
+
+	return values
+}
+
+func trimPrefixes(values []string, prefix string) []string {
+	trimmed := make([]string, len(values))
+	for i, v := range values {
+		trimmed[i] = strings.TrimPrefix(v, prefix)
+	}
+	return trimmed
+}
@@ -0,0 +1,117 @@
+//go:build !integration
+
+package cmdutils
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+func TestNewTemplateSource(t *testing.T) {
+	repo := glrepo.New("OWNER", "REPO", glinstance.DefaultHostname)
+	remotes := func() (glrepo.Remotes, error) {
+		return glrepo.Remotes{{Remote: &git.Remote{Name: "origin"}, Repo: repo}}, nil
+	}
+
+	assert.True(t, NewTemplateSource(nil, repo, remotes).Local)
+	assert.False(t, NewTemplateSource(nil, glrepo.New("OTHER", "REPO", glinstance.DefaultHostname), remotes).Local)
+}
+
+func TestTemplateSource(t *testing.T) {
+	toplevelDir := git.ToplevelDir
+	git.ToplevelDir = func() (string, error) { return "../../test/testdata", nil }
+	t.Cleanup(func() { git.ToplevelDir = toplevelDir })
+
+	repo := glrepo.New("OWNER", "REPO", glinstance.DefaultHostname)
+
+	t.Run("local checkout", func(t *testing.T) {
+		source := &TemplateSource{Repo: repo, Local: true}
+
+		names, err := source.List(IssueTemplate)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Bug", "Feature Request"}, names)
+
+		contents, err := source.Load(IssueTemplate, "Bug")
+		require.NoError(t, err)
+		assert.NotEmpty(t, contents)
+	})
+
+	t.Run("project templates API", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		source := &TemplateSource{Client: tc.Client, Repo: repo}
+
+		tc.MockProjectTemplates.EXPECT().
+			ListTemplates("OWNER/REPO", "issues", gomock.Any()).
+			Return([]*gitlab.ProjectTemplate{{Name: "Incident"}, {Name: "Bug"}}, &gitlab.Response{}, nil)
+		tc.MockProjectTemplates.EXPECT().
+			GetProjectTemplate("OWNER/REPO", "issues", "Bug").
+			Return(&gitlab.ProjectTemplate{Name: "Bug", Content: "## Summary\n"}, &gitlab.Response{}, nil)
+		tc.MockProjectTemplates.EXPECT().
+			GetProjectTemplate("OWNER/REPO", "issues", "Missing").
+			Return(nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, gitlab.ErrNotFound)
+
+		names, err := source.List(IssueTemplate)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Bug", "Incident"}, names)
+
+		contents, err := source.Load(IssueTemplate, "Bug.md")
+		require.NoError(t, err)
+		assert.Equal(t, "## Summary", contents)
+
+		_, err = source.Load(IssueTemplate, "Missing")
+		assert.EqualError(t, err, `template "Missing" not found.`)
+	})
+}
+
+func TestExtractQuickActions(t *testing.T) {
+	description := `## Summary
+
+/label ~bug ~"needs triage"
+/assign @alice, @bob
+/assign_reviewer @carol
+/milestone %"Release 1.0"
+/weight 3
+/confidential
+/assign me
+/due tomorrow
+
+` + "```" + `
+/label ~example
+` + "```"
+
+	t.Run("issue", func(t *testing.T) {
+		got, actions := ExtractQuickActions(description, QuickActionsForIssue)
+
+		assert.Equal(t, "## Summary\n\n/assign_reviewer @carol\n/assign me\n/due tomorrow\n\n```\n/label ~example\n```", got)
+		assert.Equal(t, QuickActions{
+			Labels:       []string{"bug", "needs triage"},
+			Assignees:    []string{"alice", "bob"},
+			Milestone:    "Release 1.0",
+			Weight:       gitlab.Ptr(int64(3)),
+			Confidential: true,
+		}, actions)
+	})
+
+	t.Run("merge request", func(t *testing.T) {
+		got, actions := ExtractQuickActions(description, QuickActionsForMergeRequest)
+
+		assert.Equal(t, "## Summary\n\n/weight 3\n/confidential\n/assign me\n/due tomorrow\n\n```\n/label ~example\n```", got)
+		assert.Equal(t, QuickActions{
+			Labels:    []string{"bug", "needs triage"},
+			Assignees: []string{"alice", "bob"},
+			Reviewers: []string{"carol"},
+			Milestone: "Release 1.0",
+		}, actions)
+	})
+}
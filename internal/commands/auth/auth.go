@@ -8,7 +8,10 @@ import (
 	cmdGenerate "gitlab.com/gitlab-org/cli/internal/commands/auth/generate"
 	authLoginCmd "gitlab.com/gitlab-org/cli/internal/commands/auth/login"
 	authLogoutCmd "gitlab.com/gitlab-org/cli/internal/commands/auth/logout"
+	authRefreshCmd "gitlab.com/gitlab-org/cli/internal/commands/auth/refresh"
+	authSetupGitCmd "gitlab.com/gitlab-org/cli/internal/commands/auth/setupgit"
 	authStatusCmd "gitlab.com/gitlab-org/cli/internal/commands/auth/status"
+	authSwitchCmd "gitlab.com/gitlab-org/cli/internal/commands/auth/switch"
 )
 
 func NewCmdAuth(f cmdutils.Factory) *cobra.Command {
@@ -20,8 +23,11 @@ func NewCmdAuth(f cmdutils.Factory) *cobra.Command {
 	cmd.AddCommand(authLoginCmd.NewCmdLogin(f))
 	cmd.AddCommand(authStatusCmd.NewCmdStatus(f, nil))
 	cmd.AddCommand(authLoginCmd.NewCmdCredential(f))
+	cmd.AddCommand(authSetupGitCmd.NewCmdSetupGit(f))
 	cmd.AddCommand(cmdGenerate.NewCmdGenerate(f))
 	cmd.AddCommand(authLogoutCmd.NewCmdLogout(f))
+	cmd.AddCommand(authSwitchCmd.NewCmdSwitch(f))
+	cmd.AddCommand(authRefreshCmd.NewCmdRefresh(f))
 	cmd.AddCommand(authDockerCredentialHelperCmd.NewCmdConfigureDocker(f))
 	cmd.AddCommand(authDockerCredentialHelperCmd.NewCmdCredentialHelper(f))
 
@@ -51,19 +51,7 @@ func (gc *GitCredentialFlow) Setup(hostname, protocol, username, authToken strin
 
 func (gc *GitCredentialFlow) gitCredentialSetup(hostname, protocol, username, password string) error {
 	if gc.helper == "" {
-		// first use a blank value to indicate to git we want to sever the chain of credential helpers
-		preConfigureCmd := git.GitCommand("config", "--global", gitCredentialHelperKey(hostname, protocol), "")
-		if err := run.PrepareCmd(preConfigureCmd).Run(); err != nil {
-			return err
-		}
-
-		// use glab as a credential helper (for this host only)
-		configureCmd := git.GitCommand(
-			"config", "--global", "--add",
-			gitCredentialHelperKey(hostname, protocol),
-			fmt.Sprintf("!%s auth git-credential", shellQuote(gc.Executable)),
-		)
-		return run.PrepareCmd(configureCmd).Run()
+		return ConfigureCredentialHelper(gc.Executable, hostname, protocol)
 	}
 
 	// clear previous cached credentials
@@ -96,6 +84,24 @@ func (gc *GitCredentialFlow) gitCredentialSetup(hostname, protocol, username, pa
 	return nil
 }
 
+// ConfigureCredentialHelper registers glab as the only Git credential helper
+// for the given host and protocol in the global Git configuration.
+func ConfigureCredentialHelper(executable, hostname, protocol string) error {
+	// first use a blank value to indicate to git we want to sever the chain of credential helpers
+	preConfigureCmd := git.GitCommand("config", "--global", "--replace-all", gitCredentialHelperKey(hostname, protocol), "")
+	if err := run.PrepareCmd(preConfigureCmd).Run(); err != nil {
+		return err
+	}
+
+	// use glab as a credential helper (for this host only)
+	configureCmd := git.GitCommand(
+		"config", "--global", "--add",
+		gitCredentialHelperKey(hostname, protocol),
+		fmt.Sprintf("!%s auth git-credential", shellQuote(executable)),
+	)
+	return run.PrepareCmd(configureCmd).Run()
+}
+
 func gitCredentialHelperKey(hostname, protocol string) string {
 	return fmt.Sprintf("credential.%s://%s.helper", protocol, hostname)
 }
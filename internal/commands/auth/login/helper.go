@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/spf13/cobra"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
@@ -37,10 +38,26 @@ func NewCmdCredential(f cmdutils.Factory) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:    "git-credential",
-		Args:   cobra.ExactArgs(1),
-		Short:  "Implements Git credential helper manager.",
-		Hidden: true,
+		Use:   "git-credential <get|store|erase>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Implements the Git credential helper protocol.",
+		Long: heredoc.Docf(`
+			Implements the Git credential helper protocol for Git operations over HTTPS.
+
+			Git calls this command with the %[1]sget%[1]s operation to retrieve the credentials
+			of a GitLab instance. The credentials are read from the token or OAuth 2.0 session
+			stored by %[1]sglab auth login%[1]s for the active profile. OAuth 2.0 tokens are refreshed when needed.
+
+			The %[1]sstore%[1]s and %[1]serase%[1]s operations are accepted but do not change anything,
+			because glab's configuration is the source of truth for the credentials.
+			Use %[1]sglab auth login%[1]s and %[1]sglab auth logout%[1]s to manage them.
+
+			Run %[1]sglab auth setup-git%[1]s to register this command as the credential helper
+			of your configured GitLab instances.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ printf "protocol=https\nhost=gitlab.com\n" | glab auth git-credential get
+		`),
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
 		},
@@ -63,14 +80,36 @@ func (o *options) complete(args []string) {
 }
 
 func (o *options) validate() error {
-	if o.operation != "get" {
+	switch o.operation {
+	case "get", "store", "erase":
+		return nil
+	default:
 		// Ignore unsupported operation
 		return cmdutils.SilentError
 	}
-	return nil
 }
 
 func (o *options) run() error {
+	expectedParams, err := o.readCredentialParams()
+	if err != nil {
+		return err
+	}
+
+	// glab's configuration is the source of truth for credentials, so there is nothing to store or erase.
+	// Git still expects the helper to consume the input and exit successfully.
+	if o.operation != "get" {
+		return nil
+	}
+
+	if expectedParams["protocol"] != "https" && expectedParams["protocol"] != "http" {
+		return cmdutils.SilentError
+	}
+
+	return o.getCredentials(expectedParams)
+}
+
+// readCredentialParams reads the attributes that Git passes on standard input.
+func (o *options) readCredentialParams() (map[string]string, error) {
 	expectedParams := map[string]string{}
 
 	s := bufio.NewScanner(o.io.In)
@@ -87,7 +126,7 @@ func (o *options) run() error {
 		if key == "url" {
 			u, err := url.Parse(value)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			expectedParams["protocol"] = u.Scheme
 			expectedParams["host"] = u.Host
@@ -99,13 +138,13 @@ func (o *options) run() error {
 		}
 	}
 	if err := s.Err(); err != nil {
-		return err
+		return nil, err
 	}
 
-	if expectedParams["protocol"] != "https" && expectedParams["protocol"] != "http" {
-		return cmdutils.SilentError
-	}
+	return expectedParams, nil
+}
 
+func (o *options) getCredentials(expectedParams map[string]string) error {
 	cfg := o.config()
 
 	output := map[string]string{}
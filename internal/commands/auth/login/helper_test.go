@@ -358,7 +358,7 @@ func Test_helperRun(t *testing.T) {
 				host=example.com
 			`),
 			wantErr:         false,
-			wantValidateErr: true,
+			wantValidateErr: false,
 			wantStdout:      nil,
 			wantStderr:      "",
 		},
@@ -381,7 +381,7 @@ func Test_helperRun(t *testing.T) {
 				host=example.com
 			`),
 			wantErr:         false,
-			wantValidateErr: true,
+			wantValidateErr: false,
 			wantStdout:      nil,
 			wantStderr:      "",
 		},
@@ -436,6 +436,10 @@ func Test_helperRun(t *testing.T) {
 				assert.NoError(t, runErr)
 			}
 
+			if tt.wantStdout == nil && !tt.wantErr {
+				assert.Empty(t, stdout.String())
+			}
+
 			if tt.wantStdout != nil {
 				stdout := stdout.String()
 				assert.Truef(t, strings.HasPrefix(stdout, "capability[]=authtype\n"), "first line of stdout must always be the capability preamble")
@@ -90,6 +90,9 @@ func NewCmdLogin(f cmdutils.Factory) *cobra.Command {
 
 			# Non-interactive CI/CD setup
 			$ glab auth login --hostname $CI_SERVER_HOST --job-token $CI_JOB_TOKEN
+
+			# Store the token of a second account on the same instance in the "bot" profile
+			$ glab auth login --profile bot --stdin < bottoken.txt
 		`, "`"),
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
@@ -157,47 +160,47 @@ func NewCmdLogin(f cmdutils.Factory) *cobra.Command {
 func loginRun(ctx context.Context, opts *LoginOptions) error {
 	c := opts.IO.Color()
 	cfg := opts.Config()
+	profile, _ := config.ActiveProfile(cfg, opts.Hostname)
 
 	if opts.Token != "" {
 		if opts.Hostname == "" {
 			return errors.New("empty hostname would leak `oauth_token`")
 		}
 
-		if opts.UseKeyring {
-			return keyring.Set("glab:"+opts.Hostname, "", opts.Token)
-		} else {
-			err := cfg.Set(opts.Hostname, "token", opts.Token)
+		err := storeToken(cfg, opts.Hostname, profile, "token", opts.Token, opts.UseKeyring)
+		if err != nil {
+			return err
+		}
+
+		if token := config.GetFromEnv("token"); token != "" {
+			fmt.Fprintf(opts.IO.StdErr, "%s One of %s environment variables is set. If you don't want to use it for glab, unset it.\n", c.Yellow("WARNING:"), strings.Join(config.EnvKeyEquivalence("token"), ", "))
+		}
+		if opts.ApiHost != "" {
+			err = cfg.Set(opts.Hostname, "api_host", opts.ApiHost)
 			if err != nil {
 				return err
 			}
+		}
 
-			if token := config.GetFromEnv("token"); token != "" {
-				fmt.Fprintf(opts.IO.StdErr, "%s One of %s environment variables is set. If you don't want to use it for glab, unset it.\n", c.Yellow("WARNING:"), strings.Join(config.EnvKeyEquivalence("token"), ", "))
-			}
-			if opts.ApiHost != "" {
-				err = cfg.Set(opts.Hostname, "api_host", opts.ApiHost)
-				if err != nil {
-					return err
-				}
+		if opts.ApiProtocol != "" {
+			err = cfg.Set(opts.Hostname, "api_protocol", opts.ApiProtocol)
+			if err != nil {
+				return err
 			}
+		}
 
-			if opts.ApiProtocol != "" {
-				err = cfg.Set(opts.Hostname, "api_protocol", opts.ApiProtocol)
-				if err != nil {
-					return err
-				}
+		if opts.GitProtocol != "" {
+			err = cfg.Set(opts.Hostname, "git_protocol", opts.GitProtocol)
+			if err != nil {
+				return err
 			}
 
-			if opts.GitProtocol != "" {
-				err = cfg.Set(opts.Hostname, "git_protocol", opts.GitProtocol)
-				if err != nil {
-					return err
-				}
+			if opts.GitProtocol != "ssh" {
+				fmt.Fprintf(opts.IO.StdErr, "- Run %s to use these credentials for Git over %s.\n", c.Bold("glab auth setup-git --hostname "+opts.Hostname), opts.GitProtocol)
 			}
-
-			return cfg.Write()
 		}
 
+		return cfg.Write()
 	}
 
 	if opts.JobToken != "" {
@@ -205,37 +208,33 @@ func loginRun(ctx context.Context, opts *LoginOptions) error {
 			return errors.New("empty hostname would leak `oauth_token`")
 		}
 
-		if opts.UseKeyring {
-			return keyring.Set("glab:"+opts.Hostname, "", opts.JobToken)
-		} else {
-			err := cfg.Set(opts.Hostname, "job_token", opts.JobToken)
+		err := storeToken(cfg, opts.Hostname, profile, "job_token", opts.JobToken, opts.UseKeyring)
+		if err != nil {
+			return err
+		}
+
+		if opts.ApiHost != "" {
+			err = cfg.Set(opts.Hostname, "api_host", opts.ApiHost)
 			if err != nil {
 				return err
 			}
+		}
 
-			if opts.ApiHost != "" {
-				err = cfg.Set(opts.Hostname, "api_host", opts.ApiHost)
-				if err != nil {
-					return err
-				}
-			}
-
-			if opts.ApiProtocol != "" {
-				err = cfg.Set(opts.Hostname, "api_protocol", opts.ApiProtocol)
-				if err != nil {
-					return err
-				}
+		if opts.ApiProtocol != "" {
+			err = cfg.Set(opts.Hostname, "api_protocol", opts.ApiProtocol)
+			if err != nil {
+				return err
 			}
+		}
 
-			if opts.GitProtocol != "" {
-				err = cfg.Set(opts.Hostname, "git_protocol", opts.GitProtocol)
-				if err != nil {
-					return err
-				}
+		if opts.GitProtocol != "" {
+			err = cfg.Set(opts.Hostname, "git_protocol", opts.GitProtocol)
+			if err != nil {
+				return err
 			}
-
-			return cfg.Write()
 		}
+
+		return cfg.Write()
 	}
 
 	hostname := opts.Hostname
@@ -371,7 +370,12 @@ func loginRun(ctx context.Context, opts *LoginOptions) error {
 		isSelfHosted = glinstance.IsSelfHosted(hostname)
 	}
 
-	fmt.Fprintf(opts.IO.StdErr, "- Signing into %s\n", hostname)
+	profile, _ = config.ActiveProfile(cfg, hostname)
+	if profile != "" {
+		fmt.Fprintf(opts.IO.StdErr, "- Signing into %s with profile %s\n", hostname, profile)
+	} else {
+		fmt.Fprintf(opts.IO.StdErr, "- Signing into %s\n", hostname)
+	}
 
 	if token := config.GetFromEnv("token"); token != "" {
 		fmt.Fprintf(opts.IO.StdErr, "%s One of %s environment variables is set. If you don't want to use it for glab, unset it.\n", c.Yellow("WARNING:"), strings.Join(config.EnvKeyEquivalence("token"), ", "))
@@ -448,7 +452,7 @@ func loginRun(ctx context.Context, opts *LoginOptions) error {
 	}
 
 	if opts.UseKeyring {
-		err = keyring.Set("glab:"+hostname, "", token)
+		err = keyring.Set(config.KeyringService(hostname, profile), "", token)
 		if err != nil {
 			return err
 		}
@@ -554,10 +558,26 @@ func loginRun(ctx context.Context, opts *LoginOptions) error {
 
 	fmt.Fprintf(opts.IO.StdErr, "%s Logged in as %s\n", c.GreenCheck(), c.Bold(username))
 	fmt.Fprintf(opts.IO.StdErr, "%s Configuration saved to %s\n", c.GreenCheck(), config.ConfigFile())
+	if profile != "" {
+		fmt.Fprintf(opts.IO.StdErr, "- Run %s to use this profile by default.\n", c.Bold(fmt.Sprintf("glab auth switch --hostname %s %s", hostname, profile)))
+	}
 
 	return nil
 }
 
+// storeToken saves a token in the keyring or in the config file. With the keyring,
+// any token left in the config file is removed, which still records the profile.
+func storeToken(cfg config.Config, hostname, profile, key, token string, useKeyring bool) error {
+	if !useKeyring {
+		return cfg.Set(hostname, key, token)
+	}
+
+	if err := keyring.Set(config.KeyringService(hostname, profile), "", token); err != nil {
+		return err
+	}
+	return cfg.Set(hostname, key, "")
+}
+
 func hostnameValidator(v any) error {
 	s, ok := v.(string)
 	if !ok {
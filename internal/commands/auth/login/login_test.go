@@ -4,12 +4,17 @@ package login
 
 import (
 	"bytes"
+	"io"
 	"testing"
 
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/zalando/go-keyring"
 
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	authswitch "gitlab.com/gitlab-org/cli/internal/commands/auth/switch"
+	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
 )
@@ -357,3 +362,41 @@ func Test_keyringLogin(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "glpat-1234", token)
 }
+
+func Test_keyringLoginProfile(t *testing.T) {
+	keyring.MockInit()
+	t.Setenv("GLAB_PROFILE", "")
+	t.Cleanup(func() { config.SetProfileOverride("") })
+
+	mainBuf := bytes.Buffer{}
+	defer config.StubWriteConfig(&mainBuf, io.Discard)()
+
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	f := cmdtest.NewTestFactory(ios)
+	cmd := NewCmdLogin(f)
+	cmdutils.AddGlobalProfileFlag(cmd)
+	cmd.Flags().BoolP("help", "x", false, "")
+	cmd.SetArgs([]string{"--profile", "bot", "--use-keyring", "--token", "glpat-bot"})
+
+	_, err := cmd.ExecuteC()
+	require.NoError(t, err)
+
+	token, err := keyring.Get("glab:gitlab.com:bot", "")
+	require.NoError(t, err)
+	assert.Equal(t, "glpat-bot", token)
+	assert.NotContains(t, mainBuf.String(), "glpat-bot")
+
+	config.SetProfileOverride("")
+	cfg := config.NewFromString(mainBuf.String())
+	mainBuf.Reset()
+
+	exec := cmdtest.SetupCmdForTest(t, authswitch.NewCmdSwitch, false, cmdtest.WithConfig(cfg))
+	_, err = exec("bot --hostname gitlab.com")
+	require.NoError(t, err)
+
+	profile, _ := config.ActiveProfile(config.NewFromString(mainBuf.String()), "gitlab.com")
+	assert.Equal(t, "bot", profile)
+	token, err = config.NewFromString(mainBuf.String()).Get("gitlab.com", "token")
+	require.NoError(t, err)
+	assert.Equal(t, "glpat-bot", token)
+}
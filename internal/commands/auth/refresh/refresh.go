@@ -0,0 +1,99 @@
+package refresh
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	xoauth2 "golang.org/x/oauth2"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/oauth2"
+)
+
+type options struct {
+	io        *iostreams.IOStreams
+	config    func() config.Config
+	apiClient func(repoHost string) (*api.Client, error)
+
+	hostname string
+
+	refresh func(ctx context.Context, cfg config.Config, httpClient *http.Client, protocol, hostname string) (*xoauth2.Token, error)
+}
+
+func NewCmdRefresh(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		config:    f.Config,
+		apiClient: f.ApiClient,
+		refresh:   oauth2.Refresh,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "refresh",
+		Args:  cobra.ExactArgs(0),
+		Short: "Refresh the OAuth 2.0 token of a GitLab instance.",
+		Long: heredoc.Docf(`
+			Refresh the OAuth 2.0 token of a GitLab instance, even if it has not expired yet.
+
+			glab refreshes OAuth 2.0 tokens automatically when they expire. Refreshing is
+			coordinated across all running glab processes with a lock file in your cache directory,
+			so parallel invocations reuse the token that the first process refreshed instead of
+			invalidating each other's refresh tokens.
+
+			Only sessions created with %[1]sglab auth login%[1]s through the web browser use OAuth 2.0.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab auth refresh
+			$ glab auth refresh --hostname gitlab.example.com
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.hostname == "" {
+				opts.hostname = f.DefaultHostname()
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.hostname, "hostname", "", "", "The hostname of the GitLab instance. Defaults to the instance of the current repository.")
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context) error {
+	cfg := o.config()
+	c := o.io.Color()
+
+	if isOAuth2, _ := cfg.Get(o.hostname, "is_oauth2"); isOAuth2 != "true" {
+		return fmt.Errorf("%s is not authenticated with OAuth 2.0. Run `%s` to sign in with a web browser.", o.hostname, c.Bold("glab auth login --hostname "+o.hostname))
+	}
+
+	apiClient, err := o.apiClient(o.hostname)
+	if err != nil {
+		return err
+	}
+
+	token, err := o.refresh(ctx, cfg, apiClient.HTTPClient(), glinstance.DefaultProtocol, o.hostname)
+	if err != nil {
+		return cmdutils.WrapError(err, fmt.Sprintf("failed to refresh the OAuth 2.0 token for %s.", o.hostname))
+	}
+
+	fmt.Fprintf(o.io.StdErr, "%s Refreshed the OAuth 2.0 token for %s.\n", c.GreenCheck(), o.hostname)
+	if !token.Expiry.IsZero() {
+		fmt.Fprintf(o.io.StdErr, "%s The token expires at %s.\n", c.GreenCheck(), token.Expiry.Local().Format(time.RFC1123))
+	}
+
+	return nil
+}
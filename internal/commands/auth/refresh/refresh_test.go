@@ -0,0 +1,97 @@
+//go:build !integration
+
+package refresh
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	xoauth2 "golang.org/x/oauth2"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_refreshRun(t *testing.T) {
+	t.Setenv("GLAB_IS_OAUTH2", "")
+
+	tests := []struct {
+		name       string
+		config     string
+		refreshErr error
+		wantErr    string
+		wantStderr string
+	}{
+		{
+			name: "refreshes OAuth 2.0 token",
+			config: heredoc.Doc(`
+				hosts:
+				  gitlab.com:
+				    is_oauth2: "true"
+				    token: access_token
+			`),
+			wantStderr: "Refreshed the OAuth 2.0 token for gitlab.com.",
+		},
+		{
+			name: "personal access token",
+			config: heredoc.Doc(`
+				hosts:
+				  gitlab.com:
+				    token: glpat-xxx
+			`),
+			wantErr: "gitlab.com is not authenticated with OAuth 2.0.",
+		},
+		{
+			name: "refresh fails",
+			config: heredoc.Doc(`
+				hosts:
+				  gitlab.com:
+				    is_oauth2: "true"
+				    token: access_token
+			`),
+			refreshErr: errors.New("invalid_grant"),
+			wantErr:    "invalid_grant",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, stderr := cmdtest.TestIOStreams()
+
+			var refreshedHost string
+			opts := &options{
+				io:       ios,
+				config:   func() config.Config { return config.NewFromString(tt.config) },
+				hostname: "gitlab.com",
+				apiClient: func(repoHost string) (*api.Client, error) {
+					return cmdtest.NewTestApiClient(t, &http.Client{}, "", repoHost), nil
+				},
+				refresh: func(ctx context.Context, cfg config.Config, httpClient *http.Client, protocol, hostname string) (*xoauth2.Token, error) {
+					refreshedHost = hostname
+					if tt.refreshErr != nil {
+						return nil, tt.refreshErr
+					}
+					return &xoauth2.Token{AccessToken: "new", Expiry: time.Now().Add(time.Hour)}, nil
+				},
+			}
+
+			err := opts.run(t.Context())
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "gitlab.com", refreshedHost)
+			assert.Contains(t, stderr.String(), tt.wantStderr)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package setupgit
+
+import (
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/auth/authutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io     *iostreams.IOStreams
+	config func() config.Config
+
+	hostname string
+
+	// configureHelper is replaced in tests to avoid changing the global Git configuration.
+	configureHelper func(executable, hostname, protocol string) error
+}
+
+func NewCmdSetupGit(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		config:          f.Config,
+		configureHelper: authutils.ConfigureCredentialHelper,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "setup-git",
+		Args:  cobra.ExactArgs(0),
+		Short: "Configure Git to use glab as the credential helper for HTTPS.",
+		Long: heredoc.Docf(`
+			Configure Git to use glab as the credential helper for your GitLab instances.
+
+			After running this command, Git operations over HTTPS use the token or OAuth 2.0 session
+			stored by %[1]sglab auth login%[1]s, so you don't need a separate personal access token for Git.
+			The helper is registered in your global Git configuration for each instance, and replaces
+			any other credential helper configured for that instance.
+
+			By default, all instances in the glab configuration are set up.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Configure all authenticated GitLab instances
+			$ glab auth setup-git
+
+			# Configure a single instance
+			$ glab auth setup-git --hostname gitlab.example.com
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.hostname, "hostname", "", "", "Configure only this GitLab instance.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	cfg := o.config()
+	c := o.io.Color()
+
+	hosts, err := cfg.Hosts()
+	if err != nil || len(hosts) == 0 {
+		return fmt.Errorf("no GitLab instances have been authenticated with glab. Run `%s` to authenticate.", c.Bold("glab auth login"))
+	}
+
+	if o.hostname != "" {
+		if !slices.Contains(hosts, o.hostname) {
+			return fmt.Errorf("%s has not been authenticated with glab. Run `%s %s` to authenticate.", o.hostname, c.Bold("glab auth login --hostname"), c.Bold(o.hostname))
+		}
+		hosts = []string{o.hostname}
+	}
+
+	executable := "glab"
+	if exe, err := os.Executable(); err == nil {
+		executable = exe
+	}
+
+	for _, host := range hosts {
+		gitProtocol, _ := cfg.Get(host, "git_protocol")
+		protocol := "https"
+		if gitProtocol == "http" {
+			protocol = gitProtocol
+		}
+
+		if err := o.configureHelper(executable, host, protocol); err != nil {
+			return fmt.Errorf("failed to configure the Git credential helper for %s: %w", host, err)
+		}
+
+		fmt.Fprintf(o.io.StdErr, "%s Configured Git credential helper for %s://%s\n", c.GreenCheck(), protocol, host)
+
+		if gitProtocol == "ssh" {
+			fmt.Fprintf(o.io.StdErr, "%s glab clones from %s over SSH. Run %s to use HTTPS.\n", c.WarnIcon(), host, c.Bold(fmt.Sprintf("glab config set -h %s git_protocol https", host)))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+//go:build !integration
+
+package setupgit
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_setupGitRun(t *testing.T) {
+	cfg := heredoc.Doc(`
+		hosts:
+		  gitlab.com:
+		    token: xxxxxxxx
+		    git_protocol: https
+		  gitlab.example.com:
+		    token: xxxxxxxx
+		    git_protocol: http
+		  gitlab.ssh.com:
+		    token: xxxxxxxx
+		    git_protocol: ssh
+	`)
+
+	tests := []struct {
+		name       string
+		hostname   string
+		wantHosts  []string
+		wantStderr []string
+		wantErr    string
+	}{
+		{
+			name:      "all hosts",
+			wantHosts: []string{"https://gitlab.com", "http://gitlab.example.com", "https://gitlab.ssh.com"},
+			wantStderr: []string{
+				"Configured Git credential helper for https://gitlab.com",
+				"Configured Git credential helper for http://gitlab.example.com",
+				"glab clones from gitlab.ssh.com over SSH.",
+			},
+		},
+		{
+			name:       "single host",
+			hostname:   "gitlab.example.com",
+			wantHosts:  []string{"http://gitlab.example.com"},
+			wantStderr: []string{"Configured Git credential helper for http://gitlab.example.com"},
+		},
+		{
+			name:     "unknown host",
+			hostname: "gitlab.unknown.com",
+			wantErr:  "gitlab.unknown.com has not been authenticated with glab.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, stderr := cmdtest.TestIOStreams()
+
+			var configured []string
+			opts := &options{
+				io:       ios,
+				config:   func() config.Config { return config.NewFromString(cfg) },
+				hostname: tt.hostname,
+				configureHelper: func(executable, hostname, protocol string) error {
+					configured = append(configured, protocol+"://"+hostname)
+					return nil
+				},
+			}
+
+			err := opts.run()
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Empty(t, configured)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantHosts, configured)
+			for _, msg := range tt.wantStderr {
+				assert.Contains(t, stderr.String(), msg)
+			}
+		})
+	}
+}
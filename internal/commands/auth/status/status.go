@@ -123,6 +123,9 @@ func (o *options) run() error {
 			failedAuth = true
 			addMsg("%s %s: failed to initialize api client: %s", c.FailedIcon(), instance, err)
 		}
+		if profile, profileSource := config.ActiveProfile(cfg, instance); profile != "" {
+			addMsg("%s Using profile %s (%s)", c.GreenCheck(), c.Bold(profile), profileSource)
+		}
 		proto, _ := cfg.Get(instance, "git_protocol")
 		if proto != "" {
 			addMsg("%s Git operations for %s configured to use %s protocol.",
@@ -0,0 +1,126 @@
+package authswitch
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io     *iostreams.IOStreams
+	config func() config.Config
+
+	hostname string
+	profile  string
+}
+
+func NewCmdSwitch(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:     f.IO(),
+		config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "switch [<profile>]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Switch the active authentication profile for a GitLab instance.",
+		Long: heredoc.Docf(`
+			Switch the active authentication profile for a GitLab instance.
+
+			Profiles let you keep several accounts on the same instance, each with its own token
+			and keyring entry. Create a profile by signing in with %[1]sglab auth login --profile <name>%[1]s.
+			The %[1]sdefault%[1]s profile uses the credentials stored directly under the host.
+
+			The active profile is resolved in this order:
+
+			1. The %[1]s--profile%[1]s flag.
+			1. The %[1]sGLAB_PROFILE%[1]s environment variable.
+			1. The %[1]sprofile%[1]s key of the local configuration, set with %[1]sglab config set profile <name>%[1]s in a repository.
+			1. The profile selected for the instance with this command.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Use the "bot" profile for gitlab.com
+			$ glab auth switch bot
+
+			# Go back to the default profile of a GitLab Self-Managed instance
+			$ glab auth switch default --hostname gitlab.example.com
+
+			# Select the profile interactively
+			$ glab auth switch
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				opts.profile = args[0]
+			} else if flag := cmd.Flags().Lookup("profile"); flag != nil && flag.Changed {
+				opts.profile = flag.Value.String()
+			}
+
+			if opts.hostname == "" {
+				opts.hostname = f.DefaultHostname()
+			}
+
+			return opts.run(cmd)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.hostname, "hostname", "", "", "The hostname of the GitLab instance. Defaults to the instance of the current repository.")
+
+	return cmd
+}
+
+func (o *options) run(cmd *cobra.Command) error {
+	cfg := o.config()
+	c := o.io.Color()
+
+	profiles, err := cfg.Profiles(o.hostname)
+	if err != nil {
+		return err
+	}
+
+	if o.profile == "" {
+		if !o.io.PromptEnabled() {
+			return &cmdutils.FlagError{Err: errors.New("a profile name is required when not running interactively.")}
+		}
+
+		err := o.io.Select(cmd.Context(), &o.profile, fmt.Sprintf("Which profile do you want to use for %s?", o.hostname), profiles)
+		if err != nil {
+			return fmt.Errorf("could not prompt: %w", err)
+		}
+	}
+
+	if !slices.Contains(profiles, o.profile) {
+		return fmt.Errorf("no profile named %q for %s. Run `%s` to create it.", o.profile, o.hostname, c.Bold(fmt.Sprintf("glab auth login --hostname %s --profile %s", o.hostname, o.profile)))
+	}
+
+	value := o.profile
+	if value == config.DefaultProfile {
+		value = ""
+	}
+
+	if err := cfg.Set(o.hostname, "profile", value); err != nil {
+		return err
+	}
+
+	if err := cfg.Write(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(o.io.StdErr, "%s Switched %s to profile %s\n", c.GreenCheck(), o.hostname, c.Bold(o.profile))
+
+	if active, source := config.ActiveProfile(cfg, o.hostname); active != value {
+		fmt.Fprintf(o.io.StdErr, "%s The profile is overridden by %s for this directory.\n", c.WarnIcon(), source)
+	}
+
+	return nil
+}
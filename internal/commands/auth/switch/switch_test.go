@@ -0,0 +1,82 @@
+//go:build !integration
+
+package authswitch
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_NewCmdSwitch(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        string
+		wantErr     string
+		wantProfile string
+	}{
+		{
+			name:        "switch to named profile",
+			args:        "bot --hostname gitlab.example.com",
+			wantProfile: "bot",
+		},
+		{
+			name:        "switch back to default profile",
+			args:        "default --hostname gitlab.example.com",
+			wantProfile: "",
+		},
+		{
+			name:    "unknown profile",
+			args:    "other --hostname gitlab.example.com",
+			wantErr: `no profile named "other" for gitlab.example.com`,
+		},
+		{
+			name:    "no profile when not interactive",
+			args:    "--hostname gitlab.example.com",
+			wantErr: "a profile name is required when not running interactively.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GLAB_PROFILE", "")
+
+			mainBuf := bytes.Buffer{}
+			defer config.StubWriteConfig(&mainBuf, io.Discard)()
+
+			cfg := config.NewFromString(heredoc.Doc(`
+				hosts:
+				  gitlab.example.com:
+				    token: personal-token
+				    profile: ci
+				    profiles:
+				      bot:
+				        token: bot-token
+				      ci:
+				        token: ci-token
+			`))
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdSwitch, false, cmdtest.WithConfig(cfg))
+			output, err := exec(tt.args)
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, output.Stderr(), "Switched gitlab.example.com to profile")
+
+			written := config.NewFromString(mainBuf.String())
+			profile, _ := config.ActiveProfile(written, "gitlab.example.com")
+			assert.Equal(t, tt.wantProfile, profile)
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/cluster/agent/agentutils"
+	"gitlab.com/gitlab-org/cli/internal/filemutex"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
 )
@@ -148,7 +149,7 @@ func (o *options) cachedPAT(ctx context.Context) (*gitlab.PersonalAccessToken, e
 	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	pat, err := withLock(ctx, id, func() (*gitlab.PersonalAccessToken, error) {
+	pat, err := filemutex.WithLock(ctx, id, func() (*gitlab.PersonalAccessToken, error) {
 		isTokenRevoked := func(t *gitlab.PersonalAccessToken) (bool, error) {
 			if !o.checkRevoked {
 				return false, nil
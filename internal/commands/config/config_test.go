@@ -56,6 +56,10 @@ func (c configStub) Hosts() ([]string, error) {
 	return nil, nil
 }
 
+func (c configStub) Profiles(string) ([]string, error) {
+	return nil, nil
+}
+
 func (c configStub) Write() error {
 	c["_written"] = "true"
 	return nil
@@ -0,0 +1,66 @@
+package addissue
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+func NewCmdAddIssue(f cmdutils.Factory) *cobra.Command {
+	var group string
+
+	epicAddIssueCmd := &cobra.Command{
+		Use:   "add-issue <epic> <issue> [<issue>...] [flags]",
+		Short: `Add issues to an epic.`,
+		Long: heredoc.Doc(`
+			Add issues to an epic. Issues are given by ID, in the current project, or by URL.
+			An issue that is already in another epic is moved to this one.
+		`),
+		Example: heredoc.Doc(`
+			$ glab epic add-issue 12 42 43
+			$ glab epic add-issue 12 https://gitlab.com/OWNER/REPO/-/issues/42 -g mygroup
+		`),
+		Args: cobra.MinimumNArgs(2),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, epic, group, err := epicutils.ResolveEpic(f.ApiClient, f.BaseRepo, group, args[0])
+			if err != nil {
+				return err
+			}
+
+			c := f.IO().Color()
+			issueArgs := args[1:]
+			failed := 0
+			for _, arg := range issueArgs {
+				issue, _, err := issueutils.IssueFromArg(f.ApiClient, client, f.BaseRepo, f.DefaultHostname(), arg)
+				if err == nil {
+					_, _, err = client.EpicIssues.AssignEpicIssue(group, epic.IID, issue.ID)
+				}
+				if err != nil {
+					failed++
+					fmt.Fprintf(f.IO().StdErr, "%s %s: %s\n", c.FailedIcon(), arg, err)
+					continue
+				}
+				fmt.Fprintf(f.IO().StdOut, "%s Added #%d to epic &%d\n", c.GreenCheck(), issue.IID, epic.IID)
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("failed to add %d of %s.", failed, utils.Pluralize(len(issueArgs), "issue"))
+			}
+			return nil
+		},
+	}
+
+	epicAddIssueCmd.Flags().StringVarP(&group, "group", "g", "", "Group of the epic. Defaults to the group of the project.")
+
+	return epicAddIssueCmd
+}
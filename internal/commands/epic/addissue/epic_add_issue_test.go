@@ -0,0 +1,47 @@
+//go:build !integration
+
+package addissue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestEpicAddIssue(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockEpics.EXPECT().
+		GetEpic("plan", int64(12)).
+		Return(&gitlab.Epic{ID: 500, IID: 12}, nil, nil)
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(42), gomock.Any()).
+		Return(&gitlab.Issue{ID: 4200, IID: 42}, nil, nil)
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(43), gomock.Any()).
+		Return(&gitlab.Issue{ID: 4300, IID: 43}, nil, nil)
+	tc.MockEpicIssues.EXPECT().
+		AssignEpicIssue("plan", int64(12), int64(4200)).
+		Return(&gitlab.EpicIssueAssignment{ID: 1}, nil, nil)
+	tc.MockEpicIssues.EXPECT().
+		AssignEpicIssue("plan", int64(12), int64(4300)).
+		Return(nil, nil, gitlab.ErrNotFound)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdAddIssue, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("12 42 43 -g plan")
+	require.EqualError(t, err, "failed to add 1 of 2 issues.")
+
+	assert.Contains(t, out.String(), "Added #42 to epic &12\n")
+	assert.Contains(t, out.Stderr(), "43: ")
+}
@@ -0,0 +1,57 @@
+package close
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func NewCmdClose(f cmdutils.Factory) *cobra.Command {
+	var group string
+
+	epicCloseCmd := &cobra.Command{
+		Use:   "close <id> [<id>...] [flags]",
+		Short: `Close epics.`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			$ glab epic close 12
+			$ glab epic close 12 13 -g mygroup
+			$ glab epic close https://gitlab.com/groups/mygroup/-/epics/12
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := f.IO().Color()
+
+			for _, arg := range args {
+				client, epic, group, err := epicutils.ResolveEpic(f.ApiClient, f.BaseRepo, group, arg)
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(f.IO().StdOut, "- Closing epic &%d...\n", epic.IID)
+				epic, _, err = client.Epics.UpdateEpic(group, epic.IID, &gitlab.UpdateEpicOptions{StateEvent: gitlab.Ptr("close")})
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(f.IO().StdOut, "%s Closed epic &%d\n", c.RedCheck(), epic.IID)
+				fmt.Fprintln(f.IO().StdOut, epicutils.DisplayEpic(c, epic, f.IO().IsaTTY))
+			}
+			return nil
+		},
+	}
+
+	epicCloseCmd.Flags().StringVarP(&group, "group", "g", "", "Group of the epics. Defaults to the group of the project.")
+
+	return epicCloseCmd
+}
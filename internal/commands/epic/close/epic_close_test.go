@@ -0,0 +1,55 @@
+//go:build !integration
+
+package close
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestEpicClose(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	for _, iid := range []int64{12, 13} {
+		tc.MockEpics.EXPECT().
+			GetEpic("plan", iid).
+			Return(&gitlab.Epic{IID: iid, State: "opened"}, nil, nil)
+		tc.MockEpics.EXPECT().
+			UpdateEpic("plan", iid, &gitlab.UpdateEpicOptions{StateEvent: gitlab.Ptr("close")}).
+			Return(&gitlab.Epic{IID: iid, State: "closed", WebURL: fmt.Sprintf("https://gitlab.com/groups/plan/-/epics/%d", iid)}, nil, nil)
+	}
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdClose, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("12 &13 -g plan")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "Closed epic &12\n")
+	assert.Contains(t, out.String(), "Closed epic &13\n")
+}
+
+func TestEpicClose_notFound(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockEpics.EXPECT().
+		GetEpic("plan", int64(99)).
+		Return(nil, nil, gitlab.ErrNotFound)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdClose, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	_, err := exec("99 -g plan")
+	require.ErrorContains(t, err, "error getting epic &99 of plan")
+}
@@ -0,0 +1,153 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io        *iostreams.IOStreams
+	apiClient func(repoHost string) (*api.Client, error)
+	baseRepo  func() (glrepo.Interface, error)
+
+	group        string
+	title        string
+	description  string
+	labels       []string
+	startDate    string
+	dueDate      string
+	parent       string
+	confidential bool
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+	}
+
+	epicCreateCmd := &cobra.Command{
+		Use:     "create [flags]",
+		Short:   `Create an epic.`,
+		Aliases: []string{"new"},
+		Long: heredoc.Docf(`
+			Create an epic in a group. Without %[1]s--group%[1]s, the epic is created in the group of the project.
+
+			Without start and due dates, the dates of the epic are inherited from its milestones.
+			When running interactively, you're prompted for the title if it's not given.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab epic create --title "Self-serve billing" --label roadmap
+			$ glab epic create -g mygroup -t "Invoices" --parent 12 --start-date 2026-11-01 --due-date 2026-12-15
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.complete(cmd.Context()); err != nil {
+				return err
+			}
+			return opts.run()
+		},
+	}
+
+	fl := epicCreateCmd.Flags()
+	fl.StringVarP(&opts.group, "group", "g", "", "Create the epic in a group. Defaults to the group of the project.")
+	fl.StringVarP(&opts.title, "title", "t", "", "Epic title.")
+	fl.StringVarP(&opts.description, "description", "d", "", "Epic description.")
+	fl.StringSliceVarP(&opts.labels, "label", "l", []string{}, "Add labels by name. Multiple labels can be comma-separated or specified by repeating the flag.")
+	fl.StringVar(&opts.startDate, "start-date", "", "Start date in YYYY-MM-DD format.")
+	fl.StringVar(&opts.dueDate, "due-date", "", "Due date in YYYY-MM-DD format.")
+	fl.StringVar(&opts.parent, "parent", "", "Add the epic as a child of this epic.")
+	fl.BoolVarP(&opts.confidential, "confidential", "c", false, "Make the epic confidential.")
+
+	return epicCreateCmd
+}
+
+func (o *options) complete(ctx context.Context) error {
+	if o.title != "" {
+		return nil
+	}
+	if !o.io.PromptEnabled() {
+		return &cmdutils.FlagError{Err: errors.New("--title is required when not running interactively.")}
+	}
+
+	err := o.io.Input(ctx, &o.title, "Title", "", func(s string) error {
+		if strings.TrimSpace(s) == "" {
+			return errors.New("the title can't be empty.")
+		}
+		return nil
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "could not prompt")
+	}
+	return nil
+}
+
+func (o *options) run() error {
+	createOpts := &gitlab.CreateEpicOptions{
+		Title:        gitlab.Ptr(o.title),
+		Confidential: gitlab.Ptr(o.confidential),
+	}
+	if o.description != "" {
+		createOpts.Description = gitlab.Ptr(o.description)
+	}
+	if len(o.labels) > 0 {
+		createOpts.Labels = (*gitlab.LabelOptions)(&o.labels)
+	}
+	if o.startDate != "" {
+		date, err := epicutils.ParseDate(o.startDate)
+		if err != nil {
+			return &cmdutils.FlagError{Err: fmt.Errorf("--start-date: %w", err)}
+		}
+		createOpts.StartDateIsFixed = gitlab.Ptr(true)
+		createOpts.StartDateFixed = date
+	}
+	if o.dueDate != "" {
+		date, err := epicutils.ParseDate(o.dueDate)
+		if err != nil {
+			return &cmdutils.FlagError{Err: fmt.Errorf("--due-date: %w", err)}
+		}
+		createOpts.DueDateIsFixed = gitlab.Ptr(true)
+		createOpts.DueDateFixed = date
+	}
+
+	client, group, err := cmdutils.GroupFromFlags(o.apiClient, o.baseRepo, o.group)
+	if err != nil {
+		return err
+	}
+
+	if o.parent != "" {
+		parent, _, err := epicutils.EpicFromArg(client, group, o.parent)
+		if err != nil {
+			return err
+		}
+		createOpts.ParentID = gitlab.Ptr(parent.ID)
+	}
+
+	fmt.Fprintf(o.io.StdErr, "- Creating epic in %s\n", group)
+
+	epic, _, err := client.Epics.CreateEpic(group, createOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create epic")
+	}
+
+	fmt.Fprintln(o.io.StdOut, epicutils.DisplayEpic(o.io.Color(), epic, o.io.IsaTTY))
+	return nil
+}
@@ -0,0 +1,65 @@
+//go:build !integration
+
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestEpicCreate(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockEpics.EXPECT().
+		GetEpic("plan", int64(3)).
+		Return(&gitlab.Epic{ID: 300, IID: 3}, nil, nil)
+	tc.MockEpics.EXPECT().
+		CreateEpic("plan", gomock.Any()).
+		DoAndReturn(func(gid any, opts *gitlab.CreateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+			assert.Equal(t, "Invoices", *opts.Title)
+			assert.Equal(t, gitlab.LabelOptions{"roadmap", "q4"}, *opts.Labels)
+			assert.Equal(t, int64(300), *opts.ParentID)
+			assert.True(t, *opts.StartDateIsFixed)
+			assert.Equal(t, "2026-11-01", opts.StartDateFixed.String())
+			assert.Nil(t, opts.DueDateIsFixed)
+			return &gitlab.Epic{IID: 14, Title: "Invoices", State: "opened", WebURL: "https://gitlab.com/groups/plan/-/epics/14"}, nil, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("-g plan -t Invoices -l roadmap,q4 --parent 3 --start-date 2026-11-01")
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://gitlab.com/groups/plan/-/epics/14\n", out.String())
+	assert.Equal(t, "- Creating epic in plan\n", out.Stderr())
+}
+
+func TestEpicCreate_flags(t *testing.T) {
+	tests := []struct {
+		args    string
+		wantErr string
+	}{
+		{args: "-g plan", wantErr: "--title is required when not running interactively."},
+		{args: "-g plan -t Invoices --due-date tomorrow", wantErr: `--due-date: invalid date "tomorrow". Use the YYYY-MM-DD format.`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false)
+
+			_, err := exec(tt.args)
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
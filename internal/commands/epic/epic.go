@@ -0,0 +1,52 @@
+package epic
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	epicAddIssueCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/addissue"
+	epicCloseCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/close"
+	epicCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/create"
+	epicListCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/list"
+	epicRemoveIssueCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/removeissue"
+	epicReopenCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/reopen"
+	epicUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/update"
+	epicViewCmd "gitlab.com/gitlab-org/cli/internal/commands/epic/view"
+)
+
+func NewCmdEpic(f cmdutils.Factory) *cobra.Command {
+	epicCmd := &cobra.Command{
+		Use:   "epic [command] [flags]",
+		Short: `Work with GitLab epics.`,
+		Long: heredoc.Docf(`
+			Work with the epics of a group. Use %[1]s--group%[1]s to select the group. Without it,
+			the group of the current project, or of the project given with %[1]s--repo%[1]s, is used.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab epic list -g mygroup
+			$ glab epic view 12
+		`),
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Doc(`
+				An epic can be supplied as argument in any of the following formats:
+
+				- by number, e.g. "12" or "&12"
+				- by URL, e.g. "https://gitlab.com/groups/GROUP/-/epics/12"
+			`),
+		},
+	}
+
+	cmdutils.EnableRepoOverride(epicCmd, f)
+
+	epicCmd.AddCommand(epicListCmd.NewCmdList(f))
+	epicCmd.AddCommand(epicViewCmd.NewCmdView(f))
+	epicCmd.AddCommand(epicCreateCmd.NewCmdCreate(f))
+	epicCmd.AddCommand(epicUpdateCmd.NewCmdUpdate(f))
+	epicCmd.AddCommand(epicCloseCmd.NewCmdClose(f))
+	epicCmd.AddCommand(epicReopenCmd.NewCmdReopen(f))
+	epicCmd.AddCommand(epicAddIssueCmd.NewCmdAddIssue(f))
+	epicCmd.AddCommand(epicRemoveIssueCmd.NewCmdRemoveIssue(f))
+
+	return epicCmd
+}
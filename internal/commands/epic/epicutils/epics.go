@@ -0,0 +1,138 @@
+package epicutils
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// epicURLPathRE matches the path of epic URLs, like /groups/GROUP/SUBGROUP/-/epics/12.
+var epicURLPathRE = regexp.MustCompile(`^/groups/(.+?)/(?:-/)?epics/(\d+)/?$`)
+
+// ParseEpicArg returns the IID of the epic that arg refers to, and its group. The epic is
+// given by IID, like 12 or &12, or by URL. Epics given by IID are in the given group.
+func ParseEpicArg(arg, group string) (int64, string, error) {
+	if strings.HasPrefix(arg, "http://") || strings.HasPrefix(arg, "https://") {
+		u, err := url.Parse(arg)
+		if err == nil {
+			if m := epicURLPathRE.FindStringSubmatch(u.Path); m != nil {
+				iid, _ := strconv.ParseInt(m[2], 10, 64)
+				return iid, m[1], nil
+			}
+		}
+		return 0, "", fmt.Errorf("invalid epic URL %q.", arg)
+	}
+
+	iid, err := strconv.ParseInt(strings.TrimPrefix(arg, "&"), 10, 64)
+	if err != nil || iid <= 0 {
+		return 0, "", fmt.Errorf("invalid epic %q. Use an epic ID, like 12 or &12, or an epic URL.", arg)
+	}
+	return iid, group, nil
+}
+
+// EpicFromArg returns the epic that arg refers to, and its group.
+func EpicFromArg(client *gitlab.Client, group, arg string) (*gitlab.Epic, string, error) {
+	iid, group, err := ParseEpicArg(arg, group)
+	if err != nil {
+		return nil, "", err
+	}
+
+	epic, _, err := client.Epics.GetEpic(group, iid)
+	if err != nil {
+		return nil, "", fmt.Errorf("error getting epic &%d of %s: %w", iid, group, err)
+	}
+	return epic, group, nil
+}
+
+// ParseDate parses a date in YYYY-MM-DD format.
+func ParseDate(s string) (*gitlab.ISOTime, error) {
+	d, err := gitlab.ParseISOTime(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q. Use the YYYY-MM-DD format.", s)
+	}
+	return &d, nil
+}
+
+// EpicState returns the reference of the epic, colored by state.
+func EpicState(c *iostreams.ColorPalette, e *gitlab.Epic) string {
+	ref := fmt.Sprintf("&%d", e.IID)
+	if e.State == "closed" {
+		return c.Red(ref)
+	}
+	return c.Green(ref)
+}
+
+// DisplayEpic returns the epic as one line with its URL on TTYs, and as its URL otherwise.
+func DisplayEpic(c *iostreams.ColorPalette, e *gitlab.Epic, isTTY bool) string {
+	if !isTTY {
+		return e.WebURL
+	}
+
+	ago := ""
+	if e.CreatedAt != nil {
+		ago = " (" + utils.TimeToPrettyTimeAgo(*e.CreatedAt) + ")"
+	}
+	return fmt.Sprintf("%s %s%s\n %s\n", EpicState(c, e), e.Title, ago, e.WebURL)
+}
+
+// FormatDates returns the start and due dates of the epic, like 2026-10-01 → 2026-12-31.
+func FormatDates(e *gitlab.Epic) string {
+	format := func(d *gitlab.ISOTime) string {
+		if d == nil {
+			return "?"
+		}
+		return d.String()
+	}
+	if e.StartDate == nil && e.DueDate == nil {
+		return ""
+	}
+	return format(e.StartDate) + " → " + format(e.DueDate)
+}
+
+// ResolveEpic returns the client and the epic that arg refers to, with its group. Epics given by
+// URL are in the group of the URL. Other epics are in the given group, or the group of the project.
+func ResolveEpic(apiClient func(repoHost string) (*api.Client, error), baseRepo func() (glrepo.Interface, error), group, arg string) (*gitlab.Client, *gitlab.Epic, string, error) {
+	_, argGroup, err := ParseEpicArg(arg, group)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	client, group, err := cmdutils.GroupFromFlags(apiClient, baseRepo, argGroup)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	epic, group, err := EpicFromArg(client, group, arg)
+	if err != nil {
+		return nil, nil, "", err
+	}
+	return client, epic, group, nil
+}
+
+// ListIssues returns all the issues of the epic.
+func ListIssues(client *gitlab.Client, group string, epicIID int64) ([]*gitlab.Issue, error) {
+	var issues []*gitlab.Issue
+	opts := &gitlab.ListOptions{PerPage: api.MaxPerPage}
+	for {
+		page, resp, err := client.EpicIssues.ListEpicIssues(group, epicIID, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error listing the issues of the epic: %w", err)
+		}
+		issues = append(issues, page...)
+
+		if resp == nil || resp.NextPage == 0 {
+			return issues, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
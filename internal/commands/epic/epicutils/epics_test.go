@@ -0,0 +1,54 @@
+//go:build !integration
+
+package epicutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestParseEpicArg(t *testing.T) {
+	tests := []struct {
+		arg       string
+		wantIID   int64
+		wantGroup string
+		wantErr   string
+	}{
+		{arg: "12", wantIID: 12, wantGroup: "plan"},
+		{arg: "&12", wantIID: 12, wantGroup: "plan"},
+		{arg: "https://gitlab.com/groups/org/sub/-/epics/7", wantIID: 7, wantGroup: "org/sub"},
+		{arg: "https://gitlab.example.com/groups/org/epics/7/", wantIID: 7, wantGroup: "org"},
+		{arg: "https://gitlab.com/org/app/-/issues/7", wantErr: `invalid epic URL "https://gitlab.com/org/app/-/issues/7".`},
+		{arg: "abc", wantErr: `invalid epic "abc". Use an epic ID, like 12 or &12, or an epic URL.`},
+		{arg: "0", wantErr: `invalid epic "0". Use an epic ID, like 12 or &12, or an epic URL.`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			iid, group, err := ParseEpicArg(tt.arg, "plan")
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantIID, iid)
+			assert.Equal(t, tt.wantGroup, group)
+		})
+	}
+}
+
+func TestFormatDates(t *testing.T) {
+	start, _ := ParseDate("2026-10-01")
+	due, _ := ParseDate("2026-12-31")
+
+	assert.Equal(t, "", FormatDates(&gitlab.Epic{}))
+	assert.Equal(t, "2026-10-01 → 2026-12-31", FormatDates(&gitlab.Epic{StartDate: start, DueDate: due}))
+	assert.Equal(t, "? → 2026-12-31", FormatDates(&gitlab.Epic{DueDate: due}))
+
+	_, err := ParseDate("31/12/2026")
+	require.EqualError(t, err, `invalid date "31/12/2026". Use the YYYY-MM-DD format.`)
+}
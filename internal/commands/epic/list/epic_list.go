@@ -0,0 +1,176 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io        *iostreams.IOStreams
+	apiClient func(repoHost string) (*api.Client, error)
+	baseRepo  func() (glrepo.Interface, error)
+
+	group        string
+	parent       string
+	state        string
+	labels       []string
+	search       string
+	descendants  bool
+	page         int
+	perPage      int
+	outputFormat string
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+	}
+
+	epicListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the epics of a group.`,
+		Long:    ``,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab epic list
+			$ glab epic list -g mygroup --label roadmap --state all
+			$ glab epic list -g mygroup --parent 12
+			$ glab epic list -g mygroup -F json
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := epicListCmd.Flags()
+	fl.StringVarP(&opts.group, "group", "g", "", "List the epics of a group. Defaults to the group of the project.")
+	fl.StringVar(&opts.parent, "parent", "", "List the child epics of an epic.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"opened", "closed", "all"}, "opened", &opts.state), "state", "s", "Filter by state: opened, closed, all.")
+	fl.StringSliceVarP(&opts.labels, "label", "l", []string{}, "Filter by labels. Multiple labels can be comma-separated or specified by repeating the flag.")
+	fl.StringVar(&opts.search, "search", "", "Search in the title and description.")
+	fl.BoolVar(&opts.descendants, "include-descendants", false, "Include the epics of subgroups.")
+	fl.IntVarP(&opts.page, "page", "p", 1, "Page number.")
+	fl.IntVarP(&opts.perPage, "per-page", "P", 30, "Number of items to list per page.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	epicListCmd.MarkFlagsMutuallyExclusive("parent", "search")
+	epicListCmd.MarkFlagsMutuallyExclusive("parent", "include-descendants")
+
+	return epicListCmd
+}
+
+func (o *options) run() error {
+	client, group, err := cmdutils.GroupFromFlags(o.apiClient, o.baseRepo, o.group)
+	if err != nil {
+		return err
+	}
+
+	var epics []*gitlab.Epic
+	title := "in " + group
+	if o.parent != "" {
+		var parent *gitlab.Epic
+		parent, group, err = epicutils.EpicFromArg(client, group, o.parent)
+		if err != nil {
+			return err
+		}
+		epics, err = o.children(client, group, parent)
+		title = fmt.Sprintf("under &%d", parent.IID)
+	} else {
+		epics, err = o.list(client, group)
+	}
+	if err != nil {
+		return err
+	}
+
+	if o.outputFormat == "json" {
+		data, err := json.Marshal(epics)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(o.io.StdOut, string(data))
+		return nil
+	}
+
+	if len(epics) == 0 {
+		fmt.Fprintf(o.io.StdErr, "No epics match your search %s.\n", title)
+		return nil
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "Showing %s %s.\n\n", utils.Pluralize(len(epics), "epic"), title)
+
+	table := tableprinter.NewTablePrinter()
+	for _, e := range epics {
+		labels := ""
+		if len(e.Labels) > 0 {
+			labels = c.Cyan("(" + strings.Join(e.Labels, ", ") + ")")
+		}
+		table.AddRow(epicutils.EpicState(c, e), e.Title, labels, c.Gray(epicutils.FormatDates(e)))
+	}
+	fmt.Fprint(o.io.StdOut, table.Render())
+	return nil
+}
+
+func (o *options) list(client *gitlab.Client, group string) ([]*gitlab.Epic, error) {
+	listOpts := &gitlab.ListGroupEpicsOptions{
+		ListOptions: gitlab.ListOptions{Page: int64(o.page), PerPage: int64(o.perPage)},
+		State:       gitlab.Ptr(o.state),
+	}
+	if len(o.labels) > 0 {
+		listOpts.Labels = (*gitlab.LabelOptions)(&o.labels)
+	}
+	if o.search != "" {
+		listOpts.Search = gitlab.Ptr(o.search)
+	}
+	if o.descendants {
+		listOpts.IncludeDescendantGroups = gitlab.Ptr(true)
+	}
+
+	epics, _, err := client.Epics.ListGroupEpics(group, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("error listing epics: %w", err)
+	}
+	return epics, nil
+}
+
+// children returns the child epics of the parent that match the state and label filters. The API
+// returns all the children at once, and can't filter them.
+func (o *options) children(client *gitlab.Client, group string, parent *gitlab.Epic) ([]*gitlab.Epic, error) {
+	children, _, err := client.Epics.GetEpicLinks(group, parent.IID)
+	if err != nil {
+		return nil, fmt.Errorf("error listing child epics: %w", err)
+	}
+
+	epics := []*gitlab.Epic{}
+	for _, e := range children {
+		if o.state != "all" && e.State != o.state {
+			continue
+		}
+		if len(utils.CommonElementsInStringSlice(e.Labels, o.labels)) != len(o.labels) {
+			continue
+		}
+		epics = append(epics, e)
+	}
+	return epics, nil
+}
@@ -0,0 +1,89 @@
+//go:build !integration
+
+package list
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestEpicList(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{Namespace: &gitlab.ProjectNamespace{Kind: "group", FullPath: "plan"}}, nil, nil)
+	tc.MockEpics.EXPECT().
+		ListGroupEpics("plan", gomock.Any()).
+		DoAndReturn(func(gid any, opts *gitlab.ListGroupEpicsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Epic, *gitlab.Response, error) {
+			assert.Equal(t, "opened", *opts.State)
+			assert.Equal(t, gitlab.LabelOptions{"roadmap"}, *opts.Labels)
+			return []*gitlab.Epic{
+				{IID: 12, Title: "Self-serve billing", State: "opened", Labels: []string{"roadmap"}},
+				{IID: 13, Title: "Invoices", State: "opened", Labels: []string{"roadmap"}},
+			}, nil, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("--label roadmap")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "Showing 2 epics in plan.\n")
+	assert.Contains(t, out.String(), "&12")
+	assert.Contains(t, out.String(), "Self-serve billing")
+	assert.Contains(t, out.String(), "(roadmap)")
+}
+
+func TestEpicList_parentJSON(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockEpics.EXPECT().
+		GetEpic("plan", int64(12)).
+		Return(&gitlab.Epic{ID: 500, IID: 12}, nil, nil)
+	tc.MockEpics.EXPECT().
+		GetEpicLinks("plan", int64(12)).
+		Return([]*gitlab.Epic{
+			{IID: 20, Title: "Open child", State: "opened"},
+			{IID: 21, Title: "Closed child", State: "closed"},
+		}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("-g plan --parent 12 -F json")
+	require.NoError(t, err)
+
+	var epics []*gitlab.Epic
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &epics))
+	require.Len(t, epics, 1)
+	assert.Equal(t, int64(20), epics[0].IID)
+}
+
+func TestEpicList_personalProject(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{PathWithNamespace: "OWNER/REPO", Namespace: &gitlab.ProjectNamespace{Kind: "user", FullPath: "OWNER"}}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	_, err := exec("")
+	require.EqualError(t, err, "project OWNER/REPO is not in a group. Use --group to select a group.")
+}
@@ -0,0 +1,80 @@
+package removeissue
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+func NewCmdRemoveIssue(f cmdutils.Factory) *cobra.Command {
+	var group string
+
+	epicRemoveIssueCmd := &cobra.Command{
+		Use:   "remove-issue <epic> <issue> [<issue>...] [flags]",
+		Short: `Remove issues from an epic.`,
+		Long: heredoc.Doc(`
+			Remove issues from an epic. Issues are given by ID, in the current project, or by URL.
+		`),
+		Example: heredoc.Doc(`
+			$ glab epic remove-issue 12 42
+			$ glab epic remove-issue 12 https://gitlab.com/OWNER/REPO/-/issues/42 -g mygroup
+		`),
+		Args: cobra.MinimumNArgs(2),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, epic, group, err := epicutils.ResolveEpic(f.ApiClient, f.BaseRepo, group, args[0])
+			if err != nil {
+				return err
+			}
+
+			// Issues are removed by the ID of their link to the epic, which only the list has.
+			issues, err := epicutils.ListIssues(client, group, epic.IID)
+			if err != nil {
+				return err
+			}
+			links := map[int64]int64{}
+			for _, issue := range issues {
+				links[issue.ID] = issue.EpicIssueID
+			}
+
+			c := f.IO().Color()
+			issueArgs := args[1:]
+			failed := 0
+			for _, arg := range issueArgs {
+				issue, _, err := issueutils.IssueFromArg(f.ApiClient, client, f.BaseRepo, f.DefaultHostname(), arg)
+				if err == nil {
+					linkID, ok := links[issue.ID]
+					if !ok {
+						err = fmt.Errorf("issue #%d is not in epic &%d.", issue.IID, epic.IID)
+					} else {
+						_, _, err = client.EpicIssues.RemoveEpicIssue(group, epic.IID, linkID)
+					}
+				}
+				if err != nil {
+					failed++
+					fmt.Fprintf(f.IO().StdErr, "%s %s: %s\n", c.FailedIcon(), arg, err)
+					continue
+				}
+				fmt.Fprintf(f.IO().StdOut, "%s Removed #%d from epic &%d\n", c.RedCheck(), issue.IID, epic.IID)
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("failed to remove %d of %s.", failed, utils.Pluralize(len(issueArgs), "issue"))
+			}
+			return nil
+		},
+	}
+
+	epicRemoveIssueCmd.Flags().StringVarP(&group, "group", "g", "", "Group of the epic. Defaults to the group of the project.")
+
+	return epicRemoveIssueCmd
+}
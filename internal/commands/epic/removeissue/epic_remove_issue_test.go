@@ -0,0 +1,47 @@
+//go:build !integration
+
+package removeissue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestEpicRemoveIssue(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockEpics.EXPECT().
+		GetEpic("plan", int64(12)).
+		Return(&gitlab.Epic{ID: 500, IID: 12}, nil, nil)
+	tc.MockEpicIssues.EXPECT().
+		ListEpicIssues("plan", int64(12), gomock.Any()).
+		Return([]*gitlab.Issue{{ID: 4200, IID: 42, EpicIssueID: 77}}, &gitlab.Response{}, nil)
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(42), gomock.Any()).
+		Return(&gitlab.Issue{ID: 4200, IID: 42}, nil, nil)
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(43), gomock.Any()).
+		Return(&gitlab.Issue{ID: 4300, IID: 43}, nil, nil)
+	tc.MockEpicIssues.EXPECT().
+		RemoveEpicIssue("plan", int64(12), int64(77)).
+		Return(&gitlab.EpicIssueAssignment{ID: 77}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdRemoveIssue, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("12 42 43 -g plan")
+	require.EqualError(t, err, "failed to remove 1 of 2 issues.")
+
+	assert.Contains(t, out.String(), "Removed #42 from epic &12\n")
+	assert.Contains(t, out.Stderr(), "43: issue #43 is not in epic &12.\n")
+}
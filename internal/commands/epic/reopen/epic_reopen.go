@@ -0,0 +1,57 @@
+package reopen
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func NewCmdReopen(f cmdutils.Factory) *cobra.Command {
+	var group string
+
+	epicReopenCmd := &cobra.Command{
+		Use:   "reopen <id> [<id>...] [flags]",
+		Short: `Reopen closed epics.`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			$ glab epic reopen 12
+			$ glab epic reopen 12 13 -g mygroup
+			$ glab epic reopen https://gitlab.com/groups/mygroup/-/epics/12
+		`),
+		Args: cobra.MinimumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := f.IO().Color()
+
+			for _, arg := range args {
+				client, epic, group, err := epicutils.ResolveEpic(f.ApiClient, f.BaseRepo, group, arg)
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(f.IO().StdOut, "- Reopening epic &%d...\n", epic.IID)
+				epic, _, err = client.Epics.UpdateEpic(group, epic.IID, &gitlab.UpdateEpicOptions{StateEvent: gitlab.Ptr("reopen")})
+				if err != nil {
+					return err
+				}
+
+				fmt.Fprintf(f.IO().StdOut, "%s Reopened epic &%d\n", c.GreenCheck(), epic.IID)
+				fmt.Fprintln(f.IO().StdOut, epicutils.DisplayEpic(c, epic, f.IO().IsaTTY))
+			}
+			return nil
+		},
+	}
+
+	epicReopenCmd.Flags().StringVarP(&group, "group", "g", "", "Group of the epics. Defaults to the group of the project.")
+
+	return epicReopenCmd
+}
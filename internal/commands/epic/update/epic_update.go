@@ -0,0 +1,154 @@
+package update
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
+	var group string
+
+	epicUpdateCmd := &cobra.Command{
+		Use:   "update <id> [flags]",
+		Short: `Update an epic.`,
+		Long: heredoc.Docf(`
+			Update the title, description, labels, dates, or parent of an epic.
+
+			Set %[1]s--start-date%[1]s or %[1]s--due-date%[1]s to an empty string to inherit the date from the
+			milestones of the epic again.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab epic update 12 --title "Self-serve billing v2"
+			$ glab epic update 12 --label q4 --unlabel backlog
+			$ glab epic update 12 -g mygroup --due-date 2026-12-15 --parent 3
+			$ glab epic update 12 --start-date ""
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("confidential") && flags.Changed("public") {
+				return &cmdutils.FlagError{Err: errors.New("--public and --confidential can't be used together.")}
+			}
+
+			var actions []string
+			l := &gitlab.UpdateEpicOptions{}
+
+			if m, _ := flags.GetString("title"); m != "" {
+				actions = append(actions, fmt.Sprintf("updated title to %q", m))
+				l.Title = gitlab.Ptr(m)
+			}
+			if flags.Changed("description") {
+				m, _ := flags.GetString("description")
+				actions = append(actions, "updated description")
+				l.Description = gitlab.Ptr(m)
+			}
+			if m, _ := flags.GetStringSlice("label"); len(m) != 0 {
+				actions = append(actions, fmt.Sprintf("added labels %s", strings.Join(m, " ")))
+				l.AddLabels = (*gitlab.LabelOptions)(&m)
+			}
+			if m, _ := flags.GetStringSlice("unlabel"); len(m) != 0 {
+				actions = append(actions, fmt.Sprintf("removed labels %s", strings.Join(m, " ")))
+				l.RemoveLabels = (*gitlab.LabelOptions)(&m)
+			}
+			if m, _ := flags.GetBool("confidential"); m {
+				actions = append(actions, "made confidential")
+				l.Confidential = gitlab.Ptr(true)
+			}
+			if m, _ := flags.GetBool("public"); m {
+				actions = append(actions, "made public")
+				l.Confidential = gitlab.Ptr(false)
+			}
+			if flags.Changed("start-date") {
+				m, _ := flags.GetString("start-date")
+				if m == "" {
+					actions = append(actions, "inherited start date from milestones")
+					l.StartDateIsFixed = gitlab.Ptr(false)
+				} else {
+					date, err := epicutils.ParseDate(m)
+					if err != nil {
+						return &cmdutils.FlagError{Err: fmt.Errorf("--start-date: %w", err)}
+					}
+					actions = append(actions, fmt.Sprintf("set start date to %s", m))
+					l.StartDateIsFixed = gitlab.Ptr(true)
+					l.StartDateFixed = date
+				}
+			}
+			if flags.Changed("due-date") {
+				m, _ := flags.GetString("due-date")
+				if m == "" {
+					actions = append(actions, "inherited due date from milestones")
+					l.DueDateIsFixed = gitlab.Ptr(false)
+				} else {
+					date, err := epicutils.ParseDate(m)
+					if err != nil {
+						return &cmdutils.FlagError{Err: fmt.Errorf("--due-date: %w", err)}
+					}
+					actions = append(actions, fmt.Sprintf("set due date to %s", m))
+					l.DueDateIsFixed = gitlab.Ptr(true)
+					l.DueDateFixed = date
+				}
+			}
+
+			parentArg, _ := flags.GetString("parent")
+			if len(actions) == 0 && parentArg == "" {
+				return &cmdutils.FlagError{Err: errors.New("specify at least one change, like --title or --label.")}
+			}
+
+			client, epic, group, err := epicutils.ResolveEpic(f.ApiClient, f.BaseRepo, group, args[0])
+			if err != nil {
+				return err
+			}
+
+			if parentArg != "" {
+				parent, _, err := epicutils.EpicFromArg(client, group, parentArg)
+				if err != nil {
+					return err
+				}
+				actions = append(actions, fmt.Sprintf("set parent epic to &%d", parent.IID))
+				l.ParentID = gitlab.Ptr(parent.ID)
+			}
+
+			out := f.IO().StdOut
+			c := f.IO().Color()
+			fmt.Fprintf(out, "- Updating epic &%d\n", epic.IID)
+
+			epic, _, err = client.Epics.UpdateEpic(group, epic.IID, l)
+			if err != nil {
+				return err
+			}
+
+			for _, s := range actions {
+				fmt.Fprintln(out, c.GreenCheck(), s)
+			}
+			fmt.Fprintln(out, epicutils.DisplayEpic(c, epic, f.IO().IsaTTY))
+			return nil
+		},
+	}
+
+	fl := epicUpdateCmd.Flags()
+	fl.StringVarP(&group, "group", "g", "", "Group of the epic. Defaults to the group of the project.")
+	fl.StringP("title", "t", "", "Title of the epic.")
+	fl.StringP("description", "d", "", "Description of the epic.")
+	fl.StringSliceP("label", "l", []string{}, "Add labels.")
+	fl.StringSliceP("unlabel", "u", []string{}, "Remove labels.")
+	fl.String("start-date", "", "Start date in YYYY-MM-DD format.")
+	fl.String("due-date", "", "Due date in YYYY-MM-DD format.")
+	fl.String("parent", "", "Move the epic under this epic.")
+	fl.BoolP("confidential", "c", false, "Make the epic confidential.")
+	fl.BoolP("public", "p", false, "Make the epic public.")
+
+	return epicUpdateCmd
+}
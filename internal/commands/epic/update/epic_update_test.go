@@ -0,0 +1,56 @@
+//go:build !integration
+
+package update
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestEpicUpdate(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockEpics.EXPECT().
+		GetEpic("plan", int64(12)).
+		Return(&gitlab.Epic{ID: 500, IID: 12}, nil, nil)
+	tc.MockEpics.EXPECT().
+		UpdateEpic("plan", int64(12), gomock.Any()).
+		DoAndReturn(func(gid any, epic int64, opts *gitlab.UpdateEpicOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Epic, *gitlab.Response, error) {
+			assert.Equal(t, gitlab.LabelOptions{"q4"}, *opts.AddLabels)
+			assert.Equal(t, gitlab.LabelOptions{"backlog"}, *opts.RemoveLabels)
+			assert.False(t, *opts.StartDateIsFixed)
+			assert.True(t, *opts.DueDateIsFixed)
+			assert.Equal(t, "2026-12-15", opts.DueDateFixed.String())
+			assert.Nil(t, opts.Title)
+			return &gitlab.Epic{IID: 12, Title: "Billing", State: "opened", WebURL: "https://gitlab.com/groups/plan/-/epics/12"}, nil, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdUpdate, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec(`12 -g plan --label q4 --unlabel backlog --start-date "" --due-date 2026-12-15`)
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "- Updating epic &12\n")
+	assert.Contains(t, out.String(), "added labels q4\n")
+	assert.Contains(t, out.String(), "removed labels backlog\n")
+	assert.Contains(t, out.String(), "inherited start date from milestones\n")
+	assert.Contains(t, out.String(), "set due date to 2026-12-15\n")
+}
+
+func TestEpicUpdate_noChange(t *testing.T) {
+	exec := cmdtest.SetupCmdForTest(t, NewCmdUpdate, false)
+
+	_, err := exec("12 -g plan")
+	require.EqualError(t, err, "specify at least one change, like --title or --label.")
+}
@@ -0,0 +1,172 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/epic/epicutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// EpicWithChildren is the epic with its child epics and issues, as printed in JSON.
+type EpicWithChildren struct {
+	*gitlab.Epic
+	ChildEpics []*gitlab.Epic  `json:"child_epics"`
+	Issues     []*gitlab.Issue `json:"issues"`
+}
+
+type options struct {
+	io        *iostreams.IOStreams
+	apiClient func(repoHost string) (*api.Client, error)
+	baseRepo  func() (glrepo.Interface, error)
+	config    func() config.Config
+
+	group        string
+	web          bool
+	outputFormat string
+}
+
+func NewCmdView(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+		config:    f.Config,
+	}
+
+	epicViewCmd := &cobra.Command{
+		Use:     "view <id> [flags]",
+		Short:   `Display the title, description, child epics, and issues of an epic.`,
+		Long:    ``,
+		Aliases: []string{"show"},
+		Example: heredoc.Doc(`
+			$ glab epic view 12
+			$ glab epic view 12 -g mygroup -F json
+			$ glab epic view https://gitlab.com/groups/mygroup/-/epics/12
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(args[0])
+		},
+	}
+
+	epicViewCmd.Flags().StringVarP(&opts.group, "group", "g", "", "Group of the epic. Defaults to the group of the project.")
+	epicViewCmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open the epic in a browser. Uses the default browser, or the browser specified in the $BROWSER variable.")
+	epicViewCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return epicViewCmd
+}
+
+func (o *options) run(arg string) error {
+	client, epic, group, err := epicutils.ResolveEpic(o.apiClient, o.baseRepo, o.group, arg)
+	if err != nil {
+		return err
+	}
+
+	if o.web {
+		if o.io.IsaTTY && o.io.IsErrTTY {
+			fmt.Fprintf(o.io.StdErr, "Opening %s in your browser.\n", utils.DisplayURL(epic.WebURL))
+		}
+		browser, _ := o.config().Get("", "browser")
+		return utils.OpenInBrowser(epic.WebURL, browser)
+	}
+
+	children, _, err := client.Epics.GetEpicLinks(group, epic.IID)
+	if err != nil {
+		return fmt.Errorf("error listing child epics: %w", err)
+	}
+	issues, err := epicutils.ListIssues(client, group, epic.IID)
+	if err != nil {
+		return err
+	}
+
+	view := &EpicWithChildren{Epic: epic, ChildEpics: children, Issues: issues}
+
+	if o.outputFormat == "json" {
+		data, err := json.Marshal(view)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(o.io.StdOut, string(data))
+		return nil
+	}
+
+	o.print(view)
+	return nil
+}
+
+func (o *options) print(v *EpicWithChildren) {
+	c := o.io.Color()
+	out := o.io.StdOut
+	isTTY := o.io.IsaTTY
+
+	state := c.Green("open")
+	if v.State == "closed" {
+		state = c.Red("closed")
+	}
+	fmt.Fprint(out, state)
+	if v.Author != nil && v.CreatedAt != nil {
+		fmt.Fprint(out, c.Gray(fmt.Sprintf(" • opened by %s %s", v.Author.Username, utils.TimeToPrettyTimeAgo(*v.CreatedAt))))
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "%s %s\n", c.Bold(v.Title), c.Gray(fmt.Sprintf("&%d", v.IID)))
+
+	if v.Description != "" {
+		description := v.Description
+		if isTTY {
+			description, _ = utils.RenderMarkdown(description, o.io.BackgroundColor())
+		}
+		fmt.Fprintln(out, description)
+	}
+	fmt.Fprintln(out)
+
+	if dates := epicutils.FormatDates(v.Epic); dates != "" {
+		fmt.Fprintf(out, "%s %s\n", c.Bold("Dates:"), dates)
+	}
+	if len(v.Labels) > 0 {
+		fmt.Fprintf(out, "%s %s\n", c.Bold("Labels:"), strings.Join(v.Labels, ", "))
+	}
+	if v.ParentID != 0 {
+		fmt.Fprintf(out, "%s %d\n", c.Bold("Parent epic ID:"), v.ParentID)
+	}
+
+	if len(v.ChildEpics) > 0 {
+		fmt.Fprintf(out, "\n%s\n", c.Bold(fmt.Sprintf("Child epics (%d)", len(v.ChildEpics))))
+		for _, e := range v.ChildEpics {
+			fmt.Fprintf(out, "  %s %s\n", epicutils.EpicState(c, e), e.Title)
+		}
+	}
+
+	if len(v.Issues) > 0 {
+		fmt.Fprintf(out, "\n%s\n", c.Bold(fmt.Sprintf("Issues (%d)", len(v.Issues))))
+		for _, i := range v.Issues {
+			ref := fmt.Sprintf("#%d", i.IID)
+			if i.References != nil && i.References.Full != "" {
+				ref = i.References.Full
+			}
+			if i.State == "closed" {
+				ref = c.Red(ref)
+			} else {
+				ref = c.Green(ref)
+			}
+			fmt.Fprintf(out, "  %s %s\n", ref, i.Title)
+		}
+	}
+
+	fmt.Fprintf(out, "\n%s\n", c.Gray("View this epic on GitLab: "+v.WebURL))
+}
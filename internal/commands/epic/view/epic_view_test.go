@@ -0,0 +1,87 @@
+//go:build !integration
+
+package view
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func setup(t *testing.T) *gitlabtesting.TestClient {
+	tc := gitlabtesting.NewTestClient(t)
+
+	due, _ := gitlab.ParseISOTime("2026-12-31")
+	tc.MockEpics.EXPECT().
+		GetEpic("org/plan", int64(12)).
+		Return(&gitlab.Epic{
+			ID: 500, IID: 12, Title: "Self-serve billing", State: "opened",
+			Description: "Let customers pay by card.",
+			DueDate:     &due,
+			Labels:      []string{"roadmap"},
+			WebURL:      "https://gitlab.com/groups/org/plan/-/epics/12",
+		}, nil, nil)
+	tc.MockEpics.EXPECT().
+		GetEpicLinks("org/plan", int64(12)).
+		Return([]*gitlab.Epic{{IID: 20, Title: "Invoices", State: "opened"}}, nil, nil)
+	tc.MockEpicIssues.EXPECT().
+		ListEpicIssues("org/plan", int64(12), gomock.Any()).
+		Return([]*gitlab.Issue{
+			{IID: 42, Title: "Card form", State: "closed", References: &gitlab.IssueReferences{Full: "org/app#42"}},
+		}, &gitlab.Response{}, nil)
+
+	return tc
+}
+
+func TestEpicView(t *testing.T) {
+	tc := setup(t)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdView, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("https://gitlab.com/groups/org/plan/-/epics/12")
+	require.NoError(t, err)
+
+	assert.Equal(t, `open
+Self-serve billing &12
+Let customers pay by card.
+
+Dates: ? → 2026-12-31
+Labels: roadmap
+
+Child epics (1)
+  &20 Invoices
+
+Issues (1)
+  org/app#42 Card form
+
+View this epic on GitLab: https://gitlab.com/groups/org/plan/-/epics/12
+`, out.String())
+}
+
+func TestEpicView_JSON(t *testing.T) {
+	tc := setup(t)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdView, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("&12 -g org/plan -F json")
+	require.NoError(t, err)
+
+	var view map[string]any
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &view))
+	assert.Equal(t, "Self-serve billing", view["title"])
+	assert.Len(t, view["child_epics"], 1)
+	assert.Len(t, view["issues"], 1)
+}
@@ -0,0 +1,163 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/incident/incidentutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	title        string
+	description  string
+	labels       []string
+	assignees    []string
+	severity     string
+	confidential bool
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	incidentCreateCmd := &cobra.Command{
+		Use:     "create [flags]",
+		Short:   `Create an incident.`,
+		Aliases: []string{"new"},
+		Long: heredoc.Docf(`
+			Create an incident, optionally with a severity. The severity is one of %[1]scritical%[1]s,
+			%[1]shigh%[1]s, %[1]smedium%[1]s, %[1]slow%[1]s, or %[1]sunknown%[1]s, which is the default.
+
+			When running interactively, you're prompted for the title if it's not given.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab incident create --title "Checkout is down" --severity critical
+			$ glab incident create -t "Slow search" -d "p99 latency is over 5s" -l search -a oncall
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.complete(cmd.Context()); err != nil {
+				return err
+			}
+			return opts.run()
+		},
+	}
+
+	fl := incidentCreateCmd.Flags()
+	fl.StringVarP(&opts.title, "title", "t", "", "Incident title.")
+	fl.StringVarP(&opts.description, "description", "d", "", "Incident description.")
+	fl.StringSliceVarP(&opts.labels, "label", "l", []string{}, "Add labels by name. Multiple labels can be comma-separated or specified by repeating the flag.")
+	fl.StringSliceVarP(&opts.assignees, "assignee", "a", []string{}, "Assign the incident to users by username. Multiple usernames can be comma-separated or specified by repeating the flag.")
+	fl.StringVar(&opts.severity, "severity", "", "Severity of the incident: "+strings.Join(incidentutils.Severities, ", ")+".")
+	fl.BoolVarP(&opts.confidential, "confidential", "c", false, "Make the incident confidential.")
+
+	return incidentCreateCmd
+}
+
+func (o *options) complete(ctx context.Context) error {
+	if o.severity != "" {
+		severity, err := incidentutils.ParseSeverity(o.severity)
+		if err != nil {
+			return &cmdutils.FlagError{Err: err}
+		}
+		o.severity = severity
+	}
+
+	if o.title != "" {
+		return nil
+	}
+	if !o.io.PromptEnabled() {
+		return &cmdutils.FlagError{Err: errors.New("--title is required when not running interactively.")}
+	}
+
+	err := o.io.Input(ctx, &o.title, "Title", "", func(s string) error {
+		if strings.TrimSpace(s) == "" {
+			return errors.New("the title can't be empty.")
+		}
+		return nil
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "could not prompt")
+	}
+	return nil
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	createOpts := &gitlab.CreateIssueOptions{
+		Title:        gitlab.Ptr(o.title),
+		IssueType:    gitlab.Ptr(string(issuable.TypeIncident)),
+		Confidential: gitlab.Ptr(o.confidential),
+	}
+	if o.description != "" {
+		createOpts.Description = gitlab.Ptr(o.description)
+	}
+	if len(o.labels) > 0 {
+		createOpts.Labels = (*gitlab.LabelOptions)(&o.labels)
+	}
+	if len(o.assignees) > 0 {
+		users, err := api.UsersByNames(client, o.assignees)
+		if err != nil {
+			return err
+		}
+		ids := make([]int64, 0, len(users))
+		for _, u := range users {
+			ids = append(ids, u.ID)
+		}
+		createOpts.AssigneeIDs = &ids
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdErr, "- Creating incident in %s\n", repo.FullName())
+
+	incident, _, err := client.Issues.CreateIssue(repo.FullName(), createOpts)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create incident")
+	}
+
+	// Incidents are created with the unknown severity. The REST API can't set it.
+	var severityErr error
+	if o.severity != "" {
+		severityErr = incidentutils.SetSeverity(client, repo.FullName(), incident.IID, o.severity)
+	}
+
+	fmt.Fprintln(o.io.StdOut, issueutils.DisplayIssue(c, incident, o.io.IsaTTY))
+
+	if severityErr != nil {
+		return fmt.Errorf("the incident was created, but its severity could not be set: %w", severityErr)
+	}
+	return nil
+}
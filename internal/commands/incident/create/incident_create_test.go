@@ -0,0 +1,66 @@
+//go:build !integration
+
+package create
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestIncidentCreate(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockIssues.EXPECT().
+		CreateIssue("OWNER/REPO", gomock.Any()).
+		DoAndReturn(func(pid any, opts *gitlab.CreateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+			assert.Equal(t, "Checkout is down", *opts.Title)
+			assert.Equal(t, "incident", *opts.IssueType)
+			assert.Equal(t, gitlab.LabelOptions{"checkout"}, *opts.Labels)
+			return &gitlab.Issue{
+				IID:       12,
+				Title:     "Checkout is down",
+				State:     "opened",
+				IssueType: gitlab.Ptr("incident"),
+				WebURL:    "https://gitlab.com/OWNER/REPO/-/issues/incident/12",
+				CreatedAt: gitlab.Ptr(time.Now()),
+			}, nil, nil
+		})
+	cmdtest.ExpectGraphQL(tc, `{"data": {"issueSetSeverity": {"errors": []}}}`,
+		cmdtest.GraphQLVariables(t, map[string]any{"projectPath": "OWNER/REPO", "iid": "12", "severity": "CRITICAL"}))
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec(`-t "Checkout is down" -l checkout --severity Critical`)
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "https://gitlab.com/OWNER/REPO/-/issues/incident/12")
+	assert.Equal(t, "- Creating incident in OWNER/REPO\n", out.Stderr())
+}
+
+func TestIncidentCreate_flags(t *testing.T) {
+	tests := []struct {
+		args    string
+		wantErr string
+	}{
+		{args: `-t "Outage" --severity urgent`, wantErr: `invalid severity "urgent". Use one of: critical, high, medium, low, unknown.`},
+		{args: `--severity high`, wantErr: "--title is required when not running interactively."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false)
+
+			_, err := exec(tt.args)
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
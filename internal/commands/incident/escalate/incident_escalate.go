@@ -0,0 +1,65 @@
+package escalate
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/incident/incidentutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func NewCmdEscalate(f cmdutils.Factory) *cobra.Command {
+	var status string
+
+	incidentEscalateCmd := &cobra.Command{
+		Use:   "escalate <id> [flags]",
+		Short: `Set the escalation status of an incident.`,
+		Long: heredoc.Docf(`
+			Set the escalation status of an incident. The status is one of: %s.
+
+			Triggering an incident pages the on-call responders of its escalation policy.
+			Acknowledge the incident to stop the escalation while you work on it.
+		`, strings.Join(incidentutils.EscalationStatuses, ", ")),
+		Example: heredoc.Doc(`
+			$ glab incident escalate 123
+			$ glab incident escalate 123 --status acknowledged
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			status = strings.ToLower(status)
+			if !slices.Contains(incidentutils.EscalationStatuses, status) {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid status %q. Use one of: %s.", status, strings.Join(incidentutils.EscalationStatuses, ", "))}
+			}
+
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+
+			incident, repo, err := incidentutils.IncidentFromArg(f.ApiClient, client, f.BaseRepo, f.DefaultHostname(), args[0])
+			if err != nil {
+				return err
+			}
+
+			err = incidentutils.SetEscalationStatus(client, repo.FullName(), incident.IID, status)
+			if err != nil {
+				return fmt.Errorf("error setting the escalation status of incident #%d: %w", incident.IID, err)
+			}
+
+			fmt.Fprintf(f.IO().StdOut, "%s Set escalation status of incident #%d to %s\n", f.IO().Color().GreenCheck(), incident.IID, status)
+			return nil
+		},
+	}
+
+	incidentEscalateCmd.Flags().StringVarP(&status, "status", "s", "triggered", "Escalation status: "+strings.Join(incidentutils.EscalationStatuses, ", ")+".")
+
+	return incidentEscalateCmd
+}
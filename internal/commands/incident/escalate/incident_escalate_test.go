@@ -0,0 +1,79 @@
+//go:build !integration
+
+package escalate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestIncidentEscalate(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       string
+		wantStatus string
+		response   string
+		wantOut    string
+		wantErr    string
+	}{
+		{
+			name:       "trigger by default",
+			args:       "12",
+			wantStatus: "TRIGGERED",
+			response:   `{"data": {"issueSetEscalationStatus": {"errors": []}}}`,
+			wantOut:    "Set escalation status of incident #12 to triggered\n",
+		},
+		{
+			name:       "acknowledge",
+			args:       "12 --status Acknowledged",
+			wantStatus: "ACKNOWLEDGED",
+			response:   `{"data": {"issueSetEscalationStatus": {"errors": []}}}`,
+			wantOut:    "Set escalation status of incident #12 to acknowledged\n",
+		},
+		{
+			name:       "mutation error",
+			args:       "12 -s resolved",
+			wantStatus: "RESOLVED",
+			response:   `{"data": {"issueSetEscalationStatus": {"errors": ["Escalation status cannot be changed"]}}}`,
+			wantErr:    "error setting the escalation status of incident #12: Escalation status cannot be changed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+
+			tc.MockIssues.EXPECT().
+				GetIssue("OWNER/REPO", int64(12), gomock.Any()).
+				Return(&gitlab.Issue{ID: 500, IID: 12, IssueType: gitlab.Ptr("incident")}, nil, nil)
+			cmdtest.ExpectGraphQL(tc, tt.response, func(query gitlab.GraphQLQuery) {
+				assert.Equal(t, tt.wantStatus, query.Variables["status"])
+			})
+
+			exec := cmdtest.SetupCmdForTest(t, NewCmdEscalate, false, cmdtest.WithGitLabClient(tc.Client))
+
+			out, err := exec(tt.args)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, out.String(), tt.wantOut)
+		})
+	}
+}
+
+func TestIncidentEscalate_invalidStatus(t *testing.T) {
+	exec := cmdtest.SetupCmdForTest(t, NewCmdEscalate, false)
+
+	_, err := exec("12 --status paged")
+	require.EqualError(t, err, `invalid status "paged". Use one of: triggered, acknowledged, resolved, ignored.`)
+}
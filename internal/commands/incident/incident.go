@@ -6,10 +6,14 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	incidentCloseCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/close"
+	incidentCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/create"
+	incidentEscalateCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/escalate"
 	incidentListCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/list"
 	incidentNoteCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/note"
 	incidentReopenCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/reopen"
+	incidentSeverityCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/severity"
 	incidentSubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/subscribe"
+	incidentTimelineCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/timeline"
 	incidentUnsubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/unsubscribe"
 	incidentViewCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/view"
 )
@@ -41,5 +45,9 @@ func NewCmdIncident(f cmdutils.Factory) *cobra.Command {
 	incidentCmd.AddCommand(incidentReopenCmd.NewCmdReopen(f))
 	incidentCmd.AddCommand(incidentSubscribeCmd.NewCmdSubscribe(f))
 	incidentCmd.AddCommand(incidentUnsubscribeCmd.NewCmdUnsubscribe(f))
+	incidentCmd.AddCommand(incidentCreateCmd.NewCmdCreate(f))
+	incidentCmd.AddCommand(incidentSeverityCmd.NewCmdSeverity(f))
+	incidentCmd.AddCommand(incidentEscalateCmd.NewCmdEscalate(f))
+	incidentCmd.AddCommand(incidentTimelineCmd.NewCmdTimeline(f))
 	return incidentCmd
 }
@@ -0,0 +1,298 @@
+package incidentutils
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/commands/issuable"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+// Severities are the severities of incidents, from the most to the least severe.
+var Severities = []string{"critical", "high", "medium", "low", "unknown"}
+
+// EscalationStatuses are the escalation statuses of incidents.
+var EscalationStatuses = []string{"triggered", "acknowledged", "resolved", "ignored"}
+
+// TimelineTags are the tags that GitLab accepts on timeline events.
+var TimelineTags = []string{
+	"Start time",
+	"End time",
+	"Impact detected",
+	"Response initiated",
+	"Impact mitigated",
+	"Cause identified",
+}
+
+const (
+	issueGIDPrefix         = "gid://gitlab/Issue/"
+	timelineEventGIDPrefix = "gid://gitlab/IncidentManagement::TimelineEvent/"
+)
+
+// IncidentFromArg returns the incident that arg refers to, by ID or URL, and its repository.
+// Issues of other types are reported as not found.
+func IncidentFromArg(apiClient func(repoHost string) (*api.Client, error), client *gitlab.Client, baseRepo func() (glrepo.Interface, error), defaultHostname, arg string) (*gitlab.Issue, glrepo.Interface, error) {
+	issue, repo, err := issueutils.IssueFromArg(apiClient, client, baseRepo, defaultHostname, arg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if issue.IssueType == nil || *issue.IssueType != string(issuable.TypeIncident) {
+		return nil, nil, fmt.Errorf("incident #%d not found, but an issue with that ID exists.", issue.IID)
+	}
+	return issue, repo, nil
+}
+
+// ParseSeverity validates the severity, case-insensitively, and returns it in lowercase.
+func ParseSeverity(s string) (string, error) {
+	s = strings.ToLower(s)
+	if !slices.Contains(Severities, s) {
+		return "", fmt.Errorf("invalid severity %q. Use one of: %s.", s, strings.Join(Severities, ", "))
+	}
+	return s, nil
+}
+
+const setSeverityMutation = `
+mutation($projectPath: ID!, $iid: String!, $severity: IssuableSeverity!) {
+  issueSetSeverity(input: {projectPath: $projectPath, iid: $iid, severity: $severity}) {
+    errors
+  }
+}`
+
+// SetSeverity sets the severity of the incident, which the REST API can't change.
+func SetSeverity(client *gitlab.Client, projectPath string, iid int64, severity string) error {
+	variables := map[string]any{
+		"projectPath": projectPath,
+		"iid":         strconv.FormatInt(iid, 10),
+		"severity":    strings.ToUpper(severity),
+	}
+
+	var resp struct {
+		Data struct {
+			IssueSetSeverity struct {
+				Errors []string `json:"errors"`
+			} `json:"issueSetSeverity"`
+		} `json:"data"`
+		api.GraphQLErrors
+	}
+	if _, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: setSeverityMutation, Variables: variables}, &resp); err != nil {
+		return err
+	}
+	if err := resp.Err(); err != nil {
+		return err
+	}
+	return api.MutationErr(resp.Data.IssueSetSeverity.Errors)
+}
+
+const setEscalationStatusMutation = `
+mutation($projectPath: ID!, $iid: String!, $status: IssueEscalationStatus!) {
+  issueSetEscalationStatus(input: {projectPath: $projectPath, iid: $iid, status: $status}) {
+    errors
+  }
+}`
+
+// SetEscalationStatus sets the escalation status of the incident.
+func SetEscalationStatus(client *gitlab.Client, projectPath string, iid int64, status string) error {
+	variables := map[string]any{
+		"projectPath": projectPath,
+		"iid":         strconv.FormatInt(iid, 10),
+		"status":      strings.ToUpper(status),
+	}
+
+	var resp struct {
+		Data struct {
+			IssueSetEscalationStatus struct {
+				Errors []string `json:"errors"`
+			} `json:"issueSetEscalationStatus"`
+		} `json:"data"`
+		api.GraphQLErrors
+	}
+	if _, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: setEscalationStatusMutation, Variables: variables}, &resp); err != nil {
+		return err
+	}
+	if err := resp.Err(); err != nil {
+		return err
+	}
+	return api.MutationErr(resp.Data.IssueSetEscalationStatus.Errors)
+}
+
+// TimelineEvent is an event in the timeline of an incident.
+type TimelineEvent struct {
+	ID         int64     `json:"id"`
+	Note       string    `json:"note"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Action     string    `json:"action"`
+	Tags       []string  `json:"tags"`
+	Author     string    `json:"author"`
+}
+
+const timelineEventFields = `
+  id
+  note
+  occurredAt
+  action
+  author {
+    username
+  }
+  timelineEventTags {
+    nodes {
+      name
+    }
+  }`
+
+type timelineEventNode struct {
+	ID         string    `json:"id"`
+	Note       string    `json:"note"`
+	OccurredAt time.Time `json:"occurredAt"`
+	Action     string    `json:"action"`
+	Author     *struct {
+		Username string `json:"username"`
+	} `json:"author"`
+	TimelineEventTags struct {
+		Nodes []struct {
+			Name string `json:"name"`
+		} `json:"nodes"`
+	} `json:"timelineEventTags"`
+}
+
+func (n *timelineEventNode) event() *TimelineEvent {
+	id, _ := strconv.ParseInt(strings.TrimPrefix(n.ID, timelineEventGIDPrefix), 10, 64)
+	e := &TimelineEvent{
+		ID:         id,
+		Note:       n.Note,
+		OccurredAt: n.OccurredAt,
+		Action:     n.Action,
+		Tags:       []string{},
+	}
+	if n.Author != nil {
+		e.Author = n.Author.Username
+	}
+	for _, t := range n.TimelineEventTags.Nodes {
+		e.Tags = append(e.Tags, t.Name)
+	}
+	return e
+}
+
+const timelineEventsQuery = `
+query($fullPath: ID!, $incidentId: IssueID!) {
+  project(fullPath: $fullPath) {
+    incidentManagementTimelineEvents(incidentId: $incidentId) {
+      nodes {` + timelineEventFields + `
+      }
+    }
+  }
+}`
+
+// ListTimelineEvents returns the timeline events of the incident, in the order they occurred.
+func ListTimelineEvents(client *gitlab.Client, projectPath string, incident *gitlab.Issue) ([]*TimelineEvent, error) {
+	variables := map[string]any{
+		"fullPath":   projectPath,
+		"incidentId": issueGIDPrefix + strconv.FormatInt(incident.ID, 10),
+	}
+
+	var resp struct {
+		Data struct {
+			Project *struct {
+				IncidentManagementTimelineEvents struct {
+					Nodes []timelineEventNode `json:"nodes"`
+				} `json:"incidentManagementTimelineEvents"`
+			} `json:"project"`
+		} `json:"data"`
+		api.GraphQLErrors
+	}
+	if _, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: timelineEventsQuery, Variables: variables}, &resp); err != nil {
+		return nil, fmt.Errorf("error listing timeline events: %w", err)
+	}
+	if err := resp.Err(); err != nil {
+		return nil, fmt.Errorf("error listing timeline events: %w", err)
+	}
+	if resp.Data.Project == nil {
+		return nil, fmt.Errorf("project %s not found.", projectPath)
+	}
+
+	events := []*TimelineEvent{}
+	for _, n := range resp.Data.Project.IncidentManagementTimelineEvents.Nodes {
+		events = append(events, n.event())
+	}
+	slices.SortStableFunc(events, func(a, b *TimelineEvent) int {
+		return a.OccurredAt.Compare(b.OccurredAt)
+	})
+	return events, nil
+}
+
+const createTimelineEventMutation = `
+mutation($incidentId: IssueID!, $note: String!, $occurredAt: Time!, $tags: [String!]) {
+  timelineEventCreate(input: {incidentId: $incidentId, note: $note, occurredAt: $occurredAt, timelineEventTagNames: $tags}) {
+    timelineEvent {` + timelineEventFields + `
+    }
+    errors
+  }
+}`
+
+// CreateTimelineEvent adds an event to the timeline of the incident.
+func CreateTimelineEvent(client *gitlab.Client, incident *gitlab.Issue, note string, occurredAt time.Time, tags []string) (*TimelineEvent, error) {
+	variables := map[string]any{
+		"incidentId": issueGIDPrefix + strconv.FormatInt(incident.ID, 10),
+		"note":       note,
+		"occurredAt": occurredAt.UTC().Format(time.RFC3339),
+		"tags":       tags,
+	}
+
+	var resp struct {
+		Data struct {
+			TimelineEventCreate struct {
+				TimelineEvent *timelineEventNode `json:"timelineEvent"`
+				Errors        []string           `json:"errors"`
+			} `json:"timelineEventCreate"`
+		} `json:"data"`
+		api.GraphQLErrors
+	}
+	if _, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: createTimelineEventMutation, Variables: variables}, &resp); err != nil {
+		return nil, err
+	}
+	if err := resp.Err(); err != nil {
+		return nil, err
+	}
+	if err := api.MutationErr(resp.Data.TimelineEventCreate.Errors); err != nil {
+		return nil, err
+	}
+	if resp.Data.TimelineEventCreate.TimelineEvent == nil {
+		return nil, errors.New("the timeline event was not created.")
+	}
+	return resp.Data.TimelineEventCreate.TimelineEvent.event(), nil
+}
+
+const destroyTimelineEventMutation = `
+mutation($id: IncidentManagementTimelineEventID!) {
+  timelineEventDestroy(input: {id: $id}) {
+    errors
+  }
+}`
+
+// DeleteTimelineEvent deletes the timeline event with the given ID.
+func DeleteTimelineEvent(client *gitlab.Client, id int64) error {
+	variables := map[string]any{"id": timelineEventGIDPrefix + strconv.FormatInt(id, 10)}
+
+	var resp struct {
+		Data struct {
+			TimelineEventDestroy struct {
+				Errors []string `json:"errors"`
+			} `json:"timelineEventDestroy"`
+		} `json:"data"`
+		api.GraphQLErrors
+	}
+	if _, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: destroyTimelineEventMutation, Variables: variables}, &resp); err != nil {
+		return err
+	}
+	if err := resp.Err(); err != nil {
+		return err
+	}
+	return api.MutationErr(resp.Data.TimelineEventDestroy.Errors)
+}
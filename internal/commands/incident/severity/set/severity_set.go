@@ -0,0 +1,57 @@
+package set
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/incident/incidentutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func NewCmdSet(f cmdutils.Factory) *cobra.Command {
+	severitySetCmd := &cobra.Command{
+		Use:   "set <id> <severity>",
+		Short: `Set the severity of an incident.`,
+		Long: heredoc.Docf(`
+			Set the severity of an incident to one of: %s.
+		`, strings.Join(incidentutils.Severities, ", ")),
+		Example: heredoc.Doc(`
+			$ glab incident severity set 123 critical
+			$ glab incident severity set https://gitlab.com/OWNER/REPO/-/issues/incident/123 low
+		`),
+		Args: cobra.ExactArgs(2),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			severity, err := incidentutils.ParseSeverity(args[1])
+			if err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+
+			incident, repo, err := incidentutils.IncidentFromArg(f.ApiClient, client, f.BaseRepo, f.DefaultHostname(), args[0])
+			if err != nil {
+				return err
+			}
+
+			err = incidentutils.SetSeverity(client, repo.FullName(), incident.IID, severity)
+			if err != nil {
+				return fmt.Errorf("error setting the severity of incident #%d: %w", incident.IID, err)
+			}
+
+			fmt.Fprintf(f.IO().StdOut, "%s Set severity of incident #%d to %s\n", f.IO().Color().GreenCheck(), incident.IID, severity)
+			return nil
+		},
+	}
+
+	return severitySetCmd
+}
@@ -0,0 +1,45 @@
+//go:build !integration
+
+package set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestSeveritySet(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(12), gomock.Any()).
+		Return(&gitlab.Issue{ID: 500, IID: 12, IssueType: gitlab.Ptr("incident")}, nil, nil)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"issueSetSeverity": {"errors": []}}}`,
+		cmdtest.GraphQLVariables(t, map[string]any{"projectPath": "OWNER/REPO", "iid": "12", "severity": "LOW"}))
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdSet, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("12 low")
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), "Set severity of incident #12 to low\n")
+}
+
+func TestSeveritySet_notIncident(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(12), gomock.Any()).
+		Return(&gitlab.Issue{ID: 500, IID: 12, IssueType: gitlab.Ptr("issue")}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdSet, false, cmdtest.WithGitLabClient(tc.Client))
+
+	_, err := exec("12 low")
+	require.EqualError(t, err, "incident #12 not found, but an issue with that ID exists.")
+}
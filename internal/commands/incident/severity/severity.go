@@ -0,0 +1,20 @@
+package severity
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	severitySetCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/severity/set"
+)
+
+func NewCmdSeverity(f cmdutils.Factory) *cobra.Command {
+	severityCmd := &cobra.Command{
+		Use:   "severity [command] [flags]",
+		Short: `Manage the severity of incidents.`,
+		Long:  ``,
+	}
+
+	severityCmd.AddCommand(severitySetCmd.NewCmdSet(f))
+
+	return severityCmd
+}
@@ -0,0 +1,115 @@
+package add
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/incident/incidentutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+// timeLayouts are the accepted formats of --time, in local time unless an offset is given.
+var timeLayouts = []string{time.RFC3339, "2006-01-02 15:04", "2006-01-02T15:04", "15:04"}
+
+func NewCmdAdd(f cmdutils.Factory) *cobra.Command {
+	var (
+		at   string
+		tags []string
+	)
+
+	timelineAddCmd := &cobra.Command{
+		Use:   "add <id> <note> [flags]",
+		Short: `Add an event to the timeline of an incident.`,
+		Long: heredoc.Docf(`
+			Add an event to the timeline of an incident. The event happened now, or at the time
+			given with %[1]s--time%[1]s, as %[1]s2006-01-02T15:04:05Z07:00%[1]s, %[1]s2006-01-02 15:04%[1]s, or %[1]s15:04%[1]s
+			for today. Times without an offset are in local time.
+
+			Tag events with %[1]s--tag%[1]s to build the incident's metrics. The tags are: %[2]s.
+		`, "`", strings.Join(incidentutils.TimelineTags, ", ")),
+		Example: heredoc.Doc(`
+			$ glab incident timeline add 123 "Rolled back the deployment" --tag "Impact mitigated"
+			$ glab incident timeline add 123 "Alert fired for checkout errors" --time 09:42 --tag "Start time" --tag "Impact detected"
+		`),
+		Args: cobra.ExactArgs(2),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			occurredAt := time.Now()
+			if at != "" {
+				var err error
+				occurredAt, err = parseTime(at, occurredAt)
+				if err != nil {
+					return &cmdutils.FlagError{Err: err}
+				}
+			}
+
+			tagNames, err := canonicalTags(tags)
+			if err != nil {
+				return &cmdutils.FlagError{Err: err}
+			}
+
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+
+			incident, _, err := incidentutils.IncidentFromArg(f.ApiClient, client, f.BaseRepo, f.DefaultHostname(), args[0])
+			if err != nil {
+				return err
+			}
+
+			event, err := incidentutils.CreateTimelineEvent(client, incident, args[1], occurredAt, tagNames)
+			if err != nil {
+				return fmt.Errorf("error adding the timeline event to incident #%d: %w", incident.IID, err)
+			}
+
+			fmt.Fprintf(f.IO().StdOut, "%s Added event %d to the timeline of incident #%d\n", f.IO().Color().GreenCheck(), event.ID, incident.IID)
+			return nil
+		},
+	}
+
+	timelineAddCmd.Flags().StringVar(&at, "time", "", "When the event happened. Defaults to now.")
+	timelineAddCmd.Flags().StringSliceVar(&tags, "tag", []string{}, "Tag the event. Multiple tags can be comma-separated or specified by repeating the flag.")
+
+	return timelineAddCmd
+}
+
+func parseTime(s string, now time.Time) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		t, err := time.ParseInLocation(layout, s, now.Location())
+		if err != nil {
+			continue
+		}
+		if layout == "15:04" {
+			t = time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location())
+		}
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q. Use 2006-01-02T15:04:05Z07:00, 2006-01-02 15:04, or 15:04.", s)
+}
+
+// canonicalTags returns the tags with the capitalization that GitLab expects.
+func canonicalTags(tags []string) ([]string, error) {
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		found := false
+		for _, known := range incidentutils.TimelineTags {
+			if strings.EqualFold(strings.TrimSpace(tag), known) {
+				names = append(names, known)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("invalid tag %q. Use one of: %s.", tag, strings.Join(incidentutils.TimelineTags, ", "))
+		}
+	}
+	return names, nil
+}
@@ -0,0 +1,74 @@
+//go:build !integration
+
+package add
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestTimelineAdd(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(12), gomock.Any()).
+		Return(&gitlab.Issue{ID: 500, IID: 12, IssueType: gitlab.Ptr("incident")}, nil, nil)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"timelineEventCreate": {
+		"timelineEvent": {"id": "gid://gitlab/IncidentManagement::TimelineEvent/77", "note": "Rolled back"},
+		"errors": []
+	}}}`, cmdtest.GraphQLVariables(t, map[string]any{
+		"incidentId": "gid://gitlab/Issue/500",
+		"note":       "Rolled back",
+		"occurredAt": "2026-10-18T09:42:00Z",
+		"tags":       []string{"Impact mitigated", "End time"},
+	}))
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdAdd, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec(`12 "Rolled back" --time 2026-10-18T09:42:00Z --tag "impact mitigated" --tag "End time"`)
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), "Added event 77 to the timeline of incident #12\n")
+}
+
+func TestParseTime(t *testing.T) {
+	loc := time.FixedZone("CEST", 2*60*60)
+	now := time.Date(2026, 10, 18, 14, 0, 0, 0, loc)
+
+	tests := []struct {
+		in   string
+		want time.Time
+	}{
+		{in: "2026-10-17T23:10:00Z", want: time.Date(2026, 10, 17, 23, 10, 0, 0, time.UTC)},
+		{in: "2026-10-17 23:10", want: time.Date(2026, 10, 17, 23, 10, 0, 0, loc)},
+		{in: "09:42", want: time.Date(2026, 10, 18, 9, 42, 0, 0, loc)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseTime(tt.in, now)
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "got %s", got)
+		})
+	}
+
+	_, err := parseTime("yesterday", now)
+	require.EqualError(t, err, `invalid time "yesterday". Use 2006-01-02T15:04:05Z07:00, 2006-01-02 15:04, or 15:04.`)
+}
+
+func TestCanonicalTags(t *testing.T) {
+	tags, err := canonicalTags([]string{"start time", "CAUSE IDENTIFIED"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Start time", "Cause identified"}, tags)
+
+	_, err = canonicalTags([]string{"Root cause"})
+	require.ErrorContains(t, err, `invalid tag "Root cause".`)
+}
@@ -0,0 +1,89 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/incident/incidentutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func NewCmdDelete(f cmdutils.Factory) *cobra.Command {
+	var yes bool
+
+	timelineDeleteCmd := &cobra.Command{
+		Use:   "delete <id> <event-id> [flags]",
+		Short: `Delete an event from the timeline of an incident.`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			$ glab incident timeline delete 123 45
+			$ glab incident timeline delete 123 45 --yes
+		`),
+		Args: cobra.ExactArgs(2),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			eventID, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil || eventID <= 0 {
+				return &cmdutils.FlagError{Err: fmt.Errorf("invalid event ID %q.", args[1])}
+			}
+			if !yes && !f.IO().PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes is required when not running interactively.")}
+			}
+
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+
+			incident, repo, err := incidentutils.IncidentFromArg(f.ApiClient, client, f.BaseRepo, f.DefaultHostname(), args[0])
+			if err != nil {
+				return err
+			}
+
+			// Check that the event is in the timeline of this incident, because events are
+			// deleted by their global ID.
+			events, err := incidentutils.ListTimelineEvents(client, repo.FullName(), incident)
+			if err != nil {
+				return err
+			}
+			var event *incidentutils.TimelineEvent
+			for _, e := range events {
+				if e.ID == eventID {
+					event = e
+				}
+			}
+			if event == nil {
+				return fmt.Errorf("event %d not found in the timeline of incident #%d.", eventID, incident.IID)
+			}
+
+			if !yes {
+				confirmed := false
+				err := f.IO().Confirm(cmd.Context(), &confirmed, fmt.Sprintf("Delete event %d %q?", event.ID, event.Note))
+				if err != nil {
+					return cmdutils.WrapError(err, "could not prompt")
+				}
+				if !confirmed {
+					return cmdutils.CancelError()
+				}
+			}
+
+			if err := incidentutils.DeleteTimelineEvent(client, event.ID); err != nil {
+				return fmt.Errorf("error deleting event %d: %w", event.ID, err)
+			}
+
+			fmt.Fprintf(f.IO().StdOut, "%s Deleted event %d from the timeline of incident #%d\n", f.IO().Color().RedCheck(), event.ID, incident.IID)
+			return nil
+		},
+	}
+
+	timelineDeleteCmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return timelineDeleteCmd
+}
@@ -0,0 +1,57 @@
+//go:build !integration
+
+package delete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func setup(t *testing.T) *gitlabtesting.TestClient {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(12), gomock.Any()).
+		Return(&gitlab.Issue{ID: 500, IID: 12, IssueType: gitlab.Ptr("incident")}, nil, nil)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"project": {"incidentManagementTimelineEvents": {"nodes": [
+		{"id": "gid://gitlab/IncidentManagement::TimelineEvent/77", "note": "Alert fired", "occurredAt": "2026-10-18T09:42:00Z"}
+	]}}}}`, cmdtest.GraphQLVariables(t, map[string]any{"fullPath": "OWNER/REPO", "incidentId": "gid://gitlab/Issue/500"}))
+
+	return tc
+}
+
+func TestTimelineDelete(t *testing.T) {
+	tc := setup(t)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"timelineEventDestroy": {"errors": []}}}`,
+		cmdtest.GraphQLVariables(t, map[string]any{"id": "gid://gitlab/IncidentManagement::TimelineEvent/77"}))
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("12 77 --yes")
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), "Deleted event 77 from the timeline of incident #12\n")
+}
+
+func TestTimelineDelete_otherIncident(t *testing.T) {
+	tc := setup(t)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false, cmdtest.WithGitLabClient(tc.Client))
+
+	_, err := exec("12 80 --yes")
+	require.EqualError(t, err, "event 80 not found in the timeline of incident #12.")
+}
+
+func TestTimelineDelete_requiresYes(t *testing.T) {
+	exec := cmdtest.SetupCmdForTest(t, NewCmdDelete, false)
+
+	_, err := exec("12 77")
+	require.EqualError(t, err, "--yes is required when not running interactively.")
+}
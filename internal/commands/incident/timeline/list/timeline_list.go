@@ -0,0 +1,82 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/incident/incidentutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	var outputFormat string
+
+	timelineListCmd := &cobra.Command{
+		Use:     "list <id> [flags]",
+		Short:   `List the timeline events of an incident.`,
+		Long:    ``,
+		Aliases: []string{"ls"},
+		Example: heredoc.Doc(`
+			$ glab incident timeline list 123
+			$ glab incident timeline list 123 -F json
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+
+			incident, repo, err := incidentutils.IncidentFromArg(f.ApiClient, client, f.BaseRepo, f.DefaultHostname(), args[0])
+			if err != nil {
+				return err
+			}
+
+			events, err := incidentutils.ListTimelineEvents(client, repo.FullName(), incident)
+			if err != nil {
+				return err
+			}
+
+			out := f.IO().StdOut
+			if outputFormat == "json" {
+				data, err := json.Marshal(events)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(out, string(data))
+				return nil
+			}
+
+			if len(events) == 0 {
+				fmt.Fprintf(f.IO().StdErr, "No timeline events on incident #%d.\n", incident.IID)
+				return nil
+			}
+
+			c := f.IO().Color()
+			table := tableprinter.NewTablePrinter()
+			table.AddRow("ID", "Time", "Tags", "Event", "Author")
+			for _, e := range events {
+				author := ""
+				if e.Author != "" {
+					author = "@" + e.Author
+				}
+				table.AddRow(e.ID, e.OccurredAt.Local().Format("2006-01-02 15:04"), c.Cyan(strings.Join(e.Tags, ", ")), e.Note, c.Gray(author))
+			}
+			fmt.Fprint(out, table.Render())
+			return nil
+		},
+	}
+
+	timelineListCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &outputFormat), "output", "F", "Format output as: text, json.")
+
+	return timelineListCmd
+}
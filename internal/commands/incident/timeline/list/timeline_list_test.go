@@ -0,0 +1,66 @@
+//go:build !integration
+
+package list
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/commands/incident/incidentutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const timelineEvents = `{"data": {"project": {"incidentManagementTimelineEvents": {"nodes": [
+	{"id": "gid://gitlab/IncidentManagement::TimelineEvent/78", "note": "Rolled back", "occurredAt": "2026-10-18T10:05:00Z",
+	 "action": "comment", "author": {"username": "oncall"}, "timelineEventTags": {"nodes": [{"name": "Impact mitigated"}]}},
+	{"id": "gid://gitlab/IncidentManagement::TimelineEvent/77", "note": "Alert fired", "occurredAt": "2026-10-18T09:42:00Z",
+	 "action": "comment", "author": {"username": "alertbot"}, "timelineEventTags": {"nodes": [{"name": "Start time"}, {"name": "Impact detected"}]}}
+]}}}}`
+
+func setup(t *testing.T) *gitlabtesting.TestClient {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(12), gomock.Any()).
+		Return(&gitlab.Issue{ID: 500, IID: 12, IssueType: gitlab.Ptr("incident")}, nil, nil)
+	cmdtest.ExpectGraphQL(tc, timelineEvents,
+		cmdtest.GraphQLVariables(t, map[string]any{"fullPath": "OWNER/REPO", "incidentId": "gid://gitlab/Issue/500"}))
+
+	return tc
+}
+
+func TestTimelineList(t *testing.T) {
+	tc := setup(t)
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("12")
+	require.NoError(t, err)
+
+	lines := out.String()
+	assert.Contains(t, lines, "Start time, Impact detected")
+	assert.Contains(t, lines, "@oncall")
+	assert.Less(t, strings.Index(lines, "Alert fired"), strings.Index(lines, "Rolled back"), "events are in the order they occurred")
+}
+
+func TestTimelineList_JSON(t *testing.T) {
+	tc := setup(t)
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("12 -F json")
+	require.NoError(t, err)
+
+	var events []*incidentutils.TimelineEvent
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &events))
+	require.Len(t, events, 2)
+	assert.Equal(t, int64(77), events[0].ID)
+	assert.Equal(t, []string{"Start time", "Impact detected"}, events[0].Tags)
+	assert.Equal(t, "alertbot", events[0].Author)
+}
@@ -0,0 +1,24 @@
+package timeline
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	timelineAddCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/timeline/add"
+	timelineDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/timeline/delete"
+	timelineListCmd "gitlab.com/gitlab-org/cli/internal/commands/incident/timeline/list"
+)
+
+func NewCmdTimeline(f cmdutils.Factory) *cobra.Command {
+	timelineCmd := &cobra.Command{
+		Use:   "timeline [command] [flags]",
+		Short: `Manage the timeline events of incidents.`,
+		Long:  ``,
+	}
+
+	timelineCmd.AddCommand(timelineAddCmd.NewCmdAdd(f))
+	timelineCmd.AddCommand(timelineListCmd.NewCmdList(f))
+	timelineCmd.AddCommand(timelineDeleteCmd.NewCmdDelete(f))
+
+	return timelineCmd
+}
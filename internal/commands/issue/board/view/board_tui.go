@@ -0,0 +1,485 @@
+package view
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+const keyHelp = "[::b]←/→[::-] list  [::b]↑/↓[::-] issue  [::b]</>[::-] move  [::b]m[::-] move to  [::b]enter[::-] details  [::b]a[::-] assign  [::b]t[::-] labels  [::b]o[::-] open  [::b]r[::-] reload  [::b]q[::-] quit"
+
+type listKind int
+
+const (
+	openList listKind = iota
+	closedList
+	labelList
+	milestoneList
+	assigneeList
+)
+
+// boardColumn is a list of the board, and the issues in it.
+type boardColumn struct {
+	list   *gitlab.BoardList
+	kind   listKind
+	title  string
+	color  string
+	issues []*gitlab.Issue
+	view   *tview.List
+}
+
+// board is the state of the interactive issue board.
+type board struct {
+	app     *tview.Application
+	client  *gitlab.Client
+	repo    glrepo.Interface
+	meta    boardMeta
+	opts    *issueBoardViewOptions
+	columns []*boardColumn
+	title   string
+	openURL func(url string) error
+
+	pages  *tview.Pages
+	status *tview.TextView
+}
+
+// newBoardColumns creates a column for each list of the board. The first and last lists
+// are the Open and Closed lists added by getBoardLists.
+func newBoardColumns(lists []*gitlab.BoardList) []*boardColumn {
+	columns := make([]*boardColumn, 0, len(lists))
+
+	for i, l := range lists {
+		column := &boardColumn{list: l}
+
+		switch {
+		case i == 0 && l.ID == 0:
+			column.kind, column.title, column.color = openList, l.Label.Name, l.Label.Color
+		case i == len(lists)-1 && l.ID == 0:
+			column.kind, column.title, column.color = closedList, l.Label.Name, l.Label.Color
+		case l.Label != nil:
+			column.kind, column.title, column.color = labelList, l.Label.Name, l.Label.Color
+		case l.Milestone != nil:
+			column.kind, column.title = milestoneList, "Milestone: "+l.Milestone.Title
+		case l.Assignee != nil:
+			column.kind, column.title = assigneeList, "@"+l.Assignee.Username
+		default:
+			// iteration lists can't be used to move issues yet
+			continue
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns
+}
+
+// load fetches the open and closed issues, and distributes them over the columns.
+func (b *board) load() error {
+	var issues []*gitlab.Issue
+
+	for _, state := range []string{opened, closed} {
+		b.opts.state = state
+
+		var stateIssues []*gitlab.Issue
+		var err error
+		if b.meta.group != nil {
+			stateIssues, err = getGroupBoardIssues(b.client, b.meta.group.ID, b.opts)
+		} else {
+			stateIssues, err = getProjectBoardIssues(b.client, b.repo, b.opts)
+		}
+		if err != nil {
+			return fmt.Errorf("getting issue board lists: %w", err)
+		}
+
+		issues = append(issues, stateIssues...)
+	}
+	b.opts.state = ""
+
+	for _, column := range b.columns {
+		column.issues = filterIssues(b.columns, issues, column)
+	}
+
+	return nil
+}
+
+// moveOptions returns the changes that move an issue from one list to another,
+// or nil if the lists are the same.
+func moveOptions(from, to *boardColumn, issue *gitlab.Issue) *gitlab.UpdateIssueOptions {
+	if from == to {
+		return nil
+	}
+
+	opts := &gitlab.UpdateIssueOptions{}
+	assignees := make([]int64, 0, len(issue.Assignees))
+	for _, assignee := range issue.Assignees {
+		assignees = append(assignees, assignee.ID)
+	}
+
+	// leave the list the issue is in
+	switch from.kind {
+	case closedList:
+		opts.StateEvent = gitlab.Ptr("reopen")
+	case labelList:
+		opts.RemoveLabels = &gitlab.LabelOptions{from.list.Label.Name}
+	case milestoneList:
+		if to.kind != closedList {
+			opts.MilestoneID = gitlab.Ptr(int64(0))
+		}
+	case assigneeList:
+		if to.kind != closedList {
+			var kept []int64
+			for _, id := range assignees {
+				if id != from.list.Assignee.ID {
+					kept = append(kept, id)
+				}
+			}
+			assignees = kept
+			opts.AssigneeIDs = &assignees
+		}
+	}
+
+	// join the target list
+	switch to.kind {
+	case closedList:
+		opts.StateEvent = gitlab.Ptr("close")
+	case labelList:
+		opts.AddLabels = &gitlab.LabelOptions{to.list.Label.Name}
+	case milestoneList:
+		opts.MilestoneID = gitlab.Ptr(to.list.Milestone.ID)
+	case assigneeList:
+		// the issue might already be assigned to both users
+		if !slices.Contains(assignees, to.list.Assignee.ID) {
+			assignees = append(assignees, to.list.Assignee.ID)
+			opts.AssigneeIDs = &assignees
+		}
+	}
+
+	return opts
+}
+
+// labelOptions parses a comma-separated list of labels. Labels prefixed with + are added,
+// labels prefixed with - or ! are removed, and other labels replace all labels of the issue.
+func labelOptions(input string) *gitlab.UpdateIssueOptions {
+	var add, remove gitlab.LabelOptions
+	replace := gitlab.LabelOptions{}
+
+	for label := range strings.SplitSeq(input, ",") {
+		label = strings.TrimSpace(label)
+		switch {
+		case label == "":
+		case strings.HasPrefix(label, "+"):
+			add = append(add, label[1:])
+		case strings.HasPrefix(label, "-"), strings.HasPrefix(label, "!"):
+			remove = append(remove, label[1:])
+		default:
+			replace = append(replace, label)
+		}
+	}
+
+	opts := &gitlab.UpdateIssueOptions{}
+	if len(replace) > 0 || (len(add) == 0 && len(remove) == 0) {
+		opts.Labels = &replace
+		return opts
+	}
+	if len(add) > 0 {
+		opts.AddLabels = &add
+	}
+	if len(remove) > 0 {
+		opts.RemoveLabels = &remove
+	}
+	return opts
+}
+
+func (b *board) layout() tview.Primitive {
+	root := tview.NewFlex()
+	root.SetBackgroundColor(tcell.ColorDefault)
+
+	for i, column := range b.columns {
+		column.view = tview.NewList().
+			SetHighlightFullLine(true).
+			SetSelectedBackgroundColor(tcell.ColorDarkSlateGray)
+		column.view.
+			SetBackgroundColor(tcell.ColorDefault).
+			SetBorder(true).
+			SetTitle(column.title).
+			SetTitleColor(tcell.GetColor(column.color))
+		column.view.SetInputCapture(b.inputCapture(i))
+		root.AddItem(column.view, 0, 1, i == 0)
+	}
+	b.render()
+
+	root.SetBorderPadding(1, 1, 2, 2).SetBorder(true).SetTitle(b.title)
+
+	b.status = tview.NewTextView().SetDynamicColors(true).SetText(keyHelp)
+	b.status.SetBackgroundColor(tcell.ColorDefault)
+
+	main := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(root, 0, 1, true).
+		AddItem(b.status, 1, 0, false)
+
+	b.pages = tview.NewPages().AddPage("board", main, true, true)
+	return b.pages
+}
+
+// render fills the columns with their issues, and keeps the selected card of each column.
+func (b *board) render() {
+	for _, column := range b.columns {
+		current := column.view.GetCurrentItem()
+		column.view.Clear()
+		for _, issue := range column.issues {
+			main, secondary := formatIssue(issue)
+			column.view.AddItem(main, secondary, 0, nil)
+		}
+		column.view.SetTitle(fmt.Sprintf("%s (%d)", column.title, len(column.issues)))
+		if current < len(column.issues) {
+			column.view.SetCurrentItem(current)
+		}
+	}
+}
+
+func (b *board) selected(i int) *gitlab.Issue {
+	column := b.columns[i]
+	if len(column.issues) == 0 {
+		return nil
+	}
+	return column.issues[column.view.GetCurrentItem()]
+}
+
+func (b *board) focus(i int) {
+	if i >= 0 && i < len(b.columns) {
+		b.app.SetFocus(b.columns[i].view)
+	}
+}
+
+func (b *board) setError(err error) {
+	b.status.SetText(fmt.Sprintf("[red]%s", tview.Escape(err.Error())))
+}
+
+func (b *board) inputCapture(i int) func(event *tcell.EventKey) *tcell.EventKey {
+	return func(event *tcell.EventKey) *tcell.EventKey {
+		issue := b.selected(i)
+
+		switch {
+		case event.Key() == tcell.KeyEscape || event.Rune() == 'q':
+			b.app.Stop()
+		case event.Key() == tcell.KeyLeft || event.Rune() == 'h':
+			b.focus(i - 1)
+		case event.Key() == tcell.KeyRight || event.Rune() == 'l':
+			b.focus(i + 1)
+		case event.Rune() == 'r':
+			b.update(nil, nil, i)
+		case issue == nil:
+			return event
+		case event.Rune() == '<' && i > 0:
+			b.update(issue, moveOptions(b.columns[i], b.columns[i-1], issue), i-1)
+		case event.Rune() == '>' && i < len(b.columns)-1:
+			b.update(issue, moveOptions(b.columns[i], b.columns[i+1], issue), i+1)
+		case event.Rune() == 'm':
+			b.pickList(i, issue)
+		case event.Key() == tcell.KeyEnter:
+			b.showDetails(i, issue)
+		case event.Rune() == 'a':
+			b.prompt(i, "Assignees", assigneeNames(issue), "+user adds, -user removes, other names replace", func(input string) error {
+				return b.assign(issue, input)
+			})
+		case event.Rune() == 't':
+			b.prompt(i, "Labels", strings.Join(issue.Labels, ","), "+label adds, -label removes, other labels replace", func(input string) error {
+				return b.updateIssue(issue, labelOptions(input))
+			})
+		case event.Rune() == 'o':
+			if err := b.openURL(issue.WebURL); err != nil {
+				b.setError(err)
+			}
+		default:
+			return event
+		}
+
+		return nil
+	}
+}
+
+// update applies the changes to the issue, reloads the board, and focuses the column.
+func (b *board) update(issue *gitlab.Issue, opts *gitlab.UpdateIssueOptions, focus int) {
+	if issue != nil && opts != nil {
+		if err := b.updateIssue(issue, opts); err != nil {
+			b.setError(err)
+			return
+		}
+	}
+
+	if err := b.load(); err != nil {
+		b.setError(err)
+		return
+	}
+	b.render()
+	b.focus(focus)
+
+	if issue != nil {
+		for j, moved := range b.columns[focus].issues {
+			if moved.ID == issue.ID {
+				b.columns[focus].view.SetCurrentItem(j)
+			}
+		}
+	}
+	b.status.SetText(keyHelp)
+}
+
+func (b *board) updateIssue(issue *gitlab.Issue, opts *gitlab.UpdateIssueOptions) error {
+	_, _, err := b.client.Issues.UpdateIssue(issue.ProjectID, issue.IID, opts)
+	if err != nil {
+		return fmt.Errorf("updating issue #%d: %w", issue.IID, err)
+	}
+	return nil
+}
+
+func (b *board) assign(issue *gitlab.Issue, input string) error {
+	var names []string
+	for name := range strings.SplitSeq(input, ",") {
+		if name = strings.TrimSpace(strings.TrimPrefix(name, "@")); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	opts := &gitlab.UpdateIssueOptions{AssigneeIDs: &[]int64{}}
+	if len(names) > 0 {
+		ua := cmdutils.ParseAssignees(names)
+		if err := ua.VerifyAssignees(); err != nil {
+			return err
+		}
+
+		var err error
+		if len(ua.ToReplace) != 0 {
+			opts.AssigneeIDs, _, err = ua.UsersFromReplaces(b.client, nil)
+		} else {
+			opts.AssigneeIDs, _, err = ua.UsersFromAddRemove(issue.Assignees, nil, b.client, nil)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return b.updateIssue(issue, opts)
+}
+
+func assigneeNames(issue *gitlab.Issue) string {
+	names := make([]string, 0, len(issue.Assignees))
+	for _, assignee := range issue.Assignees {
+		names = append(names, assignee.Username)
+	}
+	return strings.Join(names, ",")
+}
+
+// pickList shows the lists that the issue can be moved to.
+func (b *board) pickList(i int, issue *gitlab.Issue) {
+	picker := tview.NewList().ShowSecondaryText(false)
+	picker.SetBorder(true).SetTitle(fmt.Sprintf(" Move #%d to ", issue.IID))
+
+	for j, column := range b.columns {
+		if j == i {
+			continue
+		}
+		picker.AddItem(column.title, "", 0, func() {
+			b.pages.RemovePage("picker")
+			b.update(issue, moveOptions(b.columns[i], b.columns[j], issue), j)
+		})
+	}
+	picker.SetDoneFunc(func() {
+		b.pages.RemovePage("picker")
+		b.focus(i)
+	})
+
+	// one row per list, and two for the border
+	b.pages.AddPage("picker", modal(picker, 40, picker.GetItemCount()+2), true, true)
+}
+
+// prompt asks for a value in a form, and calls submit with it.
+func (b *board) prompt(i int, label, value, hint string, submit func(string) error) {
+	form := tview.NewForm()
+	form.AddInputField(label, value, 50, nil, nil)
+	form.AddTextView("", hint, 50, 1, true, false)
+	form.AddButton("Save", func() {
+		input := form.GetFormItemByLabel(label).(*tview.InputField).GetText()
+		b.pages.RemovePage("prompt")
+		if err := submit(input); err != nil {
+			b.setError(err)
+			b.focus(i)
+			return
+		}
+		b.update(nil, nil, i)
+	})
+	form.AddButton("Cancel", func() {
+		b.pages.RemovePage("prompt")
+		b.focus(i)
+	})
+	form.SetCancelFunc(func() {
+		b.pages.RemovePage("prompt")
+		b.focus(i)
+	})
+	form.SetBorder(true).SetTitle(" " + label + " ")
+
+	b.pages.AddPage("prompt", modal(form, 70, 9), true, true)
+}
+
+// showDetails shows the details of the issue, until Esc or q is pressed.
+func (b *board) showDetails(i int, issue *gitlab.Issue) {
+	details := tview.NewTextView().
+		SetDynamicColors(true).
+		SetWrap(true).
+		SetText(issueDetails(issue))
+	details.SetBorder(true).SetTitle(fmt.Sprintf(" #%d ", issue.IID))
+	details.SetBackgroundColor(tcell.ColorDefault)
+	details.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape || event.Rune() == 'q' {
+			b.pages.RemovePage("details")
+			b.focus(i)
+			return nil
+		}
+		return event
+	})
+
+	b.pages.AddPage("details", details, true, true)
+}
+
+func issueDetails(issue *gitlab.Issue) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "[white::b]%s[-:-:-]\n\n", tview.Escape(issue.Title))
+	fmt.Fprintf(&sb, "[darkgray]State:[-]      %s\n", issue.State)
+	if issue.Author != nil {
+		fmt.Fprintf(&sb, "[darkgray]Author:[-]     @%s\n", issue.Author.Username)
+	}
+	if names := assigneeNames(issue); names != "" {
+		fmt.Fprintf(&sb, "[darkgray]Assignees:[-]  %s\n", names)
+	}
+	if len(issue.Labels) > 0 {
+		fmt.Fprintf(&sb, "[darkgray]Labels:[-]     %s\n", buildLabelString(issue.LabelDetails))
+	}
+	if issue.Milestone != nil {
+		fmt.Fprintf(&sb, "[darkgray]Milestone:[-]  %s\n", tview.Escape(issue.Milestone.Title))
+	}
+	fmt.Fprintf(&sb, "[darkgray]URL:[-]        %s\n\n", issue.WebURL)
+	sb.WriteString(tview.Escape(issue.Description))
+	sb.WriteString("\n\n[darkgray]Press Esc to go back.")
+
+	return sb.String()
+}
+
+// modal centers the primitive in a box of the given size.
+func modal(p tview.Primitive, width, height int) tview.Primitive {
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(p, height, 1, true).
+			AddItem(nil, 0, 1, false), width, 1, true).
+		AddItem(nil, 0, 1, false)
+}
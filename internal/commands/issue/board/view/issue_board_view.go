@@ -8,6 +8,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/MakeNowJust/heredoc/v2"
 	"github.com/charmbracelet/huh"
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -22,6 +23,7 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
 )
 
 const (
@@ -48,9 +50,29 @@ func NewCmdView(f cmdutils.Factory) *cobra.Command {
 	viewCmd := &cobra.Command{
 		Use:   "view [flags]",
 		Short: `View project issue board.`,
-		Long:  ``,
+		Long: heredoc.Doc(`
+			View a project or group issue board, and move issues between its lists.
+
+			Moving an issue to a list changes the issue according to the list type:
+			label lists add the label, milestone lists set the milestone, assignee lists
+			assign the user, and the Closed list closes the issue. The label, milestone,
+			or assignee of the list the issue leaves is removed.
+
+			Key bindings:
+
+			- Left and right arrows, or h and l: select a list.
+			- Up and down arrows, or k and j: select an issue.
+			- < and >: move the issue to the previous or next list.
+			- m: move the issue to a list of your choice.
+			- Enter: view the details of the issue.
+			- a: change the assignees of the issue.
+			- t: change the labels of the issue.
+			- o: open the issue in the browser.
+			- r: reload the board.
+			- q or Esc: quit.
+		`),
 		Annotations: map[string]string{
-			mcpannotations.Safe: "true",
+			mcpannotations.Destructive: "true",
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			a := tview.NewApplication()
@@ -103,59 +125,6 @@ func NewCmdView(f cmdutils.Factory) *cobra.Command {
 				return fmt.Errorf("getting issue board lists: %w", err)
 			}
 
-			root := tview.NewFlex()
-			root.SetBackgroundColor(tcell.ColorDefault)
-			for _, l := range boardLists {
-				opts.state = ""
-				var boardIssues, listTitle, listColor string
-
-				if l.Label == nil {
-					continue
-				}
-
-				if l.Label != nil {
-					listTitle = l.Label.Name
-					listColor = l.Label.Color
-				}
-
-				// automatically request using state for default "open" and "closed" lists
-				// this is required as these lists aren't returned with the board lists api call
-				switch l.Label.Name {
-				case "Closed":
-					opts.state = closed
-				case "Open":
-					opts.state = opened
-				}
-
-				issues := []*gitlab.Issue{}
-				if selectedBoard.group != nil {
-					groupID := selectedBoard.group.ID
-					issues, err = getGroupBoardIssues(client, groupID, opts)
-					if err != nil {
-						return fmt.Errorf("getting issue board lists: %w", err)
-					}
-				}
-
-				if selectedBoard.group == nil {
-					issues, err = getProjectBoardIssues(client, repo, opts)
-					if err != nil {
-						return fmt.Errorf("getting issue board lists: %w", err)
-					}
-				}
-
-				boardIssues = filterIssues(boardLists, issues, l, opts)
-				bx := tview.NewTextView()
-				bx.
-					SetDynamicColors(true).
-					SetText(boardIssues).
-					SetWrap(true).
-					SetBackgroundColor(tcell.ColorDefault).
-					SetBorder(true).
-					SetTitle(listTitle).
-					SetTitleColor(tcell.GetColor(listColor))
-				root.AddItem(bx, 0, 1, false)
-			}
-
 			// format table title
 			caser := cases.Title(language.English)
 			var boardType, boardContext string
@@ -166,15 +135,29 @@ func NewCmdView(f cmdutils.Factory) *cobra.Command {
 				boardType = caser.String("project")
 				boardContext = project.NameWithNamespace
 			}
-			root.SetBorderPadding(1, 1, 2, 2).SetBorder(true).SetTitle(
-				fmt.Sprintf(" %s • %s ", caser.String(boardType+" issue board"), boardContext),
-			)
+
+			b := &board{
+				app:     a,
+				client:  client,
+				repo:    repo,
+				meta:    selectedBoard,
+				opts:    opts,
+				columns: newBoardColumns(boardLists),
+				title:   fmt.Sprintf(" %s • %s ", caser.String(boardType+" issue board"), boardContext),
+				openURL: func(url string) error {
+					browser, _ := f.Config().Get(repo.RepoHost(), "browser")
+					return utils.OpenInBrowser(url, browser)
+				},
+			}
+			if err := b.load(); err != nil {
+				return err
+			}
 
 			screen, err := tcell.NewScreen()
 			if err != nil {
 				return err
 			}
-			if err := a.SetScreen(screen).SetRoot(root, true).Run(); err != nil {
+			if err := a.SetScreen(screen).SetRoot(b.layout(), true).Run(); err != nil {
 				return err
 			}
 			return nil
@@ -428,53 +411,66 @@ func getProjectBoardIssues(apiClient *gitlab.Client, repo glrepo.Interface, opts
 	return issues, nil
 }
 
-// filterIssues scans through the issues passed to it, filtering for the ones that belong in targetList
-// This function returns a string representation of the issues for targetList which will be displayed in the table view
-func filterIssues(
-	boardLists []*gitlab.BoardList,
-	issues []*gitlab.Issue,
-	targetList *gitlab.BoardList,
-	opts *issueBoardViewOptions,
-) string {
-	var boardIssues strings.Builder
+// filterIssues scans through the issues passed to it, and returns the ones that belong in target.
+func filterIssues(columns []*boardColumn, issues []*gitlab.Issue, target *boardColumn) []*gitlab.Issue {
+	var filtered []*gitlab.Issue
 next:
 	for _, issue := range issues {
-		switch opts.state {
+		switch target.kind {
 		// skip all issues that are not in the "closed" state for the "closed" list
-		case closed:
+		case closedList:
 			if issue.State != closed {
 				continue next
 			}
 		// skip issues labeled for other board lists when populating the "open" list
-		case opened:
-			for _, boardList := range boardLists {
-				for _, issueLabel := range issue.Labels {
-					if issueLabel == boardList.Label.Name {
-						continue next
-					}
+		case openList:
+			if issue.State == closed {
+				continue next
+			}
+			for _, column := range columns {
+				if column.kind == labelList && slices.Contains(issue.Labels, column.list.Label.Name) {
+					continue next
 				}
 			}
 		// filter labeled issues into board lists with corresponding labels
-		default:
-			var hasListLabel bool
-			if slices.Contains(issue.Labels, targetList.Label.Name) {
-				hasListLabel = true
+		case labelList:
+			if !slices.Contains(issue.Labels, target.list.Label.Name) || issue.State == closed {
+				continue next
 			}
-			if !hasListLabel || issue.State == closed {
+		case milestoneList:
+			if issue.Milestone == nil || issue.Milestone.ID != target.list.Milestone.ID || issue.State == closed {
+				continue next
+			}
+		case assigneeList:
+			if !hasAssignee(issue, target.list.Assignee.ID) || issue.State == closed {
 				continue next
 			}
 		}
 
-		var assignee, labelString string
-		if len(issue.Labels) > 0 {
-			labelString = buildLabelString(issue.LabelDetails)
-		}
-		if issue.Assignee != nil { //nolint:staticcheck
-			assignee = issue.Assignee.Username //nolint:staticcheck
-		}
+		filtered = append(filtered, issue)
+	}
+	return filtered
+}
+
+// formatIssue returns the title, and the labels, ID, and assignee of an issue card.
+func formatIssue(issue *gitlab.Issue) (string, string) {
+	var assignee, labelString string
+	if len(issue.Labels) > 0 {
+		labelString = buildLabelString(issue.LabelDetails)
+	}
+	if issue.Assignee != nil { //nolint:staticcheck
+		assignee = issue.Assignee.Username //nolint:staticcheck
+	}
+
+	return fmt.Sprintf("[white::b]%s", tview.Escape(issue.Title)),
+		fmt.Sprintf("%s[green:-:-]#%d[darkgray] - %s", strings.ReplaceAll(labelString, "\n", " "), issue.IID, assignee)
+}
 
-		boardIssues.WriteString(fmt.Sprintf("[white::b]%s\n%s[green:-:-]#%d[darkgray] - %s\n\n",
-			issue.Title, labelString, issue.IID, assignee))
+func hasAssignee(issue *gitlab.Issue, id int64) bool {
+	for _, assignee := range issue.Assignees {
+		if assignee.ID == id {
+			return true
+		}
 	}
-	return boardIssues.String()
+	return false
 }
@@ -233,89 +233,210 @@ func Test_mapBoardData(t *testing.T) {
 }
 
 func Test_filterIssues(t *testing.T) {
-	type args struct {
-		boardLists []*gitlab.BoardList
-		issues     []*gitlab.Issue
-		targetList *gitlab.BoardList
-		opts       *issueBoardViewOptions
+	open := &boardColumn{kind: openList, list: &gitlab.BoardList{Label: &gitlab.Label{Name: "Open"}}}
+	closedColumn := &boardColumn{kind: closedList, list: &gitlab.BoardList{Label: &gitlab.Label{Name: "Closed"}}}
+	labelA := &boardColumn{kind: labelList, list: &gitlab.BoardList{ID: 1, Label: &gitlab.Label{Name: "A"}}}
+	milestone := &boardColumn{kind: milestoneList, list: &gitlab.BoardList{ID: 2, Milestone: &gitlab.Milestone{ID: 5}}}
+	assignee := &boardColumn{kind: assigneeList, list: &gitlab.BoardList{ID: 3, Assignee: &gitlab.BoardListAssignee{ID: 7}}}
+	columns := []*boardColumn{open, labelA, milestone, assignee, closedColumn}
+
+	labeled := &gitlab.Issue{IID: 1, Labels: []string{"A"}, State: "opened"}
+	labeledClosed := &gitlab.Issue{IID: 2, Labels: []string{"A"}, State: "closed"}
+	plain := &gitlab.Issue{IID: 3, State: "opened"}
+	inMilestone := &gitlab.Issue{IID: 4, State: "opened", Milestone: &gitlab.Milestone{ID: 5}}
+	assigned := &gitlab.Issue{IID: 5, State: "opened", Assignees: []*gitlab.IssueAssignee{{ID: 7}}}
+	issues := []*gitlab.Issue{labeled, labeledClosed, plain, inMilestone, assigned}
+
+	tests := []struct {
+		name   string
+		target *boardColumn
+		want   []*gitlab.Issue
+	}{
+		{
+			name:   "open list skips closed issues and issues of label lists",
+			target: open,
+			want:   []*gitlab.Issue{plain, inMilestone, assigned},
+		},
+		{
+			name:   "closed list only has closed issues",
+			target: closedColumn,
+			want:   []*gitlab.Issue{labeledClosed},
+		},
+		{
+			name:   "label list has open issues with the label",
+			target: labelA,
+			want:   []*gitlab.Issue{labeled},
+		},
+		{
+			name:   "milestone list has open issues in the milestone",
+			target: milestone,
+			want:   []*gitlab.Issue{inMilestone},
+		},
+		{
+			name:   "assignee list has open issues assigned to the user",
+			target: assignee,
+			want:   []*gitlab.Issue{assigned},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, filterIssues(columns, issues, tt.target))
+		})
 	}
+}
+
+func Test_formatIssue(t *testing.T) {
+	main, secondary := formatIssue(&gitlab.Issue{
+		Assignee:     &gitlab.IssueAssignee{Username: "user"},
+		Labels:       []string{"A"},
+		LabelDetails: []*gitlab.LabelDetails{{Name: "A", Color: "green"}},
+		Title:        "Issue",
+		IID:          1,
+	})
+
+	assert.Equal(t, "[white::b]Issue", main)
+	assert.Equal(t, "[white:green:-]A[white:-:-] [green:-:-]#1[darkgray] - user", secondary)
+}
+
+func Test_newBoardColumns(t *testing.T) {
+	columns := newBoardColumns([]*gitlab.BoardList{
+		{Label: &gitlab.Label{Name: "Open"}},
+		{ID: 1, Label: &gitlab.Label{Name: "A", Color: "#ff0000"}},
+		{ID: 2, Milestone: &gitlab.Milestone{Title: "v1"}},
+		{ID: 3, Assignee: &gitlab.BoardListAssignee{Username: "user"}},
+		{ID: 4, Iteration: &gitlab.ProjectIteration{}},
+		{Label: &gitlab.Label{Name: "Closed"}},
+	})
+
+	var kinds []listKind
+	var titles []string
+	for _, column := range columns {
+		kinds = append(kinds, column.kind)
+		titles = append(titles, column.title)
+	}
+
+	assert.Equal(t, []listKind{openList, labelList, milestoneList, assigneeList, closedList}, kinds)
+	assert.Equal(t, []string{"Open", "A", "Milestone: v1", "@user", "Closed"}, titles)
+}
+
+func Test_moveOptions(t *testing.T) {
+	open := &boardColumn{kind: openList}
+	closedColumn := &boardColumn{kind: closedList}
+	labelA := &boardColumn{kind: labelList, list: &gitlab.BoardList{Label: &gitlab.Label{Name: "A"}}}
+	labelB := &boardColumn{kind: labelList, list: &gitlab.BoardList{Label: &gitlab.Label{Name: "B"}}}
+	milestone := &boardColumn{kind: milestoneList, list: &gitlab.BoardList{Milestone: &gitlab.Milestone{ID: 5}}}
+	assignee := &boardColumn{kind: assigneeList, list: &gitlab.BoardList{Assignee: &gitlab.BoardListAssignee{ID: 7}}}
+	otherAssignee := &boardColumn{kind: assigneeList, list: &gitlab.BoardList{Assignee: &gitlab.BoardListAssignee{ID: 1}}}
+	newAssignee := &boardColumn{kind: assigneeList, list: &gitlab.BoardList{Assignee: &gitlab.BoardListAssignee{ID: 9}}}
+
+	issue := &gitlab.Issue{Assignees: []*gitlab.IssueAssignee{{ID: 1}, {ID: 7}}}
+
 	tests := []struct {
-		name string
-		args args
-		want string
+		name     string
+		from, to *boardColumn
+		want     *gitlab.UpdateIssueOptions
 	}{
 		{
-			name: "return empty string on no matches",
-			want: "",
+			name: "same list",
+			from: labelA,
+			to:   labelA,
+			want: nil,
 		},
 		{
-			name: "filter out closed issues when targetList is not the 'closed' list",
-			args: args{
-				boardLists: []*gitlab.BoardList{{Label: &gitlab.Label{Name: "A"}}},
-				issues:     []*gitlab.Issue{{Labels: []string{"A"}, State: "closed"}},
-				targetList: &gitlab.BoardList{Label: &gitlab.Label{Name: "A"}},
-				opts:       &issueBoardViewOptions{},
+			name: "label to label",
+			from: labelA,
+			to:   labelB,
+			want: &gitlab.UpdateIssueOptions{
+				RemoveLabels: &gitlab.LabelOptions{"A"},
+				AddLabels:    &gitlab.LabelOptions{"B"},
 			},
-			want: "",
 		},
 		{
-			name: "filter out issues not in the 'closed' state when populating the 'closed' list",
-			args: args{
-				boardLists: []*gitlab.BoardList{
-					{Label: &gitlab.Label{Name: "Closed"}},
-					{Label: &gitlab.Label{Name: "A"}},
-				},
-				issues:     []*gitlab.Issue{{Labels: []string{"A"}, State: "opened"}},
-				targetList: &gitlab.BoardList{Label: &gitlab.Label{Name: "Closed"}},
-				opts:       &issueBoardViewOptions{state: closed},
-			},
-			want: "",
+			name: "open to closed",
+			from: open,
+			to:   closedColumn,
+			want: &gitlab.UpdateIssueOptions{StateEvent: gitlab.Ptr("close")},
 		},
 		{
-			name: "filter out issues labeled for other board lists when iterating over the 'open' list",
-			args: args{
-				boardLists: []*gitlab.BoardList{
-					{Label: &gitlab.Label{Name: "Open"}},
-					{Label: &gitlab.Label{Name: "A"}},
-				},
-				issues:     []*gitlab.Issue{{Labels: []string{"A"}, State: "opened"}},
-				targetList: &gitlab.BoardList{Label: &gitlab.Label{Name: "Open"}},
-				opts:       &issueBoardViewOptions{state: opened},
+			name: "closed to label",
+			from: closedColumn,
+			to:   labelA,
+			want: &gitlab.UpdateIssueOptions{
+				StateEvent: gitlab.Ptr("reopen"),
+				AddLabels:  &gitlab.LabelOptions{"A"},
 			},
-			want: "",
 		},
 		{
-			name: "return formatted string on successful filter and match",
-			args: args{
-				boardLists: []*gitlab.BoardList{
-					{Label: &gitlab.Label{Name: "A"}},
-					{Label: &gitlab.Label{Name: "B"}},
-					{Label: &gitlab.Label{Name: "C"}},
-				},
-				issues: []*gitlab.Issue{
-					{
-						Assignee:     &gitlab.IssueAssignee{Username: "user"},
-						Labels:       []string{"A"},
-						LabelDetails: []*gitlab.LabelDetails{{Name: "A", Color: "green"}},
-						Title:        "Issue",
-						IID:          1,
-					},
-				},
-				targetList: &gitlab.BoardList{Label: &gitlab.Label{Name: "A"}},
-				opts:       &issueBoardViewOptions{},
+			name: "milestone to open",
+			from: milestone,
+			to:   open,
+			want: &gitlab.UpdateIssueOptions{MilestoneID: gitlab.Ptr(int64(0))},
+		},
+		{
+			name: "open to milestone",
+			from: open,
+			to:   milestone,
+			want: &gitlab.UpdateIssueOptions{MilestoneID: gitlab.Ptr(int64(5))},
+		},
+		{
+			name: "assignee to label",
+			from: assignee,
+			to:   labelA,
+			want: &gitlab.UpdateIssueOptions{
+				AssigneeIDs: &[]int64{1},
+				AddLabels:   &gitlab.LabelOptions{"A"},
 			},
-			want: "[white::b]Issue\n[white:green:-]A[white:-:-]\n[green:-:-]#1[darkgray] - user\n\n",
+		},
+		{
+			name: "label to assignee already assigned",
+			from: labelA,
+			to:   assignee,
+			want: &gitlab.UpdateIssueOptions{RemoveLabels: &gitlab.LabelOptions{"A"}},
+		},
+		{
+			name: "assignee to assignee",
+			from: assignee,
+			to:   newAssignee,
+			want: &gitlab.UpdateIssueOptions{AssigneeIDs: &[]int64{1, 9}},
+		},
+		{
+			name: "assignee to assignee already assigned",
+			from: assignee,
+			to:   otherAssignee,
+			want: &gitlab.UpdateIssueOptions{AssigneeIDs: &[]int64{1}},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := filterIssues(
-				tt.args.boardLists,
-				tt.args.issues,
-				tt.args.targetList,
-				tt.args.opts,
-			)
-			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, moveOptions(tt.from, tt.to, issue))
+		})
+	}
+}
+
+func Test_labelOptions(t *testing.T) {
+	tests := []struct {
+		input string
+		want  *gitlab.UpdateIssueOptions
+	}{
+		{
+			input: "a, b",
+			want:  &gitlab.UpdateIssueOptions{Labels: &gitlab.LabelOptions{"a", "b"}},
+		},
+		{
+			input: "+a,-b,!c",
+			want: &gitlab.UpdateIssueOptions{
+				AddLabels:    &gitlab.LabelOptions{"a"},
+				RemoveLabels: &gitlab.LabelOptions{"b", "c"},
+			},
+		},
+		{
+			input: "",
+			want:  &gitlab.UpdateIssueOptions{Labels: &gitlab.LabelOptions{}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			assert.Equal(t, tt.want, labelOptions(tt.input))
 		})
 	}
 }
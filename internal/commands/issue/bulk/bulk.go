@@ -0,0 +1,20 @@
+package bulk
+
+import (
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	bulkUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/bulk/update"
+)
+
+func NewCmdBulk(f cmdutils.Factory) *cobra.Command {
+	bulkCmd := &cobra.Command{
+		Use:   "bulk [command] [flags]",
+		Short: `Change many issues at once.`,
+		Long:  ``,
+	}
+
+	bulkCmd.AddCommand(bulkUpdateCmd.NewCmdUpdate(f))
+
+	return bulkCmd
+}
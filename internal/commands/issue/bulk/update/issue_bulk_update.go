@@ -0,0 +1,538 @@
+package update
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	// selection
+	stdin         bool
+	labels        []string
+	notLabels     []string
+	milestone     string
+	author        string
+	search        string
+	createdBefore string
+	createdAfter  string
+	closed        bool
+	all           bool
+
+	// changes
+	addLabels    []string
+	removeLabels []string
+	setMilestone string
+	assignees    []string
+	unassign     bool
+	close        bool
+	reopen       bool
+	confidential bool
+	public       bool
+
+	dryRun      bool
+	yes         bool
+	concurrency int
+	rate        float64
+
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	milestoneChanged bool
+}
+
+// result is the outcome of updating one issue.
+type result struct {
+	issue *gitlab.Issue
+	err   error
+}
+
+func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "update [flags]",
+		Short: `Update many issues at once.`,
+		Long: heredoc.Docf(`
+			Update all issues that match the filters, or the issues whose IDs are read from
+			standard input with %[1]s--stdin%[1]s.
+
+			The filters work like the filters of %[1]sglab issue list%[1]s. By default, only open
+			issues are selected. At least one filter is required, so that all open issues
+			aren't updated by mistake.
+
+			Before updating, the selected issues and the changes are shown, and you are asked
+			to confirm. Use %[1]s--dry-run%[1]s to only show them, and %[1]s--yes%[1]s to skip the confirmation.
+
+			Issues are updated concurrently, and the number of requests per second is limited
+			with %[1]s--rate%[1]s. Issues that fail to update are listed at the end, and the
+			command exits with a non-zero status.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Add a label to all open issues with the label "bug" in milestone 1.0
+			$ glab issue bulk update --label bug --milestone 1.0 --add-label needs-triage
+
+			# Close stale issues created before 2024
+			$ glab issue bulk update --search "flaky" --created-before 2024-01-01 --close --yes
+
+			# Preview moving the issues listed by another command to a milestone
+			$ glab issue list --label backend -F ids | glab issue bulk update --stdin --set-milestone 2.0 --dry-run
+
+			# Add and remove assignees
+			$ glab issue bulk update --label frontend --assign +alice,-bob
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.milestoneChanged = cmd.Flags().Changed("set-milestone")
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.BoolVar(&opts.stdin, "stdin", false, "Read the IDs of the issues to update from standard input, separated by spaces, commas, or new lines.")
+	fl.StringSliceVarP(&opts.labels, "label", "l", []string{}, "Select issues with label <name>. Multiple labels can be comma-separated or specified by repeating the flag.")
+	fl.StringSliceVar(&opts.notLabels, "not-label", []string{}, "Select issues without label <name>.")
+	fl.StringVarP(&opts.milestone, "milestone", "m", "", "Select issues in milestone <title>.")
+	fl.StringVar(&opts.author, "author", "", "Select issues by author <username>.")
+	fl.StringVar(&opts.search, "search", "", "Select issues with <string> in their title or description.")
+	fl.StringVar(&opts.createdBefore, "created-before", "", "Select issues created before a date in 'YYYY-MM-DD' format.")
+	fl.StringVar(&opts.createdAfter, "created-after", "", "Select issues created after a date in 'YYYY-MM-DD' format.")
+	fl.BoolVarP(&opts.closed, "closed", "c", false, "Select closed issues instead of open issues.")
+	fl.BoolVarP(&opts.all, "all", "A", false, "Select open and closed issues.")
+
+	fl.StringSliceVar(&opts.addLabels, "add-label", []string{}, "Add labels.")
+	fl.StringSliceVar(&opts.removeLabels, "remove-label", []string{}, "Remove labels.")
+	fl.StringVar(&opts.setMilestone, "set-milestone", "", "Title or ID of the milestone to set. Set to \"\" or 0 to unassign.")
+	fl.StringSliceVar(&opts.assignees, "assign", []string{}, "Assign users by username. Prefix with '!' or '-' to remove from existing assignees, or '+' to add new. Otherwise, replace existing assignees with these users.")
+	fl.BoolVar(&opts.unassign, "unassign", false, "Unassign all users.")
+	fl.BoolVar(&opts.close, "close", false, "Close the issues.")
+	fl.BoolVar(&opts.reopen, "reopen", false, "Reopen the issues.")
+	fl.BoolVar(&opts.confidential, "confidential", false, "Make the issues confidential.")
+	fl.BoolVar(&opts.public, "public", false, "Make the issues public.")
+
+	fl.BoolVar(&opts.dryRun, "dry-run", false, "Show the issues and the changes, without updating the issues.")
+	fl.BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt.")
+	fl.IntVar(&opts.concurrency, "concurrency", 4, "Number of issues to update at the same time.")
+	fl.Float64Var(&opts.rate, "rate", 10, "Maximum number of update requests per second. Set to 0 for no limit.")
+
+	cmd.MarkFlagsMutuallyExclusive("stdin", "label")
+	cmd.MarkFlagsMutuallyExclusive("stdin", "not-label")
+	cmd.MarkFlagsMutuallyExclusive("stdin", "milestone")
+	cmd.MarkFlagsMutuallyExclusive("stdin", "author")
+	cmd.MarkFlagsMutuallyExclusive("stdin", "search")
+	cmd.MarkFlagsMutuallyExclusive("stdin", "created-before")
+	cmd.MarkFlagsMutuallyExclusive("stdin", "created-after")
+	cmd.MarkFlagsMutuallyExclusive("closed", "all")
+	cmd.MarkFlagsMutuallyExclusive("assign", "unassign")
+	cmd.MarkFlagsMutuallyExclusive("close", "reopen")
+	cmd.MarkFlagsMutuallyExclusive("confidential", "public")
+
+	return cmd
+}
+
+func (o *options) validate() error {
+	hasFilter := len(o.labels) > 0 || len(o.notLabels) > 0 || o.milestone != "" || o.author != "" ||
+		o.search != "" || o.createdBefore != "" || o.createdAfter != ""
+	if !o.stdin && !hasFilter {
+		return &cmdutils.FlagError{Err: errors.New("select issues with at least one filter, or with --stdin.")}
+	}
+
+	hasChange := len(o.addLabels) > 0 || len(o.removeLabels) > 0 || o.milestoneChanged || len(o.assignees) > 0 ||
+		o.unassign || o.close || o.reopen || o.confidential || o.public
+	if !hasChange {
+		return &cmdutils.FlagError{Err: errors.New("specify at least one change, like --add-label or --close.")}
+	}
+
+	if len(o.assignees) > 0 {
+		if err := cmdutils.ParseAssignees(o.assignees).VerifyAssignees(); err != nil {
+			return &cmdutils.FlagError{Err: fmt.Errorf("--assign: %w", err)}
+		}
+	}
+
+	if o.concurrency < 1 {
+		return &cmdutils.FlagError{Err: errors.New("--concurrency must be at least 1.")}
+	}
+	if o.rate < 0 {
+		return &cmdutils.FlagError{Err: errors.New("--rate can't be negative.")}
+	}
+
+	if !o.dryRun && !o.yes && !o.io.PromptEnabled() {
+		return &cmdutils.FlagError{Err: errors.New("--yes or --dry-run is required when not running interactively.")}
+	}
+
+	return nil
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	issues, err := o.selectIssues(client, repo)
+	if err != nil {
+		return err
+	}
+	if len(issues) == 0 {
+		fmt.Fprintln(o.io.StdErr, "No issues match the selection.")
+		return nil
+	}
+
+	change, actions, err := o.changes(client, repo)
+	if err != nil {
+		return err
+	}
+
+	assign, err := o.assigner(client)
+	if err != nil {
+		return err
+	}
+
+	o.preview(repo, issues, actions)
+
+	if o.dryRun {
+		fmt.Fprintln(o.io.StdOut, "Dry run: no issues were updated.")
+		return nil
+	}
+
+	if !o.yes {
+		confirmed := false
+		err := o.io.Confirm(ctx, &confirmed, fmt.Sprintf("Update %s?", utils.Pluralize(len(issues), "issue")))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !confirmed {
+			return cmdutils.CancelError()
+		}
+	}
+
+	results := o.updateAll(ctx, issues, func(issue *gitlab.Issue) error {
+		opts := *change
+		if assign != nil {
+			opts.AssigneeIDs = assign(issue)
+		}
+
+		_, _, err := client.Issues.UpdateIssue(repo.FullName(), issue.IID, &opts)
+		return err
+	})
+
+	return o.summary(results)
+}
+
+// selectIssues returns the issues read from standard input, or the issues that match the filters.
+func (o *options) selectIssues(client *gitlab.Client, repo glrepo.Interface) ([]*gitlab.Issue, error) {
+	listOpts := &gitlab.ListProjectIssuesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		State:       gitlab.Ptr("opened"),
+	}
+
+	if o.stdin {
+		iids, err := issueutils.ReadIIDs(o.io.In)
+		if err != nil {
+			return nil, err
+		}
+		if len(iids) == 0 {
+			return nil, errors.New("no issue IDs were read from standard input.")
+		}
+
+		return listByIIDs(client, repo, iids)
+	}
+
+	switch {
+	case o.all:
+		listOpts.State = gitlab.Ptr("all")
+	case o.closed:
+		listOpts.State = gitlab.Ptr("closed")
+	}
+	if len(o.labels) > 0 {
+		listOpts.Labels = (*gitlab.LabelOptions)(&o.labels)
+	}
+	if len(o.notLabels) > 0 {
+		listOpts.NotLabels = (*gitlab.LabelOptions)(&o.notLabels)
+	}
+	if o.milestone != "" {
+		listOpts.Milestone = gitlab.Ptr(o.milestone)
+	}
+	if o.author != "" {
+		listOpts.AuthorUsername = gitlab.Ptr(strings.TrimPrefix(o.author, "@"))
+	}
+	if o.search != "" {
+		listOpts.Search = gitlab.Ptr(o.search)
+	}
+	if o.createdBefore != "" {
+		date, err := parseDate(o.createdBefore)
+		if err != nil {
+			return nil, &cmdutils.FlagError{Err: fmt.Errorf("--created-before: %w", err)}
+		}
+		listOpts.CreatedBefore = &date
+	}
+	if o.createdAfter != "" {
+		date, err := parseDate(o.createdAfter)
+		if err != nil {
+			return nil, &cmdutils.FlagError{Err: fmt.Errorf("--created-after: %w", err)}
+		}
+		listOpts.CreatedAfter = &date
+	}
+
+	return listAll(client, repo, listOpts)
+}
+
+func listAll(client *gitlab.Client, repo glrepo.Interface, opts *gitlab.ListProjectIssuesOptions) ([]*gitlab.Issue, error) {
+	var issues []*gitlab.Issue
+	for {
+		page, resp, err := client.Issues.ListProjectIssues(repo.FullName(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("error listing issues: %w", err)
+		}
+		issues = append(issues, page...)
+
+		if resp.NextPage == 0 {
+			return issues, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// listByIIDs returns the issues with the IDs, in batches of the maximum page size.
+func listByIIDs(client *gitlab.Client, repo glrepo.Interface, iids []int64) ([]*gitlab.Issue, error) {
+	var issues []*gitlab.Issue
+	for batch := range slices.Chunk(iids, int(api.MaxPerPage)) {
+		found, err := listAll(client, repo, &gitlab.ListProjectIssuesOptions{
+			ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+			IIDs:        &batch,
+			State:       gitlab.Ptr("all"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		issues = append(issues, found...)
+	}
+
+	if len(issues) < len(iids) {
+		var missing []string
+		for _, iid := range iids {
+			if !slices.ContainsFunc(issues, func(i *gitlab.Issue) bool { return i.IID == iid }) {
+				missing = append(missing, fmt.Sprintf("#%d", iid))
+			}
+		}
+		return nil, fmt.Errorf("issues not found in %s: %s", repo.FullName(), strings.Join(missing, ", "))
+	}
+
+	return issues, nil
+}
+
+func parseDate(s string) (time.Time, error) {
+	if date, err := time.Parse(time.DateOnly, s); err == nil {
+		return date, nil
+	}
+	date, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q. Use the 'YYYY-MM-DD' format.", s)
+	}
+	return date, nil
+}
+
+// changes returns the update options that are the same for all issues, and a description of each change.
+func (o *options) changes(client *gitlab.Client, repo glrepo.Interface) (*gitlab.UpdateIssueOptions, []string, error) {
+	var actions []string
+	opts := &gitlab.UpdateIssueOptions{}
+
+	if len(o.addLabels) > 0 {
+		actions = append(actions, fmt.Sprintf("add labels %s", strings.Join(o.addLabels, ", ")))
+		opts.AddLabels = (*gitlab.LabelOptions)(&o.addLabels)
+	}
+	if len(o.removeLabels) > 0 {
+		actions = append(actions, fmt.Sprintf("remove labels %s", strings.Join(o.removeLabels, ", ")))
+		opts.RemoveLabels = (*gitlab.LabelOptions)(&o.removeLabels)
+	}
+	if o.milestoneChanged {
+		if o.setMilestone == "" || o.setMilestone == "0" {
+			actions = append(actions, "unassign milestone")
+			opts.MilestoneID = gitlab.Ptr(int64(0))
+		} else {
+			id, err := cmdutils.ParseMilestone(client, repo, o.setMilestone)
+			if err != nil {
+				return nil, nil, err
+			}
+			actions = append(actions, fmt.Sprintf("set milestone %q", o.setMilestone))
+			opts.MilestoneID = gitlab.Ptr(id)
+		}
+	}
+	if o.unassign {
+		actions = append(actions, "unassign all users")
+		opts.AssigneeIDs = &[]int64{0}
+	}
+	if len(o.assignees) > 0 {
+		actions = append(actions, fmt.Sprintf("assign %s", strings.Join(o.assignees, ", ")))
+	}
+	if o.close {
+		actions = append(actions, "close")
+		opts.StateEvent = gitlab.Ptr("close")
+	}
+	if o.reopen {
+		actions = append(actions, "reopen")
+		opts.StateEvent = gitlab.Ptr("reopen")
+	}
+	if o.confidential {
+		actions = append(actions, "make confidential")
+		opts.Confidential = gitlab.Ptr(true)
+	}
+	if o.public {
+		actions = append(actions, "make public")
+		opts.Confidential = gitlab.Ptr(false)
+	}
+
+	return opts, actions, nil
+}
+
+// assigner resolves the users of --assign once, and returns a function that computes the assignees of each issue.
+func (o *options) assigner(client *gitlab.Client) (func(*gitlab.Issue) *[]int64, error) {
+	if len(o.assignees) == 0 {
+		return nil, nil
+	}
+
+	ua := cmdutils.ParseAssignees(o.assignees)
+	if len(ua.ToReplace) > 0 {
+		ids, _, err := ua.UsersFromReplaces(client, nil)
+		if err != nil {
+			return nil, err
+		}
+		return func(*gitlab.Issue) *[]int64 { return ids }, nil
+	}
+
+	added, err := api.UsersByNames(client, ua.ToAdd)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(issue *gitlab.Issue) *[]int64 {
+		ids := []int64{}
+		for _, assignee := range issue.Assignees {
+			if !slices.Contains(ua.ToRemove, assignee.Username) {
+				ids = append(ids, assignee.ID)
+			}
+		}
+		for _, user := range added {
+			if !slices.Contains(ids, user.ID) {
+				ids = append(ids, user.ID)
+			}
+		}
+		return &ids
+	}, nil
+}
+
+func (o *options) preview(repo glrepo.Interface, issues []*gitlab.Issue, actions []string) {
+	c := o.io.Color()
+
+	fmt.Fprintf(o.io.StdOut, "%s in %s:\n", utils.Pluralize(len(issues), "issue"), repo.FullName())
+	for _, issue := range issues {
+		fmt.Fprintf(o.io.StdOut, "  %s %s\n", c.Cyan(fmt.Sprintf("#%d", issue.IID)), issue.Title)
+	}
+
+	fmt.Fprintln(o.io.StdOut, "\nChanges:")
+	for _, action := range actions {
+		fmt.Fprintf(o.io.StdOut, "  - %s\n", action)
+	}
+	fmt.Fprintln(o.io.StdOut)
+}
+
+// updateAll runs update for each issue with a pool of workers. With a rate, the updates are
+// spread so that no more than rate updates start each second.
+func (o *options) updateAll(ctx context.Context, issues []*gitlab.Issue, update func(*gitlab.Issue) error) []result {
+	var tick <-chan time.Time
+	if o.rate > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / o.rate))
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	jobs := make(chan int)
+	results := make([]result, len(issues))
+
+	var wg sync.WaitGroup
+	for range min(o.concurrency, len(issues)) {
+		wg.Go(func() {
+			for i := range jobs {
+				results[i] = result{issue: issues[i], err: update(issues[i])}
+			}
+		})
+	}
+
+	for i := range issues {
+		if tick != nil && i > 0 {
+			select {
+			case <-tick:
+			case <-ctx.Done():
+			}
+		}
+		if ctx.Err() != nil {
+			results[i] = result{issue: issues[i], err: ctx.Err()}
+			continue
+		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return results
+}
+
+func (o *options) summary(results []result) error {
+	c := o.io.Color()
+
+	var failed []result
+	for _, r := range results {
+		if r.err != nil {
+			failed = append(failed, r)
+		}
+	}
+
+	updated := len(results) - len(failed)
+	fmt.Fprintf(o.io.StdOut, "%s Updated %s.\n", c.GreenCheck(), utils.Pluralize(updated, "issue"))
+
+	if len(failed) == 0 {
+		return nil
+	}
+
+	fmt.Fprintf(o.io.StdErr, "%s Failed to update %s:\n", c.FailedIcon(), utils.Pluralize(len(failed), "issue"))
+	for _, r := range failed {
+		fmt.Fprintf(o.io.StdErr, "  #%d %s: %s\n", r.issue.IID, r.issue.Title, r.err)
+	}
+
+	return cmdutils.SilentError
+}
@@ -0,0 +1,166 @@
+//go:build !integration
+
+package update
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+var testIssues = []*gitlab.Issue{
+	{IID: 1, Title: "First", Assignees: []*gitlab.IssueAssignee{{ID: 10, Username: "alice"}, {ID: 11, Username: "bob"}}},
+	{IID: 2, Title: "Second"},
+}
+
+func TestBulkUpdate_filters(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockIssues.EXPECT().
+		ListProjectIssues("OWNER/REPO", gomock.Any()).
+		DoAndReturn(func(pid any, opts *gitlab.ListProjectIssuesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			assert.Equal(t, "opened", *opts.State)
+			assert.Equal(t, gitlab.LabelOptions{"bug"}, *opts.Labels)
+			assert.Equal(t, "alice", *opts.AuthorUsername)
+			assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), *opts.CreatedBefore)
+			return testIssues, &gitlab.Response{}, nil
+		})
+
+	for _, iid := range []int64{1, 2} {
+		tc.MockIssues.EXPECT().
+			UpdateIssue("OWNER/REPO", iid, gomock.Any()).
+			DoAndReturn(func(pid any, iid int64, opts *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+				assert.Equal(t, gitlab.LabelOptions{"triaged"}, *opts.AddLabels)
+				assert.Equal(t, "close", *opts.StateEvent)
+				return &gitlab.Issue{IID: iid}, nil, nil
+			})
+	}
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdUpdate, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("--label bug --author @alice --created-before 2024-01-01 --add-label triaged --close --yes --rate 0")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "2 issues in OWNER/REPO:")
+	assert.Contains(t, out.String(), "#1 First")
+	assert.Contains(t, out.String(), "  - add labels triaged\n  - close\n")
+	assert.Contains(t, out.String(), "Updated 2 issues.")
+}
+
+func TestBulkUpdate_stdinDryRun(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockIssues.EXPECT().
+		ListProjectIssues("OWNER/REPO", gomock.Any()).
+		DoAndReturn(func(pid any, opts *gitlab.ListProjectIssuesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			assert.Equal(t, []int64{1, 2}, *opts.IIDs)
+			assert.Equal(t, "all", *opts.State)
+			return testIssues, &gitlab.Response{}, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdUpdate, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithStdin("1\n#2, 1\n"),
+	)
+
+	out, err := exec("--stdin --remove-label bug --dry-run")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "  - remove labels bug\n")
+	assert.Contains(t, out.String(), "Dry run: no issues were updated.")
+}
+
+func TestBulkUpdate_missingIssues(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockIssues.EXPECT().
+		ListProjectIssues("OWNER/REPO", gomock.Any()).
+		Return(testIssues[:1], &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdUpdate, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithStdin("1 2"),
+	)
+
+	_, err := exec("--stdin --close --dry-run")
+	require.EqualError(t, err, "issues not found in OWNER/REPO: #2")
+}
+
+func TestBulkUpdate_failures(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockIssues.EXPECT().
+		ListProjectIssues("OWNER/REPO", gomock.Any()).
+		Return(testIssues, &gitlab.Response{}, nil)
+	tc.MockUsers.EXPECT().
+		ListUsers(gomock.Any()).
+		Return([]*gitlab.User{{ID: 12, Username: "carol"}}, nil, nil)
+
+	tc.MockIssues.EXPECT().
+		UpdateIssue("OWNER/REPO", int64(1), gomock.Any()).
+		DoAndReturn(func(pid any, iid int64, opts *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+			assert.Equal(t, []int64{10, 12}, *opts.AssigneeIDs)
+			return &gitlab.Issue{IID: iid}, nil, nil
+		})
+	tc.MockIssues.EXPECT().
+		UpdateIssue("OWNER/REPO", int64(2), gomock.Any()).
+		DoAndReturn(func(pid any, iid int64, opts *gitlab.UpdateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+			assert.Equal(t, []int64{12}, *opts.AssigneeIDs)
+			return nil, nil, errors.New("403 Forbidden")
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdUpdate, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("--milestone 1.0 --assign +carol,-bob --yes --concurrency 2")
+	require.Error(t, err)
+
+	assert.Contains(t, out.String(), "Updated 1 issue.")
+	assert.Contains(t, out.Stderr(), "Failed to update 1 issue:\n  #2 Second: 403 Forbidden\n")
+}
+
+func TestBulkUpdate_validation(t *testing.T) {
+	tests := []struct {
+		name string
+		cli  string
+		want string
+	}{
+		{
+			name: "no selection",
+			cli:  "--close --yes",
+			want: "select issues with at least one filter, or with --stdin.",
+		},
+		{
+			name: "no change",
+			cli:  "--label bug --yes",
+			want: "specify at least one change, like --add-label or --close.",
+		},
+		{
+			name: "no confirmation",
+			cli:  "--label bug --close",
+			want: "--yes or --dry-run is required when not running interactively.",
+		},
+		{
+			name: "mixed assignees",
+			cli:  "--label bug --assign alice,+bob --yes",
+			want: "--assign: mixing relative (+,!,-) and absolute assignments is forbidden.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := cmdtest.SetupCmdForTest(t, NewCmdUpdate, false)
+
+			_, err := exec(tt.cli)
+			require.EqualError(t, err, tt.want)
+		})
+	}
+}
@@ -0,0 +1,165 @@
+package clone
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	bulk      bool
+	withNotes bool
+
+	io              *iostreams.IOStreams
+	gitlabClient    func() (*gitlab.Client, error)
+	apiClient       func(repoHost string) (*api.Client, error)
+	baseRepo        func() (glrepo.Interface, error)
+	defaultHostname string
+}
+
+func NewCmdClone(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		gitlabClient:    f.GitLabClient,
+		apiClient:       f.ApiClient,
+		baseRepo:        f.BaseRepo,
+		defaultHostname: f.DefaultHostname(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "clone <id> [<project>]",
+		Short: `Clone an issue, to the same or another project.`,
+		Long: heredoc.Docf(`
+			Clone an issue with its title, description, labels, and metadata, and print the URL
+			of the new issue. Without a project, the issue is cloned in its own project.
+			Use %[1]s--with-notes%[1]s to also copy the comments and system notes.
+
+			The project can be given as %[1]sOWNER/REPO%[1]s, %[1]sGROUP/NAMESPACE/REPO%[1]s, or a full URL.
+			Without a host, the project is on the host of the issue.
+
+			With %[1]s--bulk%[1]s, the issue ID is left out, and the IDs of the issues to clone are
+			read from standard input.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab issue clone 42
+			$ glab issue clone 42 gitlab-org/cli --with-notes
+			$ glab issue list --label template -F ids | glab issue clone --bulk gitlab-org/cli
+		`),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if opts.bulk {
+				return cobra.MaximumNArgs(1)(cmd, args)
+			}
+			return cobra.RangeArgs(1, 2)(cmd, args)
+		},
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(cmd.Context(), args)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.bulk, "bulk", false, "Read the IDs of the issues to clone from standard input.")
+	cmd.Flags().BoolVar(&opts.withNotes, "with-notes", false, "Copy the comments and system notes of the issue.")
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context, args []string) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	var issues []*gitlab.Issue
+	var repo glrepo.Interface
+	if o.bulk {
+		issues, repo, err = issueutils.IssuesFromStdin(ctx, o.apiClient, client, o.baseRepo, o.defaultHostname, o.io.In)
+	} else {
+		var issue *gitlab.Issue
+		issue, repo, err = issueutils.IssueFromArg(o.apiClient, client, o.baseRepo, o.defaultHostname, args[0])
+		issues = []*gitlab.Issue{issue}
+		args = args[1:]
+	}
+	if err != nil {
+		return err
+	}
+
+	target := repo
+	if len(args) > 0 {
+		target, err = issueutils.TargetRepo(args[0], repo)
+		if err != nil {
+			return err
+		}
+	}
+
+	client, err = issueutils.ClientForRepo(o.apiClient, client, o.baseRepo, repo)
+	if err != nil {
+		return err
+	}
+
+	project, err := api.GetProject(client, target.FullName())
+	if err != nil {
+		return fmt.Errorf("error getting project %s: %w", target.FullName(), err)
+	}
+
+	c := o.io.Color()
+	failed := 0
+	for _, issue := range issues {
+		cloned, err := o.clone(client, issue, project.ID)
+		if err != nil {
+			fmt.Fprintf(o.io.StdErr, "%s Failed to clone #%d: %s\n", c.FailedIcon(), issue.IID, err)
+			failed++
+			continue
+		}
+
+		if o.io.IsOutputTTY() {
+			fmt.Fprintf(o.io.StdOut, "%s Cloned #%d to %s\n", c.GreenCheck(), issue.IID, cloned.WebURL)
+		} else {
+			fmt.Fprintln(o.io.StdOut, cloned.WebURL)
+		}
+	}
+
+	if failed > 0 {
+		if len(issues) == 1 {
+			return cmdutils.SilentError
+		}
+		return fmt.Errorf("failed to clone %d of %d issues.", failed, len(issues))
+	}
+
+	return nil
+}
+
+// cloneIssueOptions are the options of the endpoint that clones an issue, which the client
+// library has no method for.
+type cloneIssueOptions struct {
+	ToProjectID int64 `json:"to_project_id"`
+	WithNotes   bool  `json:"with_notes"`
+}
+
+// clone clones the issue to the project with the given ID.
+func (o *options) clone(client *gitlab.Client, issue *gitlab.Issue, projectID int64) (*gitlab.Issue, error) {
+	path := fmt.Sprintf("projects/%d/issues/%d/clone", issue.ProjectID, issue.IID)
+	request, err := client.NewRequest(http.MethodPost, path, &cloneIssueOptions{ToProjectID: projectID, WithNotes: o.withNotes}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	cloned := new(gitlab.Issue)
+	if _, err := client.Do(request, cloned); err != nil {
+		return nil, err
+	}
+	return cloned, nil
+}
@@ -0,0 +1,97 @@
+//go:build !integration
+
+package clone
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+// setupCloneServer serves issue #42 of OWNER/REPO, the projects OWNER/REPO and group/other, and
+// the clone endpoint of the issue. This test uses httptest.NewServer because the clone endpoint is
+// called via client.Do() rather than a GitLab client-go service interface.
+func setupCloneServer(t *testing.T, clone http.HandlerFunc) *gitlab.Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/OWNER/REPO/issues/42":
+			_, _ = w.Write([]byte(`{"id": 100, "project_id": 1, "iid": 42}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/OWNER/REPO":
+			_, _ = w.Write([]byte(`{"id": 1, "path_with_namespace": "OWNER/REPO"}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v4/projects/group/other":
+			_, _ = w.Write([]byte(`{"id": 2, "path_with_namespace": "group/other"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v4/projects/1/issues/42/clone":
+			clone(w, r)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := gitlab.NewClient("test-token", gitlab.WithBaseURL(server.URL+"/api/v4"))
+	require.NoError(t, err)
+	return client
+}
+
+// expectClone returns a handler of the clone endpoint that checks the request and responds
+// with the cloned issue.
+func expectClone(t *testing.T, want cloneIssueOptions, cloned string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var got cloneIssueOptions
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		assert.Equal(t, want, got)
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(cloned))
+	}
+}
+
+func TestIssueClone(t *testing.T) {
+	client := setupCloneServer(t, expectClone(t, cloneIssueOptions{ToProjectID: 2, WithNotes: true},
+		`{"id": 107, "iid": 7, "web_url": "https://gitlab.com/group/other/-/issues/7"}`))
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdClone, false, cmdtest.WithGitLabClient(client))
+
+	out, err := exec("42 group/other --with-notes")
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://gitlab.com/group/other/-/issues/7\n", out.String())
+}
+
+func TestIssueClone_sameProject(t *testing.T) {
+	client := setupCloneServer(t, expectClone(t, cloneIssueOptions{ToProjectID: 1},
+		`{"id": 143, "iid": 43, "web_url": "https://gitlab.com/OWNER/REPO/-/issues/43"}`))
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdClone, true, cmdtest.WithGitLabClient(client))
+
+	out, err := exec("42")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "Cloned #42 to https://gitlab.com/OWNER/REPO/-/issues/43")
+}
+
+func TestIssueClone_failed(t *testing.T) {
+	client := setupCloneServer(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "403 Forbidden"}`))
+	})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdClone, false, cmdtest.WithGitLabClient(client))
+
+	out, err := exec("42 group/other")
+	require.Error(t, err)
+	assert.Empty(t, out.String())
+	assert.Contains(t, out.Stderr(), "Failed to clone #42")
+	assert.Contains(t, out.Stderr(), "403 Forbidden")
+}
@@ -60,6 +60,7 @@ type options struct {
 	yes           bool
 	web           bool
 	recover       bool
+	template      string
 
 	io           *iostreams.IOStreams
 	baseRepo     func() (glrepo.Interface, error)
@@ -89,6 +90,7 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			$ glab issue create -m release-2.0.0 -t "we need this feature" --label important
 			$ glab issue new -t "Fix CVE-YYYY-XXXX" -l security --linked-mr 123
 			$ glab issue create -m release-1.0.1 -t "security fix" --label security --web --recover
+			$ glab issue create --template Bug -t "crash on startup"
 		`),
 		Args: cobra.ExactArgs(0),
 		Annotations: map[string]string{
@@ -108,10 +110,10 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			hasDescription := cmd.Flags().Changed("description")
 
 			// disable interactive mode if title and description are explicitly defined
-			opts.isInteractive = !(hasTitle && hasDescription)
+			opts.isInteractive = !(hasTitle && (hasDescription || opts.template != ""))
 
 			if opts.isInteractive && !opts.io.PromptEnabled() {
-				return &cmdutils.FlagError{Err: errors.New("'--title' and '--description' required for non-interactive mode.")}
+				return &cmdutils.FlagError{Err: errors.New("'--title' and '--description' or '--template' required for non-interactive mode.")}
 			}
 
 			// Remove this once --yes does more than just skip the prompts that --web happen to skip
@@ -163,6 +165,8 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 	issueCreateCmd.Flags().BoolVar(&opts.recover, "recover", false, "Save the options to a file if the issue fails to be created. If the file exists, the options will be loaded from the recovery file. (EXPERIMENTAL)")
 	issueCreateCmd.Flags().Int64VarP(&opts.EpicID, "epic", "", 0, "ID of the epic to add the issue to.")
 	issueCreateCmd.Flags().StringVarP(&opts.DueDate, "due-date", "", "", "A date in 'YYYY-MM-DD' format.")
+	issueCreateCmd.Flags().StringVar(&opts.template, "template", "", "Use the description template with this `name`, from '.gitlab/issue_templates' or the project templates. Quick actions in the template set labels, assignees, milestone, weight, and confidentiality.")
+	issueCreateCmd.MarkFlagsMutuallyExclusive("template", "description")
 
 	return issueCreateCmd
 }
@@ -201,6 +205,19 @@ var createRun = func(ctx context.Context, opts *options) error {
 		}
 	}
 
+	templates := cmdutils.NewTemplateSource(apiClient, repo, opts.remotes)
+	if opts.template != "" {
+		templateName = opts.template
+		templateContents, err = templates.Load(cmdutils.IssueTemplate, templateName)
+		if err != nil {
+			return err
+		}
+
+		if !opts.isInteractive {
+			opts.Description = templateContents
+		}
+	}
+
 	// Handle -d- flag to directly open external editor
 	if opts.Description == "-" {
 		editor, err := cmdutils.GetEditor(opts.config)
@@ -217,8 +234,8 @@ var createRun = func(ctx context.Context, opts *options) error {
 
 	if opts.isInteractive {
 		// Step 1: Template selection (if not using --no-editor and description is empty)
-		if opts.Description == "" && !opts.noEditor {
-			templateNames, err := cmdutils.ListGitLabTemplates(cmdutils.IssueTemplate)
+		if opts.Description == "" && !opts.noEditor && templateName == "" {
+			templateNames, err := templates.List(cmdutils.IssueTemplate)
 			if err != nil {
 				return fmt.Errorf("error getting templates: %w", err)
 			}
@@ -233,7 +250,7 @@ var createRun = func(ctx context.Context, opts *options) error {
 
 			if selectedTemplate != blankIssueOption {
 				templateName = selectedTemplate
-				templateContents, err = cmdutils.LoadGitLabTemplate(cmdutils.IssueTemplate, templateName)
+				templateContents, err = templates.Load(cmdutils.IssueTemplate, templateName)
 				if err != nil {
 					return fmt.Errorf("failed to get template contents: %w", err)
 				}
@@ -262,6 +279,11 @@ var createRun = func(ctx context.Context, opts *options) error {
 
 			// Add description field if needed
 			if needsDescription {
+				// Set initial value from template
+				if templateContents != "" {
+					opts.Description = templateContents
+				}
+
 				if opts.noEditor {
 					// Use multiline text input
 					fields = append(fields, huh.NewText().
@@ -274,11 +296,6 @@ var createRun = func(ctx context.Context, opts *options) error {
 						return err
 					}
 
-					// Set initial value from template
-					if templateContents != "" {
-						opts.Description = templateContents
-					}
-
 					textField := huh.NewText().
 						Title("Description").
 						Value(&opts.Description).
@@ -305,6 +322,12 @@ var createRun = func(ctx context.Context, opts *options) error {
 		return fmt.Errorf("title can't be blank")
 	}
 
+	if templateName != "" {
+		if err := applyQuickActions(apiClient, repo, opts); err != nil {
+			return err
+		}
+	}
+
 	var action cmdutils.Action
 
 	// submit without prompting for non interactive mode
@@ -541,3 +564,29 @@ func createRecoverSaveFile(repoName string, opts *options) error {
 	fmt.Fprintf(opts.io.StdErr, "Failed to create issue. Created recovery file: %s\nRun the command again with the '--recover' option to retry", recoverFile)
 	return nil
 }
+
+// applyQuickActions moves the quick actions of the description that glab can apply to the options.
+// Options set with flags take precedence over the milestone and weight of the description.
+func applyQuickActions(client *gitlab.Client, repo glrepo.Interface, opts *options) error {
+	var actions cmdutils.QuickActions
+	opts.Description, actions = cmdutils.ExtractQuickActions(opts.Description, cmdutils.QuickActionsForIssue)
+
+	opts.Labels = append(opts.Labels, actions.Labels...)
+	opts.Assignees = append(opts.Assignees, actions.Assignees...)
+	opts.IsConfidential = opts.IsConfidential || actions.Confidential
+
+	if actions.Weight != nil && opts.Weight == 0 {
+		opts.Weight = *actions.Weight
+	}
+
+	if actions.Milestone != "" && opts.MilestoneFlag == "" {
+		milestone, err := cmdutils.ParseMilestone(client, repo, actions.Milestone)
+		if err != nil {
+			return err
+		}
+		opts.MilestoneFlag = actions.Milestone
+		opts.Milestone = milestone
+	}
+
+	return nil
+}
@@ -3,14 +3,23 @@
 package create
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	gitlab "gitlab.com/gitlab-org/api/client-go"
 	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
 
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/glinstance"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
 )
 
@@ -76,3 +85,47 @@ func TestIssueCreateWhenIssuesDisabled(t *testing.T) {
 		"Make sure issues are enabled for the \"OWNER/REPO\" project, and if required, you are a member of the project.\n",
 		output.Stderr())
 }
+
+func TestIssueCreateWithTemplate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, ".gitlab", "issue_templates"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, ".gitlab", "issue_templates", "Bug.md"),
+		[]byte("## Steps to reproduce\n\n/label ~bug ~\"needs triage\"\n/weight 2\n/confidential\n/due tomorrow\n"), 0o644))
+
+	toplevelDir := git.ToplevelDir
+	git.ToplevelDir = func() (string, error) { return dir, nil }
+	t.Cleanup(func() { git.ToplevelDir = toplevelDir })
+
+	testClient := gitlabtesting.NewTestClient(t)
+
+	testClient.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{ID: 1, PathWithNamespace: "OWNER/REPO", IssuesEnabled: true}, nil, nil)
+
+	testClient.MockIssues.EXPECT().
+		CreateIssue("OWNER/REPO", gomock.Any()).
+		DoAndReturn(func(pid any, opts *gitlab.CreateIssueOptions, options ...gitlab.RequestOptionFunc) (*gitlab.Issue, *gitlab.Response, error) {
+			assert.Equal(t, "## Steps to reproduce\n\n/due tomorrow", *opts.Description)
+			assert.Equal(t, gitlab.LabelOptions{"bug", "needs triage"}, *opts.Labels)
+			assert.Equal(t, int64(2), *opts.Weight)
+			assert.True(t, *opts.Confidential)
+
+			return &gitlab.Issue{IID: 1, Title: "crash", CreatedAt: gitlab.Ptr(time.Now()), WebURL: "https://gitlab.com/OWNER/REPO/-/issues/1"}, nil, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+		f.(*cmdtest.Factory).RemotesStub = func() (glrepo.Remotes, error) {
+			return glrepo.Remotes{
+				{
+					Remote: &git.Remote{Name: "origin"},
+					Repo:   glrepo.New("OWNER", "REPO", glinstance.DefaultHostname),
+				},
+			}, nil
+		}
+		return NewCmdCreate(f)
+	}, false, cmdtest.WithGitLabClient(testClient.Client))
+
+	output, err := exec(`--title crash --template Bug`)
+	require.NoError(t, err)
+	assert.Contains(t, output.String(), "https://gitlab.com/OWNER/REPO/-/issues/1")
+}
@@ -6,16 +6,21 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	issueBoardCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/board"
+	issueBulkCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/bulk"
+	issueCloneCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/clone"
 	issueCloseCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/close"
 	issueCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/create"
 	issueDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/delete"
 	issueListCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/list"
+	issueMoveCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/move"
 	issueNoteCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/note"
+	issuePromoteCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/promote"
 	issueReopenCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/reopen"
 	issueSubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/subscribe"
 	issueUnsubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/unsubscribe"
 	issueUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/update"
 	issueViewCmd "gitlab.com/gitlab-org/cli/internal/commands/issue/view"
+	"gitlab.com/gitlab-org/cli/internal/commands/timetracking"
 )
 
 func NewCmdIssue(f cmdutils.Factory) *cobra.Command {
@@ -40,15 +45,20 @@ func NewCmdIssue(f cmdutils.Factory) *cobra.Command {
 
 	cmdutils.EnableRepoOverride(issueCmd, f)
 
+	issueCmd.AddCommand(issueCloneCmd.NewCmdClone(f))
 	issueCmd.AddCommand(issueCloseCmd.NewCmdClose(f))
 	issueCmd.AddCommand(issueBoardCmd.NewCmdBoard(f))
+	issueCmd.AddCommand(issueBulkCmd.NewCmdBulk(f))
 	issueCmd.AddCommand(issueCreateCmd.NewCmdCreate(f))
 	issueCmd.AddCommand(issueDeleteCmd.NewCmdDelete(f))
 	issueCmd.AddCommand(issueListCmd.NewCmdList(f, nil))
+	issueCmd.AddCommand(issueMoveCmd.NewCmdMove(f))
 	issueCmd.AddCommand(issueNoteCmd.NewCmdNote(f))
+	issueCmd.AddCommand(issuePromoteCmd.NewCmdPromote(f))
 	issueCmd.AddCommand(issueReopenCmd.NewCmdReopen(f))
 	issueCmd.AddCommand(issueViewCmd.NewCmdView(f))
 	issueCmd.AddCommand(issueSubscribeCmd.NewCmdSubscribe(f))
+	issueCmd.AddCommand(timetracking.NewCmdTime(f, timetracking.Issue))
 	issueCmd.AddCommand(issueUnsubscribeCmd.NewCmdUnsubscribe(f))
 	issueCmd.AddCommand(issueUpdateCmd.NewCmdUpdate(f))
 	return issueCmd
@@ -1,10 +1,13 @@
 package issueutils
 
 import (
+	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -174,3 +177,82 @@ func issueMetadataFromURL(s, defaultHostname string) (int64, glrepo.Interface) {
 func issueFromIID(apiClient *gitlab.Client, repo glrepo.Interface, issueIID int64) (*gitlab.Issue, error) {
 	return api.GetIssue(apiClient, repo.FullName(), issueIID)
 }
+
+// ReadIIDs reads issue IDs separated by whitespace or commas, like the output of
+// glab issue list -F ids. Duplicate IDs are dropped.
+func ReadIIDs(r io.Reader) ([]int64, error) {
+	var iids []int64
+
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+	for scanner.Scan() {
+		for field := range strings.SplitSeq(scanner.Text(), ",") {
+			field = strings.TrimPrefix(strings.TrimSpace(field), "#")
+			if field == "" {
+				continue
+			}
+
+			iid, err := strconv.ParseInt(field, 10, 64)
+			if err != nil || iid <= 0 {
+				return nil, fmt.Errorf("invalid issue ID %q on standard input.", field)
+			}
+			if !slices.Contains(iids, iid) {
+				iids = append(iids, iid)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading standard input: %w", err)
+	}
+
+	return iids, nil
+}
+
+// TargetRepo parses a project reference to move or copy an issue of source to. References
+// without a host are on the host of source, and references to other hosts are rejected,
+// because issues can't leave their GitLab instance.
+func TargetRepo(arg string, source glrepo.Interface) (glrepo.Interface, error) {
+	target, err := glrepo.FromFullName(arg, source.RepoHost())
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.EqualFold(target.RepoHost(), source.RepoHost()) {
+		return nil, fmt.Errorf("project %s is on %s, but issue is on %s. Issues cannot leave their GitLab instance.",
+			target.FullName(), target.RepoHost(), source.RepoHost())
+	}
+
+	return target, nil
+}
+
+// IssuesFromStdin returns the issues of the base repository whose IDs are read from r with ReadIIDs.
+func IssuesFromStdin(ctx context.Context, apiClientFunc func(repoHost string) (*api.Client, error), gitlabClient *gitlab.Client, baseRepoFn func() (glrepo.Interface, error), defaultHostname string, r io.Reader) ([]*gitlab.Issue, glrepo.Interface, error) {
+	iids, err := ReadIIDs(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(iids) == 0 {
+		return nil, nil, fmt.Errorf("no issue IDs were read from standard input.")
+	}
+
+	args := make([]string, len(iids))
+	for i, iid := range iids {
+		args[i] = strconv.FormatInt(iid, 10)
+	}
+
+	return IssuesFromArgs(ctx, apiClientFunc, gitlabClient, baseRepoFn, defaultHostname, args)
+}
+
+// ClientForRepo returns the client for the host of repo. Issues given by URL can be on
+// another host than the base repository, whose client is given.
+func ClientForRepo(apiClientFunc func(repoHost string) (*api.Client, error), client *gitlab.Client, baseRepoFn func() (glrepo.Interface, error), repo glrepo.Interface) (*gitlab.Client, error) {
+	if baseRepo, err := baseRepoFn(); err == nil && strings.EqualFold(baseRepo.RepoHost(), repo.RepoHost()) {
+		return client, nil
+	}
+
+	a, err := apiClientFunc(repo.RepoHost())
+	if err != nil {
+		return nil, err
+	}
+	return a.Lab(), nil
+}
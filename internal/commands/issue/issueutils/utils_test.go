@@ -3,6 +3,7 @@
 package issueutils
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -104,3 +105,28 @@ func Test_issueMetadataFromURL(t *testing.T) {
 		})
 	}
 }
+
+func TestReadIIDs(t *testing.T) {
+	iids, err := ReadIIDs(strings.NewReader("1 #2,3\n\n2, 4\n"))
+	require.NoError(t, err)
+	require.Equal(t, []int64{1, 2, 3, 4}, iids)
+
+	_, err = ReadIIDs(strings.NewReader("1 two"))
+	require.EqualError(t, err, `invalid issue ID "two" on standard input.`)
+}
+
+func TestTargetRepo(t *testing.T) {
+	source := glrepo.NewWithHost("owner", "repo", "gitlab.example.com")
+
+	target, err := TargetRepo("group/sub/project", source)
+	require.NoError(t, err)
+	require.Equal(t, "gitlab.example.com", target.RepoHost())
+	require.Equal(t, "group/sub/project", target.FullName())
+
+	target, err = TargetRepo("https://gitlab.example.com/group/project", source)
+	require.NoError(t, err)
+	require.Equal(t, "group/project", target.FullName())
+
+	_, err = TargetRepo("https://gitlab.com/group/project", source)
+	require.Error(t, err)
+}
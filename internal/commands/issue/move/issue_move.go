@@ -0,0 +1,141 @@
+package move
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	bulk bool
+
+	io              *iostreams.IOStreams
+	gitlabClient    func() (*gitlab.Client, error)
+	apiClient       func(repoHost string) (*api.Client, error)
+	baseRepo        func() (glrepo.Interface, error)
+	defaultHostname string
+}
+
+func NewCmdMove(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		gitlabClient:    f.GitLabClient,
+		apiClient:       f.ApiClient,
+		baseRepo:        f.BaseRepo,
+		defaultHostname: f.DefaultHostname(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "move <id> <project>",
+		Short: `Move an issue to another project.`,
+		Long: heredoc.Docf(`
+			Move an issue to another project on the same GitLab instance, and print the URL
+			of the moved issue. The original issue is closed, and links to the moved issue.
+
+			The project can be given as %[1]sOWNER/REPO%[1]s, %[1]sGROUP/NAMESPACE/REPO%[1]s, or a full URL.
+			Without a host, the project is on the host of the issue.
+
+			With %[1]s--bulk%[1]s, only the project is given, and the IDs of the issues to move are
+			read from standard input.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab issue move 42 gitlab-org/cli
+			$ glab issue move https://gitlab.example.com/group/project/-/issues/42 group/other-project
+			$ glab issue list --label "wrong project" -F ids | glab issue move --bulk gitlab-org/cli
+		`),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if opts.bulk {
+				return cobra.ExactArgs(1)(cmd, args)
+			}
+			return cobra.ExactArgs(2)(cmd, args)
+		},
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(cmd.Context(), args)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.bulk, "bulk", false, "Read the IDs of the issues to move from standard input.")
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context, args []string) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	var issues []*gitlab.Issue
+	var repo glrepo.Interface
+	if o.bulk {
+		issues, repo, err = issueutils.IssuesFromStdin(ctx, o.apiClient, client, o.baseRepo, o.defaultHostname, o.io.In)
+	} else {
+		var issue *gitlab.Issue
+		issue, repo, err = issueutils.IssueFromArg(o.apiClient, client, o.baseRepo, o.defaultHostname, args[0])
+		issues = []*gitlab.Issue{issue}
+	}
+	if err != nil {
+		return err
+	}
+
+	target, err := issueutils.TargetRepo(args[len(args)-1], repo)
+	if err != nil {
+		return err
+	}
+
+	client, err = issueutils.ClientForRepo(o.apiClient, client, o.baseRepo, repo)
+	if err != nil {
+		return err
+	}
+
+	project, err := api.GetProject(client, target.FullName())
+	if err != nil {
+		return fmt.Errorf("error getting project %s: %w", target.FullName(), err)
+	}
+
+	c := o.io.Color()
+	failed := 0
+	for _, issue := range issues {
+		if issue.ProjectID == project.ID {
+			fmt.Fprintf(o.io.StdErr, "%s #%d is already in %s.\n", c.FailedIcon(), issue.IID, project.PathWithNamespace)
+			failed++
+			continue
+		}
+
+		moved, _, err := client.Issues.MoveIssue(issue.ProjectID, issue.IID, &gitlab.MoveIssueOptions{ToProjectID: gitlab.Ptr(project.ID)})
+		if err != nil {
+			fmt.Fprintf(o.io.StdErr, "%s Failed to move #%d: %s\n", c.FailedIcon(), issue.IID, err)
+			failed++
+			continue
+		}
+
+		if o.io.IsOutputTTY() {
+			fmt.Fprintf(o.io.StdOut, "%s Moved #%d to %s\n", c.GreenCheck(), issue.IID, moved.WebURL)
+		} else {
+			fmt.Fprintln(o.io.StdOut, moved.WebURL)
+		}
+	}
+
+	if failed > 0 {
+		if len(issues) == 1 {
+			return cmdutils.SilentError
+		}
+		return fmt.Errorf("failed to move %d of %d issues.", failed, len(issues))
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+//go:build !integration
+
+package move
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestIssueMove(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(42), gomock.Any()).
+		Return(&gitlab.Issue{ProjectID: 1, IID: 42}, nil, nil)
+	tc.MockProjects.EXPECT().
+		GetProject("group/other", gomock.Any()).
+		Return(&gitlab.Project{ID: 2, PathWithNamespace: "group/other"}, nil, nil)
+	tc.MockIssues.EXPECT().
+		MoveIssue(int64(1), int64(42), &gitlab.MoveIssueOptions{ToProjectID: gitlab.Ptr(int64(2))}).
+		Return(&gitlab.Issue{IID: 7, WebURL: "https://gitlab.com/group/other/-/issues/7"}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdMove, true, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("42 group/other")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "Moved #42 to https://gitlab.com/group/other/-/issues/7\n")
+}
+
+func TestIssueMove_bulk(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	for _, iid := range []int64{1, 2, 3} {
+		tc.MockIssues.EXPECT().
+			GetIssue("OWNER/REPO", iid, gomock.Any()).
+			Return(&gitlab.Issue{ProjectID: 1, IID: iid}, nil, nil)
+	}
+	tc.MockProjects.EXPECT().
+		GetProject("group/other", gomock.Any()).
+		Return(&gitlab.Project{ID: 2, PathWithNamespace: "group/other"}, nil, nil)
+	tc.MockIssues.EXPECT().
+		MoveIssue(int64(1), int64(1), gomock.Any()).
+		Return(&gitlab.Issue{WebURL: "https://gitlab.com/group/other/-/issues/10"}, nil, nil)
+	tc.MockIssues.EXPECT().
+		MoveIssue(int64(1), int64(2), gomock.Any()).
+		Return(nil, nil, errors.New("403 Forbidden"))
+	tc.MockIssues.EXPECT().
+		MoveIssue(int64(1), int64(3), gomock.Any()).
+		Return(&gitlab.Issue{WebURL: "https://gitlab.com/group/other/-/issues/11"}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdMove, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithStdin("1 2\n3\n"),
+	)
+
+	out, err := exec("--bulk group/other")
+	require.EqualError(t, err, "failed to move 1 of 3 issues.")
+
+	assert.Equal(t, "https://gitlab.com/group/other/-/issues/10\nhttps://gitlab.com/group/other/-/issues/11\n", out.String())
+	assert.Contains(t, out.Stderr(), "Failed to move #2: 403 Forbidden")
+}
+
+func TestIssueMove_otherHost(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(42), gomock.Any()).
+		Return(&gitlab.Issue{ProjectID: 1, IID: 42}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdMove, false, cmdtest.WithGitLabClient(tc.Client))
+
+	_, err := exec("42 https://gitlab.example.com/group/other")
+	require.EqualError(t, err, "project group/other is on gitlab.example.com, but issue is on gitlab.com. Issues cannot leave their GitLab instance.")
+}
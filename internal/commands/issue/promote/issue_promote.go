@@ -0,0 +1,180 @@
+package promote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+const promoteMutation = `
+mutation($projectPath: ID!, $iid: String!, $groupPath: ID) {
+  promoteToEpic(input: {projectPath: $projectPath, iid: $iid, groupPath: $groupPath}) {
+    epic {
+      iid
+      webUrl
+    }
+    errors
+  }
+}`
+
+type promoteResponse struct {
+	Data struct {
+		PromoteToEpic struct {
+			Epic *struct {
+				IID    string `json:"iid"`
+				WebURL string `json:"webUrl"`
+			} `json:"epic"`
+			Errors []string `json:"errors"`
+		} `json:"promoteToEpic"`
+	} `json:"data"`
+	api.GraphQLErrors
+}
+
+type options struct {
+	bulk  bool
+	group string
+
+	io              *iostreams.IOStreams
+	gitlabClient    func() (*gitlab.Client, error)
+	apiClient       func(repoHost string) (*api.Client, error)
+	baseRepo        func() (glrepo.Interface, error)
+	defaultHostname string
+}
+
+func NewCmdPromote(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		gitlabClient:    f.GitLabClient,
+		apiClient:       f.ApiClient,
+		baseRepo:        f.BaseRepo,
+		defaultHostname: f.DefaultHostname(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "promote <id>",
+		Short: `Promote an issue to an epic.`,
+		Long: heredoc.Docf(`
+			Promote an issue to an epic, and print the URL of the new epic. The epic is created
+			in the group of the project, or in the group given with %[1]s--group%[1]s. The issue
+			is closed, and links to the epic. Epics require GitLab Premium or Ultimate.
+
+			With %[1]s--bulk%[1]s, the issue ID is left out, and the IDs of the issues to promote are
+			read from standard input.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab issue promote 42
+			$ glab issue promote 42 --group gitlab-org/plan
+			$ glab issue list --label idea -F ids | glab issue promote --bulk
+		`),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if opts.bulk {
+				return cobra.NoArgs(cmd, args)
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		},
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(cmd.Context(), args)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.bulk, "bulk", false, "Read the IDs of the issues to promote from standard input.")
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Group to create the epic in. Defaults to the group of the project.")
+
+	return cmd
+}
+
+func (o *options) run(ctx context.Context, args []string) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	var issues []*gitlab.Issue
+	var repo glrepo.Interface
+	if o.bulk {
+		issues, repo, err = issueutils.IssuesFromStdin(ctx, o.apiClient, client, o.baseRepo, o.defaultHostname, o.io.In)
+	} else {
+		var issue *gitlab.Issue
+		issue, repo, err = issueutils.IssueFromArg(o.apiClient, client, o.baseRepo, o.defaultHostname, args[0])
+		issues = []*gitlab.Issue{issue}
+	}
+	if err != nil {
+		return err
+	}
+
+	client, err = issueutils.ClientForRepo(o.apiClient, client, o.baseRepo, repo)
+	if err != nil {
+		return err
+	}
+
+	c := o.io.Color()
+	failed := 0
+	for _, issue := range issues {
+		url, err := o.promote(ctx, client, repo, issue)
+		if err != nil {
+			fmt.Fprintf(o.io.StdErr, "%s Failed to promote #%d: %s\n", c.FailedIcon(), issue.IID, err)
+			failed++
+			continue
+		}
+
+		if o.io.IsOutputTTY() {
+			fmt.Fprintf(o.io.StdOut, "%s Promoted #%d to %s\n", c.GreenCheck(), issue.IID, url)
+		} else {
+			fmt.Fprintln(o.io.StdOut, url)
+		}
+	}
+
+	if failed > 0 {
+		if len(issues) == 1 {
+			return cmdutils.SilentError
+		}
+		return fmt.Errorf("failed to promote %d of %d issues.", failed, len(issues))
+	}
+
+	return nil
+}
+
+// promote promotes the issue with GraphQL, because the REST API has no endpoint to promote issues.
+func (o *options) promote(ctx context.Context, client *gitlab.Client, repo glrepo.Interface, issue *gitlab.Issue) (string, error) {
+	variables := map[string]any{
+		"projectPath": repo.FullName(),
+		"iid":         strconv.FormatInt(issue.IID, 10),
+	}
+	if o.group != "" {
+		variables["groupPath"] = o.group
+	}
+
+	var resp promoteResponse
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: promoteMutation, Variables: variables}, &resp, gitlab.WithContext(ctx))
+	if err != nil {
+		return "", err
+	}
+
+	if err := resp.Err(); err != nil {
+		return "", err
+	}
+	if err := api.MutationErr(resp.Data.PromoteToEpic.Errors); err != nil {
+		return "", err
+	}
+	if resp.Data.PromoteToEpic.Epic == nil {
+		return "", errors.New("no epic was created.")
+	}
+
+	return resp.Data.PromoteToEpic.Epic.WebURL, nil
+}
@@ -0,0 +1,61 @@
+//go:build !integration
+
+package promote
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestIssuePromote(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(42), gomock.Any()).
+		Return(&gitlab.Issue{ProjectID: 1, IID: 42}, nil, nil)
+	cmdtest.ExpectGraphQL(tc,
+		`{"data": {"promoteToEpic": {"epic": {"iid": "3", "webUrl": "https://gitlab.com/groups/plan/-/epics/3"}, "errors": []}}}`,
+		cmdtest.GraphQLVariables(t, map[string]any{"projectPath": "OWNER/REPO", "iid": "42", "groupPath": "plan"}),
+	)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdPromote, true, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("42 --group plan")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "Promoted #42 to https://gitlab.com/groups/plan/-/epics/3\n")
+}
+
+func TestIssuePromote_bulkErrors(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	for _, iid := range []int64{1, 2} {
+		tc.MockIssues.EXPECT().
+			GetIssue("OWNER/REPO", iid, gomock.Any()).
+			Return(&gitlab.Issue{ProjectID: 1, IID: iid}, nil, nil)
+	}
+	cmdtest.ExpectGraphQL(tc,
+		`{"data": {"promoteToEpic": {"epic": null, "errors": ["Cannot promote issue"]}}}`,
+		cmdtest.GraphQLVariables(t, map[string]any{"projectPath": "OWNER/REPO", "iid": "1"}),
+	)
+	cmdtest.ExpectGraphQL(tc,
+		`{"data": {"promoteToEpic": null}, "errors": [{"message": "The resource that you are attempting to access does not exist"}]}`,
+		cmdtest.GraphQLVariables(t, map[string]any{"projectPath": "OWNER/REPO", "iid": "2"}),
+	)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdPromote, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithStdin("1,2"),
+	)
+
+	_, err := exec("--bulk")
+	require.EqualError(t, err, "failed to promote 2 of 2 issues.")
+}
@@ -13,6 +13,7 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/api"
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/iteration/iterationutils"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
 )
 
@@ -24,6 +25,7 @@ func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
 		Example: heredoc.Doc(`
 			$ glab issue update 42 --label ui,ux
 			$ glab issue update 42 --unlabel working
+			$ glab issue update 42 --iteration next
 		`),
 		Args: cobra.ExactArgs(1),
 		Annotations: map[string]string{
@@ -184,11 +186,33 @@ func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
 				l.DueDate = gitlab.Ptr(dueDate)
 			}
 
+			var iteration *iterationutils.Iteration
+			if m, _ := cmd.Flags().GetString("iteration"); cmd.Flags().Changed("iteration") {
+				iteration, err = resolveIteration(client, repo.FullName(), issue, m)
+				if err != nil {
+					return err
+				}
+				actions = append(actions, fmt.Sprintf("set iteration to %s", iteration.Name()))
+			}
+
 			fmt.Fprintf(out, "- Updating issue #%d\n", issue.IID)
 
-			issue, err = api.UpdateIssue(client, repo.FullName(), issue.IID, l)
-			if err != nil {
-				return err
+			if *l != (gitlab.UpdateIssueOptions{}) {
+				issue, err = api.UpdateIssue(client, repo.FullName(), issue.IID, l)
+				if err != nil {
+					return err
+				}
+			}
+
+			if iteration != nil {
+				err = iterationutils.SetIteration(client, repo.FullName(), issue.IID, iteration.ID)
+				if err != nil {
+					return fmt.Errorf("error setting the iteration: %w", err)
+				}
+				issue, err = api.GetIssue(client, repo.FullName(), issue.IID)
+				if err != nil {
+					return err
+				}
 			}
 
 			for _, s := range actions {
@@ -214,6 +238,30 @@ func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
 	issueUpdateCmd.Flags().Bool("unassign", false, "Unassign all users.")
 	issueUpdateCmd.Flags().IntP("weight", "w", 0, "Set weight of the issue.")
 	issueUpdateCmd.Flags().StringP("due-date", "", "", "A date in 'YYYY-MM-DD' format.")
+	issueUpdateCmd.Flags().String("iteration", "", "Add the issue to an iteration: current, next, or an iteration ID.")
 
 	return issueUpdateCmd
 }
+
+// resolveIteration returns the iteration that ref refers to, in the group of the project.
+// The current and next iterations are those of the cadence of the issue's iteration, so
+// issues can be rolled over to the next iteration of their cadence.
+func resolveIteration(client *gitlab.Client, project string, issue *gitlab.Issue, ref string) (*iterationutils.Iteration, error) {
+	group, err := cmdutils.ProjectGroup(client, project)
+	if err != nil {
+		return nil, err
+	}
+	if group == "" {
+		return nil, fmt.Errorf("project %s is not in a group, so it has no iterations.", project)
+	}
+
+	var cadenceID int64
+	if issue.Iteration != nil && (ref == iterationutils.RefCurrent || ref == iterationutils.RefNext) {
+		cadenceID, err = iterationutils.CadenceOf(client, group, issue.Iteration.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return iterationutils.Resolve(client, group, ref, cadenceID)
+}
@@ -0,0 +1,101 @@
+//go:build !integration
+
+package update
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+// Two cadences: Sprints (1) is in progress, and Releases (2) is in R1 with R2 upcoming.
+const iterations = `{"data": {"group": {"iterations": {"nodes": [
+	{"id": "gid://gitlab/Iteration/11", "state": "current", "startDate": "2026-10-12", "dueDate": "2026-10-25",
+	 "iterationCadence": {"id": "gid://gitlab/Iterations::Cadence/1", "title": "Sprints"}},
+	{"id": "gid://gitlab/Iteration/12", "state": "upcoming", "startDate": "2026-10-26", "dueDate": "2026-11-08",
+	 "iterationCadence": {"id": "gid://gitlab/Iterations::Cadence/1", "title": "Sprints"}},
+	{"id": "gid://gitlab/Iteration/20", "title": "R1", "state": "current", "startDate": "2026-10-01", "dueDate": "2026-10-31",
+	 "iterationCadence": {"id": "gid://gitlab/Iterations::Cadence/2", "title": "Releases"}},
+	{"id": "gid://gitlab/Iteration/21", "title": "R2", "state": "upcoming", "startDate": "2026-11-01", "dueDate": "2026-11-30",
+	 "iterationCadence": {"id": "gid://gitlab/Iterations::Cadence/2", "title": "Releases"}}
+]}}}}`
+
+var createdAt = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+func setupIteration(t *testing.T, tc *gitlabtesting.TestClient, issue *gitlab.Issue, kind string) cmdtest.CmdExecFunc {
+	t.Helper()
+
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(42), gomock.Any()).
+		Return(issue, nil, nil)
+	tc.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{PathWithNamespace: "OWNER/REPO", Namespace: &gitlab.ProjectNamespace{Kind: kind, FullPath: "OWNER"}}, nil, nil)
+
+	return cmdtest.SetupCmdForTest(t, NewCmdUpdate, false,
+		cmdtest.WithGitLabClient(tc.Client),
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+}
+
+func expectSetIteration(t *testing.T, tc *gitlabtesting.TestClient, iterationID string) {
+	t.Helper()
+
+	cmdtest.ExpectGraphQL(tc, `{"data": {"issueSetIteration": {"errors": []}}}`,
+		cmdtest.GraphQLVariables(t, map[string]any{"projectPath": "OWNER/REPO", "iid": "42", "iterationId": iterationID}))
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(42), gomock.Any()).
+		Return(&gitlab.Issue{IID: 42, Title: "Login page", CreatedAt: &createdAt, WebURL: "https://gitlab.com/OWNER/REPO/-/issues/42"}, nil, nil)
+}
+
+func TestIssueUpdate_iterationNextInCadence(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	exec := setupIteration(t, tc, &gitlab.Issue{IID: 42, Title: "Login page", CreatedAt: &createdAt, Iteration: &gitlab.GroupIteration{ID: 20}}, api.NamespaceKindGroup)
+
+	gomock.InOrder(
+		cmdtest.ExpectGraphQL(tc, iterations, cmdtest.GraphQLVariables(t, map[string]any{"fullPath": "OWNER", "state": "all"})),
+		cmdtest.ExpectGraphQL(tc, iterations, cmdtest.GraphQLVariables(t, map[string]any{"fullPath": "OWNER", "state": "opened"})),
+	)
+	expectSetIteration(t, tc, "gid://gitlab/Iteration/21")
+
+	out, err := exec("42 --iteration next")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "set iteration to R2")
+	assert.Contains(t, out.String(), "https://gitlab.com/OWNER/REPO/-/issues/42")
+}
+
+func TestIssueUpdate_iterationByID(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	exec := setupIteration(t, tc, &gitlab.Issue{IID: 42, Title: "Login page", CreatedAt: &createdAt}, api.NamespaceKindGroup)
+
+	cmdtest.ExpectGraphQL(tc, iterations, cmdtest.GraphQLVariables(t, map[string]any{"fullPath": "OWNER", "state": "all"}))
+	tc.MockIssues.EXPECT().
+		UpdateIssue("OWNER/REPO", int64(42), &gitlab.UpdateIssueOptions{Title: gitlab.Ptr("Sign-in page")}).
+		Return(&gitlab.Issue{IID: 42, Title: "Sign-in page", CreatedAt: &createdAt}, nil, nil)
+	expectSetIteration(t, tc, "gid://gitlab/Iteration/12")
+
+	out, err := exec("42 --title 'Sign-in page' --iteration 12")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), `updated title to "Sign-in page"`)
+	assert.Contains(t, out.String(), "set iteration to Sprints 2026-10-26 → 2026-11-08")
+	assert.Contains(t, out.String(), "https://gitlab.com/OWNER/REPO/-/issues/42")
+}
+
+func TestIssueUpdate_iterationOutsideGroup(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	exec := setupIteration(t, tc, &gitlab.Issue{IID: 42, Title: "Login page", CreatedAt: &createdAt}, "user")
+
+	_, err := exec("42 --iteration current")
+	require.EqualError(t, err, "project OWNER/REPO is not in a group, so it has no iterations.")
+}
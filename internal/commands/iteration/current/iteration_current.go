@@ -0,0 +1,94 @@
+package current
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/iteration/iterationutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	apiClient    func(repoHost string) (*api.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	group        string
+	outputFormat string
+}
+
+func NewCmdCurrent(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+	}
+
+	iterationCurrentCmd := &cobra.Command{
+		Use:   "current [flags]",
+		Short: `Show the iterations in progress, one for each cadence.`,
+		Long: heredoc.Doc(`
+			Show the iterations in progress in a group, one for each iteration cadence of the
+			group and its parent groups. Without a group, the group of the project is used.
+		`),
+		Example: heredoc.Doc(`
+			$ glab iteration current
+			$ glab iteration current -g mygroup -F json
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	iterationCurrentCmd.Flags().StringVarP(&opts.group, "group", "g", "", "Show the iterations of a group.")
+	iterationCurrentCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return iterationCurrentCmd
+}
+
+func (o *options) run() error {
+	client, group, err := cmdutils.GroupFromFlags(o.apiClient, o.baseRepo, o.group)
+	if err != nil {
+		return err
+	}
+
+	iterations, err := iterationutils.List(client, group, "current")
+	if err != nil {
+		return err
+	}
+
+	if o.outputFormat == "json" {
+		data, err := json.Marshal(iterations)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(o.io.StdOut, string(data))
+		return nil
+	}
+
+	if len(iterations) == 0 {
+		fmt.Fprintf(o.io.StdErr, "No iteration in progress in %s.\n", group)
+		return nil
+	}
+
+	c := o.io.Color()
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("ID", "Cadence", "Iteration", "Dates", "URL")
+	for _, it := range iterations {
+		table.AddRow(it.ID, it.Cadence.Title, it.Name(), it.StartDate+" → "+it.DueDate, c.Gray(it.WebURL))
+	}
+	fmt.Fprint(o.io.StdOut, table.Render())
+
+	return nil
+}
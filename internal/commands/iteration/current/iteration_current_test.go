@@ -0,0 +1,57 @@
+//go:build !integration
+
+package current
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+var currentVariables = map[string]any{"fullPath": "plan", "state": "current"}
+
+func TestIterationCurrent(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{Namespace: &gitlab.ProjectNamespace{Kind: "group", FullPath: "plan"}}, nil, nil)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"group": {"iterations": {"nodes": [
+		{"id": "gid://gitlab/Iteration/11", "state": "current", "startDate": "2026-10-12", "dueDate": "2026-10-25",
+		 "webUrl": "https://gitlab.com/groups/plan/-/iterations/11",
+		 "iterationCadence": {"id": "gid://gitlab/Iterations::Cadence/1", "title": "Sprints"}}
+	]}}}}`, cmdtest.GraphQLVariables(t, currentVariables))
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCurrent, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "Sprints")
+	assert.Contains(t, out.String(), "2026-10-12 → 2026-10-25")
+	assert.Contains(t, out.String(), "https://gitlab.com/groups/plan/-/iterations/11")
+}
+
+func TestIterationCurrent_none(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"group": {"iterations": {"nodes": []}}}}`, cmdtest.GraphQLVariables(t, currentVariables))
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCurrent, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("-g plan")
+	require.NoError(t, err)
+	assert.Empty(t, out.String())
+	assert.Equal(t, "No iteration in progress in plan.\n", out.Stderr())
+}
@@ -4,7 +4,10 @@ import (
 	"github.com/spf13/cobra"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	iterationCurrentCmd "gitlab.com/gitlab-org/cli/internal/commands/iteration/current"
 	iterationListCmd "gitlab.com/gitlab-org/cli/internal/commands/iteration/list"
+	iterationRolloverCmd "gitlab.com/gitlab-org/cli/internal/commands/iteration/rollover"
+	iterationViewCmd "gitlab.com/gitlab-org/cli/internal/commands/iteration/view"
 )
 
 func NewCmdIteration(f cmdutils.Factory) *cobra.Command {
@@ -17,5 +20,8 @@ func NewCmdIteration(f cmdutils.Factory) *cobra.Command {
 	cmdutils.EnableRepoOverride(iterationCmd, f)
 
 	iterationCmd.AddCommand(iterationListCmd.NewCmdList(f))
+	iterationCmd.AddCommand(iterationViewCmd.NewCmdView(f))
+	iterationCmd.AddCommand(iterationCurrentCmd.NewCmdCurrent(f))
+	iterationCmd.AddCommand(iterationRolloverCmd.NewCmdRollover(f))
 	return iterationCmd
 }
@@ -0,0 +1,332 @@
+package iterationutils
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+)
+
+const (
+	RefCurrent = "current"
+	RefNext    = "next"
+
+	stateUpcoming = "upcoming"
+	stateCurrent  = "current"
+	// stateStarted is the name of the current state before GitLab 14.6.
+	stateStarted = "started"
+
+	iterationGIDPrefix = "gid://gitlab/Iteration/"
+	cadenceGIDPrefix   = "gid://gitlab/Iterations::Cadence/"
+)
+
+// Iteration is an iteration with its cadence. The REST API doesn't return the cadence of
+// iterations, so iterations are read with GraphQL.
+type Iteration struct {
+	ID        int64  `json:"id"`
+	IID       int64  `json:"iid"`
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	StartDate string `json:"start_date"`
+	DueDate   string `json:"due_date"`
+	WebURL    string `json:"web_url"`
+	Cadence   struct {
+		ID    int64  `json:"id"`
+		Title string `json:"title"`
+	} `json:"cadence"`
+}
+
+// Name returns the title of the iteration, or its cadence and dates for iterations created
+// automatically by a cadence, which have no title.
+func (i *Iteration) Name() string {
+	dates := i.StartDate + " → " + i.DueDate
+	if i.Title != "" {
+		return i.Title
+	}
+	if i.Cadence.Title != "" {
+		return i.Cadence.Title + " " + dates
+	}
+	return dates
+}
+
+// IsCurrent reports whether the iteration is in progress.
+func (i *Iteration) IsCurrent() bool {
+	return i.State == stateCurrent || i.State == stateStarted
+}
+
+const iterationsQuery = `
+query($fullPath: ID!, $state: IterationState, $after: String) {
+  group(fullPath: $fullPath) {
+    iterations(state: $state, includeAncestors: true, first: 100, after: $after) {
+      nodes {
+        id
+        iid
+        title
+        state
+        startDate
+        dueDate
+        webUrl
+        iterationCadence {
+          id
+          title
+        }
+      }
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+    }
+  }
+}`
+
+type iterationsResponse struct {
+	Data struct {
+		Group *struct {
+			Iterations struct {
+				Nodes []struct {
+					ID               string `json:"id"`
+					IID              string `json:"iid"`
+					Title            string `json:"title"`
+					State            string `json:"state"`
+					StartDate        string `json:"startDate"`
+					DueDate          string `json:"dueDate"`
+					WebURL           string `json:"webUrl"`
+					IterationCadence *struct {
+						ID    string `json:"id"`
+						Title string `json:"title"`
+					} `json:"iterationCadence"`
+				} `json:"nodes"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"iterations"`
+		} `json:"group"`
+	} `json:"data"`
+	api.GraphQLErrors
+}
+
+// List returns the iterations of the group and its ancestors in the given state, like
+// opened, current, upcoming, closed, or all, ordered by cadence and start date.
+func List(client *gitlab.Client, group, state string) ([]*Iteration, error) {
+	var iterations []*Iteration
+
+	variables := map[string]any{"fullPath": group, "state": state}
+	for {
+		var resp iterationsResponse
+		_, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: iterationsQuery, Variables: variables}, &resp)
+		if err != nil {
+			return nil, fmt.Errorf("error listing iterations of %s: %w", group, err)
+		}
+		if err := resp.Err(); err != nil {
+			return nil, fmt.Errorf("error listing iterations of %s: %w", group, err)
+		}
+		if resp.Data.Group == nil {
+			return nil, fmt.Errorf("group %s not found.", group)
+		}
+
+		for _, n := range resp.Data.Group.Iterations.Nodes {
+			it := &Iteration{
+				ID:        gidToID(n.ID, iterationGIDPrefix),
+				Title:     n.Title,
+				State:     n.State,
+				StartDate: n.StartDate,
+				DueDate:   n.DueDate,
+				WebURL:    n.WebURL,
+			}
+			it.IID, _ = strconv.ParseInt(n.IID, 10, 64)
+			if n.IterationCadence != nil {
+				it.Cadence.ID = gidToID(n.IterationCadence.ID, cadenceGIDPrefix)
+				it.Cadence.Title = n.IterationCadence.Title
+			}
+			iterations = append(iterations, it)
+		}
+
+		pageInfo := resp.Data.Group.Iterations.PageInfo
+		if !pageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = pageInfo.EndCursor
+	}
+
+	slices.SortStableFunc(iterations, func(a, b *Iteration) int {
+		return cmp.Or(cmp.Compare(a.Cadence.ID, b.Cadence.ID), strings.Compare(a.StartDate, b.StartDate))
+	})
+
+	return iterations, nil
+}
+
+func gidToID(gid, prefix string) int64 {
+	id, _ := strconv.ParseInt(strings.TrimPrefix(gid, prefix), 10, 64)
+	return id
+}
+
+// Resolve returns the iteration that ref refers to: "current" for the iteration in progress,
+// "next" for the first upcoming iteration, or an iteration ID. Because a group can have several
+// cadences, current and next are resolved in the cadence with the given ID. Without a cadence,
+// they are resolved in the only cadence with an iteration in progress.
+func Resolve(client *gitlab.Client, group, ref string, cadenceID int64) (*Iteration, error) {
+	if ref != RefCurrent && ref != RefNext {
+		id, err := strconv.ParseInt(ref, 10, 64)
+		if err != nil || id <= 0 {
+			return nil, fmt.Errorf("invalid iteration %q. Use current, next, or an iteration ID.", ref)
+		}
+
+		iterations, err := List(client, group, "all")
+		if err != nil {
+			return nil, err
+		}
+		for _, it := range iterations {
+			if it.ID == id {
+				return it, nil
+			}
+		}
+		return nil, fmt.Errorf("iteration %d not found in %s.", id, group)
+	}
+
+	iterations, err := List(client, group, "opened")
+	if err != nil {
+		return nil, err
+	}
+
+	if cadenceID == 0 {
+		for _, it := range iterations {
+			if !it.IsCurrent() {
+				continue
+			}
+			if cadenceID != 0 && it.Cadence.ID != cadenceID {
+				return nil, errors.New("the group has several iteration cadences in progress. Give an iteration ID instead.")
+			}
+			cadenceID = it.Cadence.ID
+		}
+	}
+
+	for _, it := range iterations {
+		if cadenceID != 0 && it.Cadence.ID != cadenceID {
+			continue
+		}
+		if ref == RefCurrent && it.IsCurrent() || ref == RefNext && it.State == stateUpcoming {
+			return it, nil
+		}
+	}
+
+	if ref == RefCurrent {
+		return nil, fmt.Errorf("no iteration in progress in %s.", group)
+	}
+	return nil, fmt.Errorf("no upcoming iteration in %s.", group)
+}
+
+// CadenceOf returns the ID of the cadence of the iteration with the given ID, or 0 if it's not found.
+func CadenceOf(client *gitlab.Client, group string, iterationID int64) (int64, error) {
+	iterations, err := List(client, group, "all")
+	if err != nil {
+		return 0, err
+	}
+	for _, it := range iterations {
+		if it.ID == iterationID {
+			return it.Cadence.ID, nil
+		}
+	}
+	return 0, nil
+}
+
+const setIterationMutation = `
+mutation($projectPath: ID!, $iid: String!, $iterationId: IterationID) {
+  issueSetIteration(input: {projectPath: $projectPath, iid: $iid, iterationId: $iterationId}) {
+    errors
+  }
+}`
+
+// SetIteration adds the issue to the iteration with the given ID. The REST API can't change the
+// iteration of issues, so the iteration is set with GraphQL.
+func SetIteration(client *gitlab.Client, projectPath string, iid, iterationID int64) error {
+	variables := map[string]any{
+		"projectPath": projectPath,
+		"iid":         strconv.FormatInt(iid, 10),
+		"iterationId": iterationGIDPrefix + strconv.FormatInt(iterationID, 10),
+	}
+
+	var resp struct {
+		Data struct {
+			IssueSetIteration struct {
+				Errors []string `json:"errors"`
+			} `json:"issueSetIteration"`
+		} `json:"data"`
+		api.GraphQLErrors
+	}
+	_, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: setIterationMutation, Variables: variables}, &resp)
+	if err != nil {
+		return err
+	}
+	if err := resp.Err(); err != nil {
+		return err
+	}
+	return api.MutationErr(resp.Data.IssueSetIteration.Errors)
+}
+
+// ListGroupIssues returns the issues of the group in the iteration, in the given state.
+func ListGroupIssues(client *gitlab.Client, group string, iterationID int64, state string) ([]*gitlab.Issue, error) {
+	opts := &gitlab.ListGroupIssuesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		IterationID: gitlab.Ptr(iterationID),
+		State:       gitlab.Ptr(state),
+	}
+
+	var issues []*gitlab.Issue
+	for {
+		page, resp, err := client.Issues.ListGroupIssues(group, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error listing issues: %w", err)
+		}
+		issues = append(issues, page...)
+
+		if resp == nil || resp.NextPage == 0 {
+			return issues, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// ListProjectIssues returns the issues of the project in the iteration, in the given state.
+func ListProjectIssues(client *gitlab.Client, project string, iterationID int64, state string) ([]*gitlab.Issue, error) {
+	opts := &gitlab.ListProjectIssuesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		IterationID: gitlab.Ptr(iterationID),
+		State:       gitlab.Ptr(state),
+	}
+
+	var issues []*gitlab.Issue
+	for {
+		page, resp, err := client.Issues.ListProjectIssues(project, opts)
+		if err != nil {
+			return nil, fmt.Errorf("error listing issues: %w", err)
+		}
+		issues = append(issues, page...)
+
+		if resp == nil || resp.NextPage == 0 {
+			return issues, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+// ProjectPath returns the full path of the project of the issue.
+func ProjectPath(issue *gitlab.Issue) string {
+	if issue.References != nil {
+		if path, _, ok := strings.Cut(issue.References.Full, "#"); ok {
+			return path
+		}
+	}
+	path, _, _ := strings.Cut(issue.WebURL, "/-/issues/")
+	if u, err := url.Parse(path); err == nil {
+		return strings.Trim(u.Path, "/")
+	}
+	return path
+}
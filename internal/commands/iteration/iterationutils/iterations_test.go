@@ -0,0 +1,110 @@
+//go:build !integration
+
+package iterationutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+// Two cadences: Sprints (1) is in progress, and Releases (2) has only upcoming iterations.
+const openedIterations = `{"data": {"group": {"iterations": {
+	"nodes": [
+		{"id": "gid://gitlab/Iteration/12", "iid": "2", "state": "upcoming", "startDate": "2026-10-26", "dueDate": "2026-11-08",
+		 "iterationCadence": {"id": "gid://gitlab/Iterations::Cadence/1", "title": "Sprints"}},
+		{"id": "gid://gitlab/Iteration/20", "iid": "1", "title": "R1", "state": "upcoming", "startDate": "2026-11-01", "dueDate": "2026-11-30",
+		 "iterationCadence": {"id": "gid://gitlab/Iterations::Cadence/2", "title": "Releases"}},
+		{"id": "gid://gitlab/Iteration/11", "iid": "1", "state": "current", "startDate": "2026-10-12", "dueDate": "2026-10-25",
+		 "iterationCadence": {"id": "gid://gitlab/Iterations::Cadence/1", "title": "Sprints"}}
+	],
+	"pageInfo": {"hasNextPage": false}
+}}}}`
+
+func TestList(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	cmdtest.ExpectGraphQL(tc, openedIterations, nil)
+
+	iterations, err := List(tc.Client, "plan", "opened")
+	require.NoError(t, err)
+
+	var names []string
+	for _, it := range iterations {
+		names = append(names, it.Name())
+	}
+	assert.Equal(t, []string{
+		"Sprints 2026-10-12 → 2026-10-25",
+		"Sprints 2026-10-26 → 2026-11-08",
+		"R1",
+	}, names)
+	assert.Equal(t, int64(1), iterations[0].Cadence.ID)
+	assert.Equal(t, int64(11), iterations[0].ID)
+}
+
+func TestList_errors(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"group": null}}`, nil)
+	_, err := List(tc.Client, "missing", "opened")
+	require.EqualError(t, err, "group missing not found.")
+
+	cmdtest.ExpectGraphQL(tc, `{"data": null, "errors": [{"message": "boom"}]}`, nil)
+	_, err = List(tc.Client, "plan", "opened")
+	require.EqualError(t, err, "error listing iterations of plan: boom")
+}
+
+func TestResolve(t *testing.T) {
+	tests := []struct {
+		name      string
+		ref       string
+		cadenceID int64
+		wantID    int64
+		wantErr   string
+	}{
+		{name: "current", ref: RefCurrent, wantID: 11},
+		{name: "next in the cadence in progress", ref: RefNext, wantID: 12},
+		{name: "next in a cadence", ref: RefNext, cadenceID: 2, wantID: 20},
+		{name: "no current in a cadence", ref: RefCurrent, cadenceID: 2, wantErr: "no iteration in progress in plan."},
+		{name: "by ID", ref: "20", wantID: 20},
+		{name: "unknown ID", ref: "99", wantErr: "iteration 99 not found in plan."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tc := gitlabtesting.NewTestClient(t)
+			cmdtest.ExpectGraphQL(tc, openedIterations, nil)
+
+			it, err := Resolve(tc.Client, "plan", tt.ref, tt.cadenceID)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantID, it.ID)
+		})
+	}
+
+	_, err := Resolve(nil, "plan", "soon", 0)
+	require.EqualError(t, err, `invalid iteration "soon". Use current, next, or an iteration ID.`)
+}
+
+func TestResolve_severalCadences(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"group": {"iterations": {"nodes": [
+		{"id": "gid://gitlab/Iteration/11", "state": "current", "iterationCadence": {"id": "gid://gitlab/Iterations::Cadence/1"}},
+		{"id": "gid://gitlab/Iteration/21", "state": "current", "iterationCadence": {"id": "gid://gitlab/Iterations::Cadence/2"}}
+	]}}}}`, nil)
+
+	_, err := Resolve(tc.Client, "plan", RefCurrent, 0)
+	require.EqualError(t, err, "the group has several iteration cadences in progress. Give an iteration ID instead.")
+}
+
+func TestProjectPath(t *testing.T) {
+	assert.Equal(t, "plan/app", ProjectPath(&gitlab.Issue{References: &gitlab.IssueReferences{Full: "plan/app#4"}}))
+	assert.Equal(t, "plan/sub/app", ProjectPath(&gitlab.Issue{WebURL: "https://gitlab.com/plan/sub/app/-/issues/4"}))
+}
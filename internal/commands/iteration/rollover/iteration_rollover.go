@@ -0,0 +1,181 @@
+package rollover
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/iteration/iterationutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io        *iostreams.IOStreams
+	apiClient func(repoHost string) (*api.Client, error)
+	baseRepo  func() (glrepo.Interface, error)
+
+	from   string
+	to     string
+	group  string
+	dryRun bool
+	yes    bool
+}
+
+func NewCmdRollover(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+	}
+
+	iterationRolloverCmd := &cobra.Command{
+		Use:   "rollover [<id> | current] [flags]",
+		Short: `Move the open issues of an iteration to another iteration.`,
+		Long: heredoc.Docf(`
+			Move the open issues of an iteration to another iteration, usually at the end of the
+			iteration. Closed issues stay in the iteration.
+
+			The iteration to roll over is an iteration ID, or %[1]scurrent%[1]s for the iteration in
+			progress. Defaults to %[1]scurrent%[1]s. The issues are moved to the next iteration of the
+			same cadence, or to the iteration given with %[1]s--to%[1]s.
+
+			The issues are shown before they're moved, and you're asked to confirm. Use
+			%[1]s--dry-run%[1]s to only show them, and %[1]s--yes%[1]s to skip the confirmation.
+			Without a group, only the issues of the project are moved.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab iteration rollover --dry-run
+			$ glab iteration rollover -g mygroup --yes
+			$ glab iteration rollover 1234 --to 1240
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.from = iterationutils.RefCurrent
+			if len(args) == 1 {
+				opts.from = args[0]
+			}
+
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			return opts.run(cmd.Context())
+		},
+	}
+
+	fl := iterationRolloverCmd.Flags()
+	fl.StringVar(&opts.to, "to", iterationutils.RefNext, "Iteration to move the issues to: next, or an iteration ID.")
+	fl.StringVarP(&opts.group, "group", "g", "", "Move the issues of a group.")
+	fl.BoolVar(&opts.dryRun, "dry-run", false, "Show the issues, without moving them.")
+	fl.BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt.")
+
+	return iterationRolloverCmd
+}
+
+func (o *options) validate() error {
+	if o.from == iterationutils.RefNext {
+		return &cmdutils.FlagError{Err: errors.New("the next iteration can't be rolled over. Use current or an iteration ID.")}
+	}
+	if o.to == iterationutils.RefCurrent {
+		return &cmdutils.FlagError{Err: errors.New("--to must be next or an iteration ID.")}
+	}
+	if !o.dryRun && !o.yes && !o.io.PromptEnabled() {
+		return &cmdutils.FlagError{Err: errors.New("--yes or --dry-run is required when not running interactively.")}
+	}
+	return nil
+}
+
+func (o *options) run(ctx context.Context) error {
+	client, group, err := cmdutils.GroupFromFlags(o.apiClient, o.baseRepo, o.group)
+	if err != nil {
+		return err
+	}
+
+	from, err := iterationutils.Resolve(client, group, o.from, 0)
+	if err != nil {
+		return err
+	}
+	to, err := iterationutils.Resolve(client, group, o.to, from.Cadence.ID)
+	if err != nil {
+		return err
+	}
+	if to.ID == from.ID {
+		return &cmdutils.FlagError{Err: errors.New("the issues are already in that iteration.")}
+	}
+
+	var issues []*gitlab.Issue
+	if o.group != "" {
+		issues, err = iterationutils.ListGroupIssues(client, group, from.ID, "opened")
+	} else {
+		var repo glrepo.Interface
+		repo, err = o.baseRepo()
+		if err != nil {
+			return err
+		}
+		issues, err = iterationutils.ListProjectIssues(client, repo.FullName(), from.ID, "opened")
+	}
+	if err != nil {
+		return err
+	}
+	if len(issues) == 0 {
+		fmt.Fprintf(o.io.StdErr, "No open issues in %s.\n", from.Name())
+		return nil
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "Moving %s from %s to %s:\n", utils.Pluralize(len(issues), "open issue"), c.Bold(from.Name()), c.Bold(to.Name()))
+	for _, issue := range issues {
+		fmt.Fprintf(o.io.StdOut, "  %s %s\n", c.Cyan(reference(issue)), issue.Title)
+	}
+
+	if o.dryRun {
+		fmt.Fprintln(o.io.StdOut, "Dry run: no issues were moved.")
+		return nil
+	}
+
+	if !o.yes {
+		confirmed := false
+		err := o.io.Confirm(ctx, &confirmed, fmt.Sprintf("Move %s?", utils.Pluralize(len(issues), "issue")))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !confirmed {
+			return cmdutils.CancelError()
+		}
+	}
+
+	failed := 0
+	for _, issue := range issues {
+		err := iterationutils.SetIteration(client, iterationutils.ProjectPath(issue), issue.IID, to.ID)
+		if err != nil {
+			failed++
+			fmt.Fprintf(o.io.StdErr, "%s %s: %s\n", c.FailedIcon(), reference(issue), err)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to move %d of %s.", failed, utils.Pluralize(len(issues), "issue"))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Moved %s to %s.\n", c.GreenCheck(), utils.Pluralize(len(issues), "issue"), to.Name())
+	return nil
+}
+
+func reference(issue *gitlab.Issue) string {
+	if issue.References != nil && issue.References.Full != "" {
+		return issue.References.Full
+	}
+	return fmt.Sprintf("#%d", issue.IID)
+}
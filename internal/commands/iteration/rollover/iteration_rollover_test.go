@@ -0,0 +1,110 @@
+//go:build !integration
+
+package rollover
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const openedIterations = `{"data": {"group": {"iterations": {"nodes": [
+	{"id": "gid://gitlab/Iteration/11", "state": "current", "startDate": "2026-10-12", "dueDate": "2026-10-25",
+	 "iterationCadence": {"id": "gid://gitlab/Iterations::Cadence/1", "title": "Sprints"}},
+	{"id": "gid://gitlab/Iteration/12", "state": "upcoming", "startDate": "2026-10-26", "dueDate": "2026-11-08",
+	 "iterationCadence": {"id": "gid://gitlab/Iterations::Cadence/1", "title": "Sprints"}}
+]}}}}`
+
+func setup(t *testing.T) *gitlabtesting.TestClient {
+	tc := gitlabtesting.NewTestClient(t)
+
+	cmdtest.ExpectGraphQL(tc, openedIterations, nil)
+	cmdtest.ExpectGraphQL(tc, openedIterations, nil)
+	tc.MockIssues.EXPECT().
+		ListGroupIssues("plan", gomock.Any()).
+		DoAndReturn(func(gid any, opts *gitlab.ListGroupIssuesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			assert.Equal(t, int64(11), *opts.IterationID)
+			assert.Equal(t, "opened", *opts.State)
+			return []*gitlab.Issue{
+				{IID: 1, Title: "Login page", References: &gitlab.IssueReferences{Full: "plan/app#1"}},
+				{IID: 7, Title: "Logout", References: &gitlab.IssueReferences{Full: "plan/web#7"}},
+			}, &gitlab.Response{}, nil
+		})
+
+	return tc
+}
+
+func TestIterationRollover(t *testing.T) {
+	tc := setup(t)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"issueSetIteration": {"errors": []}}}`,
+		cmdtest.GraphQLVariables(t, map[string]any{"projectPath": "plan/app", "iid": "1", "iterationId": "gid://gitlab/Iteration/12"}))
+	cmdtest.ExpectGraphQL(tc, `{"data": {"issueSetIteration": {"errors": []}}}`,
+		cmdtest.GraphQLVariables(t, map[string]any{"projectPath": "plan/web", "iid": "7", "iterationId": "gid://gitlab/Iteration/12"}))
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdRollover, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("-g plan --yes")
+	require.NoError(t, err)
+
+	assert.Equal(t, `Moving 2 open issues from Sprints 2026-10-12 → 2026-10-25 to Sprints 2026-10-26 → 2026-11-08:
+  plan/app#1 Login page
+  plan/web#7 Logout
+✓ Moved 2 issues to Sprints 2026-10-26 → 2026-11-08.
+`, out.String())
+}
+
+func TestIterationRollover_dryRun(t *testing.T) {
+	tc := setup(t)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdRollover, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("-g plan --dry-run")
+	require.NoError(t, err)
+	assert.Contains(t, out.String(), "Dry run: no issues were moved.\n")
+}
+
+func TestIterationRollover_errors(t *testing.T) {
+	tc := setup(t)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"issueSetIteration": {"errors": ["Iteration is not valid"]}}}`, nil)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"issueSetIteration": {"errors": []}}}`, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdRollover, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("-g plan --yes")
+	require.EqualError(t, err, "failed to move 1 of 2 issues.")
+	assert.Contains(t, out.Stderr(), "plan/app#1: Iteration is not valid\n")
+}
+
+func TestIterationRollover_flags(t *testing.T) {
+	tests := []struct {
+		args    string
+		wantErr string
+	}{
+		{args: "next -g plan --yes", wantErr: "the next iteration can't be rolled over. Use current or an iteration ID."},
+		{args: "--to current -g plan --yes", wantErr: "--to must be next or an iteration ID."},
+		{args: "-g plan", wantErr: "--yes or --dry-run is required when not running interactively."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			exec := cmdtest.SetupCmdForTest(t, NewCmdRollover, false)
+
+			_, err := exec(tt.args)
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
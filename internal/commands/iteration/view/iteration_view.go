@@ -0,0 +1,222 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/iteration/iterationutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+const unassigned = "Unassigned"
+
+type options struct {
+	io           *iostreams.IOStreams
+	apiClient    func(repoHost string) (*api.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	group        string
+	outputFormat string
+}
+
+type issueSummary struct {
+	Reference string `json:"reference"`
+	Title     string `json:"title"`
+	Weight    int64  `json:"weight"`
+	WebURL    string `json:"web_url"`
+}
+
+type assigneeGroup struct {
+	Assignee string          `json:"assignee"`
+	Weight   int64           `json:"weight"`
+	Issues   []*issueSummary `json:"issues"`
+}
+
+type stateGroup struct {
+	State     string           `json:"state"`
+	Count     int              `json:"count"`
+	Weight    int64            `json:"weight"`
+	Assignees []*assigneeGroup `json:"assignees"`
+}
+
+type iterationView struct {
+	Iteration *iterationutils.Iteration `json:"iteration"`
+	States    []*stateGroup             `json:"states"`
+}
+
+func NewCmdView(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+	}
+
+	iterationViewCmd := &cobra.Command{
+		Use:   "view [<id> | current | next] [flags]",
+		Short: `Show the issues in an iteration, by state and assignee.`,
+		Long: heredoc.Docf(`
+			Show the issues in an iteration, grouped by state and by assignee, with their weights.
+			Issues with several assignees are shown under each of them.
+
+			The iteration is an iteration ID, %[1]scurrent%[1]s for the iteration in progress, or %[1]snext%[1]s
+			for the upcoming one. Defaults to %[1]scurrent%[1]s. Without a group, only the issues of
+			the project are shown.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab iteration view
+			$ glab iteration view next -g mygroup
+			$ glab iteration view 1234 -F json
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ref := iterationutils.RefCurrent
+			if len(args) == 1 {
+				ref = args[0]
+			}
+			return opts.run(ref)
+		},
+	}
+
+	iterationViewCmd.Flags().StringVarP(&opts.group, "group", "g", "", "Show the issues of a group in the iteration.")
+	iterationViewCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return iterationViewCmd
+}
+
+func (o *options) run(ref string) error {
+	client, group, err := cmdutils.GroupFromFlags(o.apiClient, o.baseRepo, o.group)
+	if err != nil {
+		return err
+	}
+
+	iteration, err := iterationutils.Resolve(client, group, ref, 0)
+	if err != nil {
+		return err
+	}
+
+	var issues []*gitlab.Issue
+	if o.group != "" {
+		issues, err = iterationutils.ListGroupIssues(client, group, iteration.ID, "all")
+	} else {
+		repo, _ := o.baseRepo()
+		issues, err = iterationutils.ListProjectIssues(client, repo.FullName(), iteration.ID, "all")
+	}
+	if err != nil {
+		return err
+	}
+
+	view := &iterationView{Iteration: iteration, States: groupIssues(issues)}
+
+	if o.outputFormat == "json" {
+		data, err := json.Marshal(view)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(o.io.StdOut, string(data))
+		return nil
+	}
+
+	o.print(view)
+	return nil
+}
+
+// groupIssues groups the issues by state, open first, and by assignee, in alphabetical order
+// with the unassigned issues last.
+func groupIssues(issues []*gitlab.Issue) []*stateGroup {
+	var states []*stateGroup
+	for _, state := range []string{"opened", "closed"} {
+		sg := &stateGroup{State: state, Assignees: []*assigneeGroup{}}
+		byAssignee := map[string]*assigneeGroup{}
+
+		for _, issue := range issues {
+			if issue.State != state {
+				continue
+			}
+			sg.Count++
+			sg.Weight += issue.Weight
+
+			summary := &issueSummary{
+				Reference: fmt.Sprintf("#%d", issue.IID),
+				Title:     issue.Title,
+				Weight:    issue.Weight,
+				WebURL:    issue.WebURL,
+			}
+			if issue.References != nil && issue.References.Relative != "" {
+				summary.Reference = issue.References.Relative
+			}
+
+			assignees := []string{unassigned}
+			if len(issue.Assignees) > 0 {
+				assignees = nil
+				for _, a := range issue.Assignees {
+					assignees = append(assignees, "@"+a.Username)
+				}
+			}
+			for _, name := range assignees {
+				ag, ok := byAssignee[name]
+				if !ok {
+					ag = &assigneeGroup{Assignee: name}
+					byAssignee[name] = ag
+					sg.Assignees = append(sg.Assignees, ag)
+				}
+				ag.Weight += issue.Weight
+				ag.Issues = append(ag.Issues, summary)
+			}
+		}
+
+		slices.SortFunc(sg.Assignees, func(a, b *assigneeGroup) int {
+			switch {
+			case a.Assignee == unassigned:
+				return 1
+			case b.Assignee == unassigned:
+				return -1
+			}
+			return strings.Compare(a.Assignee, b.Assignee)
+		})
+		states = append(states, sg)
+	}
+
+	return states
+}
+
+func (o *options) print(view *iterationView) {
+	c := o.io.Color()
+	out := o.io.StdOut
+	it := view.Iteration
+
+	title := it.Name()
+	if it.Title != "" {
+		title += " " + it.StartDate + " → " + it.DueDate
+	}
+	fmt.Fprintf(out, "%s (%s)\n", c.Bold(title), it.State)
+	fmt.Fprintln(out, c.Gray(it.WebURL))
+
+	for _, sg := range view.States {
+		title := "Open"
+		if sg.State == "closed" {
+			title = "Closed"
+		}
+		fmt.Fprintf(out, "\n%s (%s, weight %d)\n", c.Bold(title), utils.Pluralize(sg.Count, "issue"), sg.Weight)
+
+		for _, ag := range sg.Assignees {
+			fmt.Fprintf(out, "  %s (%s, weight %d)\n", ag.Assignee, utils.Pluralize(len(ag.Issues), "issue"), ag.Weight)
+			for _, issue := range ag.Issues {
+				fmt.Fprintf(out, "    %s %s %s\n", c.Cyan(issue.Reference), issue.Title, c.Gray(fmt.Sprintf("(weight %d)", issue.Weight)))
+			}
+		}
+	}
+}
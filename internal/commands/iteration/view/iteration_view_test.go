@@ -0,0 +1,96 @@
+//go:build !integration
+
+package view
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const currentIteration = `{"data": {"group": {"iterations": {"nodes": [
+	{"id": "gid://gitlab/Iteration/11", "iid": "1", "state": "current", "startDate": "2026-10-12", "dueDate": "2026-10-25",
+	 "webUrl": "https://gitlab.com/groups/plan/-/iterations/11",
+	 "iterationCadence": {"id": "gid://gitlab/Iterations::Cadence/1", "title": "Sprints"}}
+]}}}}`
+
+func TestIterationView(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{Namespace: &gitlab.ProjectNamespace{Kind: "group", FullPath: "plan"}}, nil, nil)
+	cmdtest.ExpectGraphQL(tc, currentIteration, nil)
+	tc.MockIssues.EXPECT().
+		ListProjectIssues("OWNER/REPO", gomock.Any()).
+		DoAndReturn(func(pid any, opts *gitlab.ListProjectIssuesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Issue, *gitlab.Response, error) {
+			assert.Equal(t, int64(11), *opts.IterationID)
+			assert.Equal(t, "all", *opts.State)
+			return []*gitlab.Issue{
+				{IID: 1, Title: "Login page", State: "opened", Weight: 3, Assignees: []*gitlab.IssueAssignee{{Username: "zoe"}}},
+				{IID: 2, Title: "Logout", State: "opened", Weight: 1},
+				{IID: 3, Title: "Sign up", State: "opened", Weight: 2, Assignees: []*gitlab.IssueAssignee{{Username: "amy"}, {Username: "zoe"}}},
+				{IID: 4, Title: "Docs", State: "closed", Weight: 5, Assignees: []*gitlab.IssueAssignee{{Username: "amy"}}},
+			}, &gitlab.Response{}, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdView, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("")
+	require.NoError(t, err)
+
+	assert.Equal(t, `Sprints 2026-10-12 → 2026-10-25 (current)
+https://gitlab.com/groups/plan/-/iterations/11
+
+Open (3 issues, weight 6)
+  @amy (1 issue, weight 2)
+    #3 Sign up (weight 2)
+  @zoe (2 issues, weight 5)
+    #1 Login page (weight 3)
+    #3 Sign up (weight 2)
+  Unassigned (1 issue, weight 1)
+    #2 Logout (weight 1)
+
+Closed (1 issue, weight 5)
+  @amy (1 issue, weight 5)
+    #4 Docs (weight 5)
+`, out.String())
+}
+
+func TestIterationView_groupJSON(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	cmdtest.ExpectGraphQL(tc, currentIteration, nil)
+	tc.MockIssues.EXPECT().
+		ListGroupIssues("plan", gomock.Any()).
+		Return([]*gitlab.Issue{
+			{IID: 1, Title: "Login page", State: "opened", Weight: 3, References: &gitlab.IssueReferences{Relative: "app#1"}},
+		}, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdView, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec("11 -g plan -F json")
+	require.NoError(t, err)
+
+	var view iterationView
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &view))
+	assert.Equal(t, int64(11), view.Iteration.ID)
+	require.Len(t, view.States, 2)
+	assert.Equal(t, 1, view.States[0].Count)
+	assert.Equal(t, "app#1", view.States[0].Assignees[0].Issues[0].Reference)
+	assert.Equal(t, "Unassigned", view.States[0].Assignees[0].Assignee)
+	assert.Equal(t, 0, view.States[1].Count)
+}
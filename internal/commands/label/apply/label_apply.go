@@ -0,0 +1,334 @@
+package apply
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/label/labelutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type action int
+
+const (
+	actionDelete action = iota
+	actionRename
+	actionUpdate
+	actionCreate
+)
+
+// change is one change to the labels of the target. Changes are applied in the order of their
+// actions, so labels are deleted before other labels are renamed to their names, and renamed
+// before new labels are created with their old names.
+type change struct {
+	action  action
+	current labelutils.Label
+	want    labelutils.Label
+}
+
+type options struct {
+	file   string
+	group  string
+	prune  bool
+	dryRun bool
+	yes    bool
+
+	io        *iostreams.IOStreams
+	apiClient func(repoHost string) (*api.Client, error)
+	baseRepo  func() (glrepo.Interface, error)
+}
+
+func NewCmdApply(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "apply -f <file> [flags]",
+		Short: `Create, update, and rename the labels of a project or group from a YAML file.`,
+		Long: heredoc.Docf(`
+			Make the labels of a project or group match a YAML file, like the one written by
+			%[1]sglab label export%[1]s. The changes are shown before they're applied.
+
+			Labels in the file are matched to existing labels by ID, then by name, then by one of
+			their %[1]sprevious_names%[1]s. A matched label with another name is renamed, so it keeps
+			its ID, and issues and merge requests keep the label. Labels that don't match are
+			created. With %[1]s--prune%[1]s, labels that are not in the file are deleted.
+
+			Labels inherited from parent groups are never changed.
+
+			The file has this format:
+
+			%[1]s%[1]s%[1]syaml
+			labels:
+			  - name: priority::high
+			    color: "#d9534f"
+			    description: Fix in the current milestone.
+			  - name: type::bug
+			    color: "#cc0033"
+			    previous_names: [bug, defect]
+			%[1]s%[1]s%[1]s
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab label apply -f labels.yaml --dry-run
+			$ glab label apply -f labels.yaml -R group/project --prune --yes
+			$ glab label export -R group/template | glab label apply -f - -g other-group
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.validate(); err != nil {
+				return err
+			}
+			return opts.run(cmd.Context())
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.file, "file", "f", "", "YAML file with the labels. Use - for standard input.")
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Apply the labels to a group.")
+	cmd.Flags().BoolVar(&opts.prune, "prune", false, "Delete the labels that are not in the file.")
+	cmd.Flags().BoolVar(&opts.dryRun, "dry-run", false, "Show the changes without applying them.")
+	cmd.Flags().BoolVarP(&opts.yes, "yes", "y", false, "Skip the confirmation prompt.")
+	_ = cmd.MarkFlagRequired("file")
+	cmd.MarkFlagsMutuallyExclusive("dry-run", "yes")
+
+	return cmd
+}
+
+func (o *options) validate() error {
+	if !o.dryRun && !o.yes && !o.io.PromptEnabled() {
+		return &cmdutils.FlagError{Err: errors.New("--yes or --dry-run is required when not running interactively.")}
+	}
+
+	return nil
+}
+
+func (o *options) run(ctx context.Context) error {
+	var data []byte
+	var err error
+	if o.file == "-" {
+		data, err = io.ReadAll(o.io.In)
+	} else {
+		data, err = os.ReadFile(o.file)
+	}
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", o.file, err)
+	}
+
+	file, err := labelutils.ParseFile(data)
+	if err != nil {
+		return err
+	}
+
+	target, err := labelutils.NewTarget(o.apiClient, o.baseRepo, o.group)
+	if err != nil {
+		return err
+	}
+
+	current, err := target.List()
+	if err != nil {
+		return err
+	}
+
+	changes, err := plan(current, file.Labels, o.prune)
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		fmt.Fprintf(o.io.StdOut, "The labels of %s are up to date.\n", target)
+		return nil
+	}
+
+	o.preview(target, changes)
+
+	if o.dryRun {
+		fmt.Fprintln(o.io.StdOut, "Dry run: no labels were changed.")
+		return nil
+	}
+
+	if !o.yes {
+		confirmed := false
+		err := o.io.Confirm(ctx, &confirmed, fmt.Sprintf("Apply %s?", utils.Pluralize(len(changes), "change")))
+		if err != nil {
+			return cmdutils.WrapError(err, "could not prompt")
+		}
+		if !confirmed {
+			return cmdutils.CancelError()
+		}
+	}
+
+	c := o.io.Color()
+	failed := 0
+	for _, ch := range changes {
+		var err error
+		switch ch.action {
+		case actionDelete:
+			err = target.Delete(ch.current.ID)
+		case actionRename, actionUpdate:
+			err = target.Update(ch.current.ID, ch.want)
+		case actionCreate:
+			err = target.Create(ch.want)
+		}
+		if err != nil {
+			fmt.Fprintf(o.io.StdErr, "%s Failed to %s: %s\n", c.FailedIcon(), describe(ch), err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to apply %d of %d changes.", failed, len(changes))
+	}
+
+	fmt.Fprintf(o.io.StdOut, "%s Applied %s to %s.\n", c.GreenCheck(), utils.Pluralize(len(changes), "change"), target)
+	return nil
+}
+
+func (o *options) preview(target *labelutils.Target, changes []change) {
+	c := o.io.Color()
+
+	fmt.Fprintf(o.io.StdOut, "Changes to the labels of %s:\n", target)
+	for _, ch := range changes {
+		switch ch.action {
+		case actionDelete:
+			fmt.Fprintf(o.io.StdOut, "  %s %s\n", c.Red("-"), describe(ch))
+		case actionRename, actionUpdate:
+			fmt.Fprintf(o.io.StdOut, "  %s %s\n", c.Yellow("~"), describe(ch))
+		case actionCreate:
+			fmt.Fprintf(o.io.StdOut, "  %s %s\n", c.Green("+"), describe(ch))
+		}
+	}
+	fmt.Fprintln(o.io.StdOut)
+}
+
+func describe(ch change) string {
+	switch ch.action {
+	case actionDelete:
+		return fmt.Sprintf("delete %q", ch.current.Name)
+	case actionCreate:
+		return fmt.Sprintf("create %q (%s)", ch.want.Name, ch.want.Color)
+	}
+
+	var details []string
+	if !strings.EqualFold(ch.current.Color, ch.want.Color) {
+		details = append(details, fmt.Sprintf("color %s → %s", ch.current.Color, ch.want.Color))
+	}
+	if ch.current.Description != ch.want.Description {
+		details = append(details, "description")
+	}
+	if !samePriority(ch.current, ch.want) {
+		details = append(details, "priority")
+	}
+
+	s := fmt.Sprintf("update %q", ch.current.Name)
+	if ch.action == actionRename {
+		s = fmt.Sprintf("rename %q → %q", ch.current.Name, ch.want.Name)
+	}
+	if len(details) > 0 {
+		s += " (" + strings.Join(details, ", ") + ")"
+	}
+	return s
+}
+
+// plan returns the changes that make the current labels match the wanted labels, in the order
+// to apply them. Wanted labels are matched to current labels by ID, then by name, then by their
+// previous names, so renamed labels keep their ID.
+func plan(current, wanted []labelutils.Label, prune bool) ([]change, error) {
+	byID := make(map[int64]int, len(current))
+	byName := make(map[string]int, len(current))
+	for i, l := range current {
+		byID[l.ID] = i
+		byName[l.Name] = i
+	}
+
+	matches := make([]int, len(wanted))
+	matched := make(map[int]bool)
+	match := func(w, c int) {
+		matches[w] = c
+		matched[c] = true
+	}
+	for i := range matches {
+		matches[i] = -1
+	}
+
+	for i, w := range wanted {
+		if c, ok := byID[w.ID]; ok && w.ID != 0 {
+			match(i, c)
+		}
+	}
+	for i, w := range wanted {
+		if c, ok := byName[w.Name]; ok && matches[i] == -1 && !matched[c] {
+			match(i, c)
+		}
+	}
+	for i, w := range wanted {
+		for _, name := range w.PreviousNames {
+			if c, ok := byName[name]; ok && matches[i] == -1 && !matched[c] {
+				match(i, c)
+			}
+		}
+	}
+
+	var changes []change
+	for c, l := range current {
+		if !matched[c] && prune {
+			changes = append(changes, change{action: actionDelete, current: l})
+		}
+	}
+
+	for i, w := range wanted {
+		c := matches[i]
+		if c == -1 {
+			// No current label has this name, or the one that has it is matched to another wanted
+			// label. It's renamed before this one is created, because creates are applied last.
+			changes = append(changes, change{action: actionCreate, want: w})
+			continue
+		}
+
+		cur := current[c]
+		if cur.Name != w.Name {
+			if other, ok := byName[w.Name]; ok && matched[other] {
+				return nil, fmt.Errorf("cannot rename label %q to %q: the label with this name is renamed too. Rename the labels one at a time.", cur.Name, w.Name)
+			} else if ok && !prune {
+				return nil, fmt.Errorf("cannot rename label %q to %q: a label with this name already exists. Use --prune to delete it.", cur.Name, w.Name)
+			}
+			changes = append(changes, change{action: actionRename, current: cur, want: w})
+			continue
+		}
+
+		if !strings.EqualFold(cur.Color, w.Color) || cur.Description != w.Description || !samePriority(cur, w) {
+			changes = append(changes, change{action: actionUpdate, current: cur, want: w})
+		}
+	}
+
+	slices.SortStableFunc(changes, func(a, b change) int {
+		return cmp.Compare(a.action, b.action)
+	})
+	return changes, nil
+}
+
+// samePriority reports whether the priority of want matches current. A label without a priority
+// in the file keeps its current priority.
+func samePriority(current, want labelutils.Label) bool {
+	if want.Priority == nil {
+		return true
+	}
+	return current.Priority != nil && *current.Priority == *want.Priority
+}
@@ -0,0 +1,191 @@
+//go:build !integration
+
+package apply
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/commands/label/labelutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_plan(t *testing.T) {
+	current := []labelutils.Label{
+		{ID: 1, Name: "bug", Color: "#cc0033"},
+		{ID: 2, Name: "feature", Color: "#00ff00"},
+		{ID: 3, Name: "docs", Color: "#0000ff"},
+		{ID: 4, Name: "wontfix", Color: "#ffffff"},
+	}
+
+	type want struct {
+		action  action
+		current string
+		want    string
+	}
+
+	tests := []struct {
+		name    string
+		wanted  []labelutils.Label
+		prune   bool
+		want    []want
+		wantErr string
+	}{
+		{
+			name: "unchanged",
+			wanted: []labelutils.Label{
+				{Name: "bug", Color: "#CC0033"},
+			},
+		},
+		{
+			name: "create, update, and rename",
+			wanted: []labelutils.Label{
+				{Name: "priority::high", Color: "#d9534f"},
+				{Name: "bug", Color: "#cc0033", Description: "Something is broken."},
+				{ID: 2, Name: "type::feature", Color: "#00ff00"},
+				{Name: "type::docs", Color: "#0000ff", PreviousNames: []string{"documentation", "docs"}},
+			},
+			want: []want{
+				{actionRename, "feature", "type::feature"},
+				{actionRename, "docs", "type::docs"},
+				{actionUpdate, "bug", "bug"},
+				{actionCreate, "", "priority::high"},
+			},
+		},
+		{
+			name: "prune",
+			wanted: []labelutils.Label{
+				{Name: "bug", Color: "#cc0033"},
+				{ID: 2, Name: "wontfix", Color: "#00ff00"},
+			},
+			prune: true,
+			want: []want{
+				{actionDelete, "docs", ""},
+				{actionDelete, "wontfix", ""},
+				{actionRename, "feature", "wontfix"},
+			},
+		},
+		{
+			name: "rename to existing label",
+			wanted: []labelutils.Label{
+				{ID: 2, Name: "wontfix", Color: "#00ff00"},
+			},
+			wantErr: `cannot rename label "feature" to "wontfix": a label with this name already exists. Use --prune to delete it.`,
+		},
+		{
+			name: "create with name of renamed label",
+			wanted: []labelutils.Label{
+				{ID: 1, Name: "type::bug", Color: "#cc0033"},
+				{Name: "bug", Color: "#000000"},
+			},
+			want: []want{
+				{actionRename, "bug", "type::bug"},
+				{actionCreate, "", "bug"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := plan(current, tt.wanted, tt.prune)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var got []want
+			for _, ch := range changes {
+				got = append(got, want{ch.action, ch.current.Name, ch.want.Name})
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLabelApply(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockLabels.EXPECT().
+		ListLabels("OWNER/REPO", gomock.Any()).
+		Return([]*gitlab.Label{
+			{ID: 1, Name: "bug", Color: "#cc0033", IsProjectLabel: true},
+			{ID: 2, Name: "old", Color: "#cccccc", IsProjectLabel: true},
+		}, &gitlab.Response{}, nil)
+	testClient.MockLabels.EXPECT().
+		UpdateLabel("OWNER/REPO", int64(1), &gitlab.UpdateLabelOptions{
+			NewName:     gitlab.Ptr("type::bug"),
+			Color:       gitlab.Ptr("#cc0033"),
+			Description: gitlab.Ptr(""),
+		}).
+		Return(&gitlab.Label{}, nil, nil)
+	testClient.MockLabels.EXPECT().
+		DeleteLabel("OWNER/REPO", int64(2), gomock.Any()).
+		Return(nil, nil)
+	testClient.MockLabels.EXPECT().
+		CreateLabel("OWNER/REPO", &gitlab.CreateLabelOptions{
+			Name:        gitlab.Ptr("priority::high"),
+			Color:       gitlab.Ptr("#d9534f"),
+			Description: gitlab.Ptr("Fix it now."),
+			Priority:    gitlab.Ptr(int64(1)),
+		}).
+		Return(&gitlab.Label{}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdApply, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+		cmdtest.WithStdin(`
+labels:
+  - name: type::bug
+    color: "#cc0033"
+    previous_names: [bug]
+  - name: priority::high
+    color: "#d9534f"
+    description: Fix it now.
+    priority: 1
+`),
+	)
+
+	out, err := exec("-f - --prune --yes")
+	require.NoError(t, err)
+
+	assert.Equal(t, `Changes to the labels of OWNER/REPO:
+  - delete "old"
+  ~ rename "bug" → "type::bug"
+  + create "priority::high" (#d9534f)
+
+✓ Applied 3 changes to OWNER/REPO.
+`, out.String())
+}
+
+func TestLabelApply_dryRun(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockLabels.EXPECT().
+		ListLabels("OWNER/REPO", gomock.Any()).
+		Return([]*gitlab.Label{{ID: 1, Name: "bug", Color: "#cc0033", IsProjectLabel: true}}, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdApply, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+		cmdtest.WithStdin("labels:\n  - {name: bug, color: '#000000', description: Broken}\n"),
+	)
+
+	out, err := exec("-f - --dry-run")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), `~ update "bug" (color #cc0033 → #000000, description)`)
+	assert.Contains(t, out.String(), "Dry run: no labels were changed.")
+}
+
+func TestLabelApply_invalidFile(t *testing.T) {
+	exec := cmdtest.SetupCmdForTest(t, NewCmdApply, false,
+		cmdtest.WithStdin("labels:\n  - {name: bug, color: red}\n  - {name: bug, color: blue}\n"),
+	)
+
+	_, err := exec("-f - --dry-run")
+	require.EqualError(t, err, `invalid label file: label "bug" is defined more than once.`)
+}
@@ -0,0 +1,97 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/label/labelutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	group  string
+	output string
+
+	io        *iostreams.IOStreams
+	apiClient func(repoHost string) (*api.Client, error)
+	baseRepo  func() (glrepo.Interface, error)
+}
+
+func NewCmdExport(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export [flags]",
+		Short: `Export the labels of a project or group to a YAML file.`,
+		Long: heredoc.Docf(`
+			Export the labels of a project or group, without the labels inherited from
+			parent groups, in the format read by %[1]sglab label apply%[1]s.
+
+			Each label has its ID, so a label renamed in the file is renamed when the file is
+			applied to the same project or group, instead of being replaced by a new label.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab label export > labels.yaml
+			$ glab label export -g mygroup -o labels.yaml
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.group, "group", "g", "", "Export the labels of a group.")
+	cmd.Flags().StringVarP(&opts.output, "output-file", "o", "", "Write the labels to a file instead of standard output.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	target, err := labelutils.NewTarget(o.apiClient, o.baseRepo, o.group)
+	if err != nil {
+		return err
+	}
+
+	labels, err := target.List()
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(labelutils.File{Labels: labels}); err != nil {
+		return err
+	}
+	data := buf.Bytes()
+
+	if o.output == "" {
+		_, err = o.io.StdOut.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(o.output, data, 0o644); err != nil {
+		return fmt.Errorf("error writing %s: %w", o.output, err)
+	}
+	if o.io.IsOutputTTY() {
+		fmt.Fprintf(o.io.StdErr, "%s Exported %d labels of %s to %s\n", o.io.Color().GreenCheck(), len(labels), target, o.output)
+	}
+
+	return nil
+}
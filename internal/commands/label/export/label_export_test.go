@@ -0,0 +1,67 @@
+//go:build !integration
+
+package export
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestLabelExport(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockLabels.EXPECT().
+		ListLabels("OWNER/REPO", gomock.Any()).
+		DoAndReturn(func(pid any, opts *gitlab.ListLabelsOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.Label, *gitlab.Response, error) {
+			assert.False(t, *opts.IncludeAncestorGroups)
+			return []*gitlab.Label{
+				{ID: 1, Name: "bug", Color: "#cc0033", IsProjectLabel: true, Priority: 2},
+				{ID: 2, Name: "ux", Color: "#3cb371", Description: "User Experience", IsProjectLabel: true},
+				{ID: 3, Name: "group-label", Color: "#000000"},
+			}, &gitlab.Response{}, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdExport, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+	)
+
+	out, err := exec("")
+	require.NoError(t, err)
+
+	assert.Equal(t, heredoc.Doc(`
+		labels:
+		  - id: 1
+		    name: bug
+		    color: '#cc0033'
+		    priority: 2
+		  - id: 2
+		    name: ux
+		    color: '#3cb371'
+		    description: User Experience
+	`), out.String())
+}
+
+func TestLabelExport_group(t *testing.T) {
+	testClient := gitlabtesting.NewTestClient(t)
+	testClient.MockGroupLabels.EXPECT().
+		ListGroupLabels("mygroup", gomock.Any()).
+		Return([]*gitlab.GroupLabel{{ID: 4, Name: "priority::high", Color: "#d9534f"}}, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdExport, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+	)
+
+	out, err := exec("-g mygroup")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "name: priority::high")
+}
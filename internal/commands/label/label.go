@@ -4,11 +4,14 @@ import (
 	"github.com/spf13/cobra"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	labelApplyCmd "gitlab.com/gitlab-org/cli/internal/commands/label/apply"
 	labelCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/label/create"
 	labelDeleteCmd "gitlab.com/gitlab-org/cli/internal/commands/label/delete"
 	labelUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/label/edit"
+	labelExportCmd "gitlab.com/gitlab-org/cli/internal/commands/label/export"
 	labelGetCmd "gitlab.com/gitlab-org/cli/internal/commands/label/get"
 	labelListCmd "gitlab.com/gitlab-org/cli/internal/commands/label/list"
+	labelPromoteCmd "gitlab.com/gitlab-org/cli/internal/commands/label/promote"
 )
 
 func NewCmdLabel(f cmdutils.Factory) *cobra.Command {
@@ -25,6 +28,9 @@ func NewCmdLabel(f cmdutils.Factory) *cobra.Command {
 	labelCmd.AddCommand(labelDeleteCmd.NewCmdDelete(f))
 	labelCmd.AddCommand(labelUpdateCmd.NewCmdEdit(f))
 	labelCmd.AddCommand(labelGetCmd.NewCmdGet(f))
+	labelCmd.AddCommand(labelExportCmd.NewCmdExport(f))
+	labelCmd.AddCommand(labelApplyCmd.NewCmdApply(f))
+	labelCmd.AddCommand(labelPromoteCmd.NewCmdPromote(f))
 
 	return labelCmd
 }
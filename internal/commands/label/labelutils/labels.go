@@ -0,0 +1,186 @@
+package labelutils
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+)
+
+// Label is a label in a label file, as written by glab label export and read by glab label apply.
+type Label struct {
+	ID            int64    `yaml:"id,omitempty"`
+	Name          string   `yaml:"name"`
+	Color         string   `yaml:"color"`
+	Description   string   `yaml:"description,omitempty"`
+	Priority      *int64   `yaml:"priority,omitempty"`
+	PreviousNames []string `yaml:"previous_names,omitempty"`
+}
+
+// File is the content of a label file.
+type File struct {
+	Labels []Label `yaml:"labels"`
+}
+
+// ParseFile parses a label file, and checks that every label has a unique name and a color.
+func ParseFile(data []byte) (*File, error) {
+	var file File
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid label file: %w", err)
+	}
+
+	names := make(map[string]bool)
+	for i, l := range file.Labels {
+		if l.Name == "" {
+			return nil, fmt.Errorf("invalid label file: label %d has no name.", i+1)
+		}
+		if l.Color == "" {
+			return nil, fmt.Errorf("invalid label file: label %q has no color.", l.Name)
+		}
+		if names[l.Name] {
+			return nil, fmt.Errorf("invalid label file: label %q is defined more than once.", l.Name)
+		}
+		names[l.Name] = true
+	}
+
+	return &file, nil
+}
+
+// Target is the project or group whose labels are exported or applied. Only the labels of the
+// project or group itself are managed, not the labels it inherits from parent groups.
+type Target struct {
+	client  *gitlab.Client
+	project glrepo.Interface
+	group   string
+}
+
+// NewTarget returns the group if it's given, and the base repository otherwise.
+func NewTarget(apiClient func(repoHost string) (*api.Client, error), baseRepo func() (glrepo.Interface, error), group string) (*Target, error) {
+	client, repo, err := cmdutils.GroupOrRepoClient(apiClient, baseRepo, group)
+	if err != nil {
+		return nil, err
+	}
+	return &Target{client: client, project: repo, group: group}, nil
+}
+
+func (t *Target) String() string {
+	if t.group != "" {
+		return t.group
+	}
+	return t.project.FullName()
+}
+
+// List returns all labels of the target.
+func (t *Target) List() ([]Label, error) {
+	var labels []Label
+
+	if t.group != "" {
+		opts := &gitlab.ListGroupLabelsOptions{
+			ListOptions:           gitlab.ListOptions{PerPage: api.MaxPerPage},
+			IncludeAncestorGroups: gitlab.Ptr(false),
+			OnlyGroupLabels:       gitlab.Ptr(true),
+		}
+		for {
+			page, resp, err := t.client.GroupLabels.ListGroupLabels(t.group, opts)
+			if err != nil {
+				return nil, fmt.Errorf("error listing labels of %s: %w", t, err)
+			}
+			for _, l := range page {
+				labels = append(labels, fromAPI((*gitlab.Label)(l)))
+			}
+
+			if resp.NextPage == 0 {
+				return labels, nil
+			}
+			opts.Page = resp.NextPage
+		}
+	}
+
+	opts := &gitlab.ListLabelsOptions{
+		ListOptions:           gitlab.ListOptions{PerPage: api.MaxPerPage},
+		IncludeAncestorGroups: gitlab.Ptr(false),
+	}
+	for {
+		page, resp, err := t.client.Labels.ListLabels(t.project.FullName(), opts)
+		if err != nil {
+			return nil, fmt.Errorf("error listing labels of %s: %w", t, err)
+		}
+		for _, l := range page {
+			if l.IsProjectLabel {
+				labels = append(labels, fromAPI(l))
+			}
+		}
+
+		if resp.NextPage == 0 {
+			return labels, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func fromAPI(l *gitlab.Label) Label {
+	label := Label{ID: l.ID, Name: l.Name, Color: l.Color, Description: l.Description}
+	if l.Priority != 0 {
+		label.Priority = gitlab.Ptr(l.Priority)
+	}
+	return label
+}
+
+// Create creates the label.
+func (t *Target) Create(l Label) error {
+	var err error
+	if t.group != "" {
+		_, _, err = t.client.GroupLabels.CreateGroupLabel(t.group, &gitlab.CreateGroupLabelOptions{
+			Name:        gitlab.Ptr(l.Name),
+			Color:       gitlab.Ptr(l.Color),
+			Description: gitlab.Ptr(l.Description),
+			Priority:    l.Priority,
+		})
+	} else {
+		_, _, err = t.client.Labels.CreateLabel(t.project.FullName(), &gitlab.CreateLabelOptions{
+			Name:        gitlab.Ptr(l.Name),
+			Color:       gitlab.Ptr(l.Color),
+			Description: gitlab.Ptr(l.Description),
+			Priority:    l.Priority,
+		})
+	}
+	return err
+}
+
+// Update changes the label with the given ID to l. Because the label keeps its ID when
+// it's renamed, issues and merge requests keep the label.
+func (t *Target) Update(id int64, l Label) error {
+	var err error
+	if t.group != "" {
+		_, _, err = t.client.GroupLabels.UpdateGroupLabel(t.group, id, &gitlab.UpdateGroupLabelOptions{
+			NewName:     gitlab.Ptr(l.Name),
+			Color:       gitlab.Ptr(l.Color),
+			Description: gitlab.Ptr(l.Description),
+			Priority:    l.Priority,
+		})
+	} else {
+		_, _, err = t.client.Labels.UpdateLabel(t.project.FullName(), id, &gitlab.UpdateLabelOptions{
+			NewName:     gitlab.Ptr(l.Name),
+			Color:       gitlab.Ptr(l.Color),
+			Description: gitlab.Ptr(l.Description),
+			Priority:    l.Priority,
+		})
+	}
+	return err
+}
+
+// Delete deletes the label with the given ID.
+func (t *Target) Delete(id int64) error {
+	var err error
+	if t.group != "" {
+		_, err = t.client.GroupLabels.DeleteGroupLabel(t.group, id, &gitlab.DeleteGroupLabelOptions{})
+	} else {
+		_, err = t.client.Labels.DeleteLabel(t.project.FullName(), id, &gitlab.DeleteLabelOptions{})
+	}
+	return err
+}
@@ -0,0 +1,52 @@
+package promote
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func NewCmdPromote(f cmdutils.Factory) *cobra.Command {
+	labelPromoteCmd := &cobra.Command{
+		Use:   "promote <name>",
+		Short: `Promote a project label to a group label.`,
+		Long: heredoc.Doc(`
+			Promote a project label to a label of the group that the project belongs to.
+			Issues and merge requests keep the label, and labels with the same name in other
+			projects of the group are merged into the group label.
+		`),
+		Example: heredoc.Doc(`
+			$ glab label promote "priority::high"
+			$ glab label promote bug -R owner/repo
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+
+			repo, err := f.BaseRepo()
+			if err != nil {
+				return err
+			}
+
+			_, err = client.Labels.PromoteLabel(repo.FullName(), args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(f.IO().StdOut, "%s Promoted label %q to a group label.\n", f.IO().Color().GreenCheck(), args[0])
+			return nil
+		},
+	}
+
+	return labelPromoteCmd
+}
@@ -0,0 +1,65 @@
+//go:build !integration
+
+package promote
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_LabelPromote(t *testing.T) {
+	type testCase struct {
+		name        string
+		cli         string
+		expectedMsg string
+		wantErr     string
+		setupMock   func(tc *gitlabtesting.TestClient)
+	}
+
+	testCases := []testCase{
+		{
+			name:        "Label promote",
+			cli:         "priority::high",
+			expectedMsg: `Promoted label "priority::high" to a group label.`,
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockLabels.EXPECT().
+					PromoteLabel("OWNER/REPO", "priority::high", gomock.Any()).
+					Return(nil, nil)
+			},
+		},
+		{
+			name:    "Label promote error",
+			cli:     "bug",
+			wantErr: "403 Forbidden",
+			setupMock: func(tc *gitlabtesting.TestClient) {
+				tc.MockLabels.EXPECT().
+					PromoteLabel("OWNER/REPO", "bug", gomock.Any()).
+					Return(nil, errors.New("403 Forbidden"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testClient := gitlabtesting.NewTestClient(t)
+			tc.setupMock(testClient)
+			exec := cmdtest.SetupCmdForTest(t, NewCmdPromote, false, cmdtest.WithGitLabClient(testClient.Client))
+
+			out, err := exec(tc.cli)
+			if tc.wantErr != "" {
+				require.ErrorContains(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Contains(t, out.OutBuf.String(), tc.expectedMsg)
+		})
+	}
+}
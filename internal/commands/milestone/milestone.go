@@ -9,6 +9,7 @@ import (
 	cmdEdit "gitlab.com/gitlab-org/cli/internal/commands/milestone/edit"
 	cmdGet "gitlab.com/gitlab-org/cli/internal/commands/milestone/get"
 	cmdList "gitlab.com/gitlab-org/cli/internal/commands/milestone/list"
+	cmdReport "gitlab.com/gitlab-org/cli/internal/commands/milestone/report"
 )
 
 func NewCmdMilestone(f cmdutils.Factory) *cobra.Command {
@@ -25,6 +26,7 @@ func NewCmdMilestone(f cmdutils.Factory) *cobra.Command {
 	cmd.AddCommand(cmdEdit.NewCmdEdit(f))
 	cmd.AddCommand(cmdGet.NewCmdGet(f))
 	cmd.AddCommand(cmdList.NewCmdList(f))
+	cmd.AddCommand(cmdReport.NewCmdReport(f))
 
 	return cmd
 }
@@ -0,0 +1,523 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+const (
+	dateFormat  = "2006-01-02"
+	chartWidth  = 40
+	unitWeight  = "weight"
+	unitIssues  = "issues"
+	kindIssue   = "issue"
+	kindMR      = "merge_request"
+	stateOpened = "opened"
+)
+
+type options struct {
+	apiClient func(repoHost string) (*api.Client, error)
+	io        *iostreams.IOStreams
+	baseRepo  func() (glrepo.Interface, error)
+
+	projectID    string
+	groupID      string
+	title        string
+	outputFormat string
+}
+
+// Report is the progress of a milestone, as printed with --output json.
+type Report struct {
+	Milestone     MilestoneInfo `json:"milestone"`
+	Issues        Counts        `json:"issues"`
+	MergeRequests Counts        `json:"merge_requests"`
+	Weight        Weight        `json:"weight"`
+	Time          TimeTracking  `json:"time_tracking"`
+	BurndownUnit  string        `json:"burndown_unit"`
+	Burndown      []Day         `json:"burndown"`
+	Overdue       []Item        `json:"overdue"`
+}
+
+type MilestoneInfo struct {
+	Title     string `json:"title"`
+	State     string `json:"state"`
+	StartDate string `json:"start_date,omitempty"`
+	DueDate   string `json:"due_date,omitempty"`
+	WebURL    string `json:"web_url,omitempty"`
+}
+
+type Counts struct {
+	Total  int `json:"total"`
+	Open   int `json:"open"`
+	Closed int `json:"closed"`
+	Merged int `json:"merged,omitempty"`
+}
+
+type Weight struct {
+	Total     int64 `json:"total"`
+	Remaining int64 `json:"remaining"`
+}
+
+type TimeTracking struct {
+	Estimate int64 `json:"estimate_seconds"`
+	Spent    int64 `json:"spent_seconds"`
+}
+
+// Day is the remaining work at the end of a day of the milestone, and the remaining work
+// if the work was done at a steady pace.
+type Day struct {
+	Date      string  `json:"date"`
+	Remaining int64   `json:"remaining"`
+	Ideal     float64 `json:"ideal"`
+}
+
+type Item struct {
+	Kind      string `json:"kind"`
+	Reference string `json:"reference"`
+	Title     string `json:"title"`
+	DueDate   string `json:"due_date"`
+	WebURL    string `json:"web_url"`
+}
+
+func NewCmdReport(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		baseRepo:  f.BaseRepo,
+	}
+	cmd := &cobra.Command{
+		Use:   "report <title>",
+		Short: "Show the progress and burndown of a project or group milestone.",
+		Long: heredoc.Docf(`
+			Show the progress of a milestone: open and closed issues and merge requests,
+			total and remaining weight, estimated and spent time, a burndown chart from the
+			start date to the due date, and overdue items.
+
+			The burndown chart counts the remaining weight, or the remaining issues when no
+			issue has a weight. Open issues are overdue after their due date, or after the due
+			date of the milestone when they have none. Open merge requests are overdue after the
+			due date of the milestone.
+
+			Use %[1]s--output markdown%[1]s to paste the report in a sprint review, and
+			%[1]s--output json%[1]s to process it with other tools.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Report on a milestone of the current project
+			$ glab milestone report "Sprint 42"
+
+			# Report on a group milestone, in Markdown
+			$ glab milestone report "Sprint 42" --group example-group --output markdown
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.title = args[0]
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.projectID, "project", "", "The ID or URL-encoded path of the project.")
+	cmd.Flags().StringVar(&opts.groupID, "group", "", "The ID or URL-encoded path of the group.")
+	cmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json", "markdown"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json, markdown.")
+	cmd.MarkFlagsMutuallyExclusive("project", "group")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	c, err := o.apiClient("")
+	if err != nil {
+		return err
+	}
+	client := c.Lab()
+
+	var milestone *gitlab.Milestone
+	var issues []*gitlab.Issue
+	var mrs []*gitlab.BasicMergeRequest
+
+	if o.groupID != "" {
+		milestone, issues, mrs, err = groupMilestone(client, o.groupID, o.title)
+	} else {
+		projectID := o.projectID
+		if projectID == "" {
+			repo, err := o.baseRepo()
+			if err != nil {
+				return err
+			}
+			projectID = repo.FullName()
+		}
+		milestone, issues, mrs, err = projectMilestone(client, projectID, o.title)
+	}
+	if err != nil {
+		return err
+	}
+
+	report := buildReport(milestone, issues, mrs, time.Now())
+
+	switch o.outputFormat {
+	case "json":
+		data, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(o.io.StdOut, string(data))
+	case "markdown":
+		printMarkdown(o.io.StdOut, report)
+	default:
+		o.printText(report)
+	}
+
+	return nil
+}
+
+func projectMilestone(client *gitlab.Client, projectID, title string) (*gitlab.Milestone, []*gitlab.Issue, []*gitlab.BasicMergeRequest, error) {
+	milestones, _, err := client.Milestones.ListMilestones(projectID, &gitlab.ListMilestonesOptions{Title: gitlab.Ptr(title)})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(milestones) == 0 {
+		return nil, nil, nil, fmt.Errorf("milestone %q not found in project %s.", title, projectID)
+	}
+	milestone := milestones[0]
+
+	issues, err := listAll(func(opts gitlab.ListOptions) ([]*gitlab.Issue, *gitlab.Response, error) {
+		return client.Milestones.GetMilestoneIssues(projectID, milestone.ID, &gitlab.GetMilestoneIssuesOptions{ListOptions: opts})
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error listing issues: %w", err)
+	}
+
+	mrs, err := listAll(func(opts gitlab.ListOptions) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+		return client.Milestones.GetMilestoneMergeRequests(projectID, milestone.ID, &gitlab.GetMilestoneMergeRequestsOptions{ListOptions: opts})
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error listing merge requests: %w", err)
+	}
+
+	return milestone, issues, mrs, nil
+}
+
+func groupMilestone(client *gitlab.Client, groupID, title string) (*gitlab.Milestone, []*gitlab.Issue, []*gitlab.BasicMergeRequest, error) {
+	milestones, _, err := client.GroupMilestones.ListGroupMilestones(groupID, &gitlab.ListGroupMilestonesOptions{Title: gitlab.Ptr(title)})
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(milestones) == 0 {
+		return nil, nil, nil, fmt.Errorf("milestone %q not found in group %s.", title, groupID)
+	}
+	gm := milestones[0]
+	milestone := &gitlab.Milestone{
+		ID:        gm.ID,
+		Title:     gm.Title,
+		State:     gm.State,
+		StartDate: gm.StartDate,
+		DueDate:   gm.DueDate,
+		CreatedAt: gm.CreatedAt,
+	}
+
+	// The client library doesn't decode the web URL of group milestones, so it's built from the
+	// web URL of the group.
+	group, _, err := client.Groups.GetGroup(gm.GroupID, nil)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error getting group %s: %w", groupID, err)
+	}
+	milestone.WebURL = fmt.Sprintf("%s/-/milestones/%d", group.WebURL, gm.IID)
+
+	issues, err := listAll(func(opts gitlab.ListOptions) ([]*gitlab.Issue, *gitlab.Response, error) {
+		return client.GroupMilestones.GetGroupMilestoneIssues(groupID, gm.ID, &gitlab.GetGroupMilestoneIssuesOptions{ListOptions: opts})
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error listing issues: %w", err)
+	}
+
+	mrs, err := listAll(func(opts gitlab.ListOptions) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+		return client.GroupMilestones.GetGroupMilestoneMergeRequests(groupID, gm.ID, &gitlab.GetGroupMilestoneMergeRequestsOptions{ListOptions: opts})
+	})
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error listing merge requests: %w", err)
+	}
+
+	return milestone, issues, mrs, nil
+}
+
+func listAll[T any](list func(opts gitlab.ListOptions) ([]T, *gitlab.Response, error)) ([]T, error) {
+	var all []T
+	opts := gitlab.ListOptions{PerPage: api.MaxPerPage}
+	for {
+		page, resp, err := list(opts)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+
+		if resp == nil || resp.NextPage == 0 {
+			return all, nil
+		}
+		opts.Page = resp.NextPage
+	}
+}
+
+func buildReport(milestone *gitlab.Milestone, issues []*gitlab.Issue, mrs []*gitlab.BasicMergeRequest, now time.Time) *Report {
+	r := &Report{
+		Milestone: MilestoneInfo{
+			Title:     milestone.Title,
+			State:     milestone.State,
+			StartDate: utils.FormatDueDate(milestone.StartDate),
+			DueDate:   utils.FormatDueDate(milestone.DueDate),
+			WebURL:    milestone.WebURL,
+		},
+		Burndown: []Day{},
+		Overdue:  []Item{},
+	}
+	today := now.Format(dateFormat)
+
+	for _, issue := range issues {
+		r.Issues.Total++
+		r.Weight.Total += issue.Weight
+		if issue.State == stateOpened {
+			r.Issues.Open++
+			r.Weight.Remaining += issue.Weight
+		} else {
+			r.Issues.Closed++
+		}
+		if issue.TimeStats != nil {
+			r.Time.Estimate += issue.TimeStats.TimeEstimate
+			r.Time.Spent += issue.TimeStats.TotalTimeSpent
+		}
+
+		due := r.Milestone.DueDate
+		if issue.DueDate != nil {
+			due = issue.DueDate.String()
+		}
+		if issue.State == stateOpened && due != "" && due < today {
+			r.Overdue = append(r.Overdue, Item{
+				Kind:      kindIssue,
+				Reference: reference(issue.References, "#", issue.IID),
+				Title:     issue.Title,
+				DueDate:   due,
+				WebURL:    issue.WebURL,
+			})
+		}
+	}
+
+	for _, mr := range mrs {
+		r.MergeRequests.Total++
+		switch mr.State {
+		case stateOpened:
+			r.MergeRequests.Open++
+		case "merged":
+			r.MergeRequests.Closed++
+			r.MergeRequests.Merged++
+		default:
+			r.MergeRequests.Closed++
+		}
+		if mr.TimeStats != nil {
+			r.Time.Estimate += mr.TimeStats.TimeEstimate
+			r.Time.Spent += mr.TimeStats.TotalTimeSpent
+		}
+
+		due := r.Milestone.DueDate
+		if mr.State == stateOpened && due != "" && due < today {
+			r.Overdue = append(r.Overdue, Item{
+				Kind:      kindMR,
+				Reference: reference(mr.References, "!", mr.IID),
+				Title:     mr.Title,
+				DueDate:   due,
+				WebURL:    mr.WebURL,
+			})
+		}
+	}
+
+	r.BurndownUnit, r.Burndown = burndown(milestone, issues, now)
+	return r
+}
+
+func reference(refs *gitlab.IssueReferences, prefix string, iid int64) string {
+	if refs != nil && refs.Full != "" {
+		return refs.Full
+	}
+	return fmt.Sprintf("%s%d", prefix, iid)
+}
+
+// burndown returns the remaining work at the end of each day from the start of the milestone
+// until today or the due date, whichever comes first. The ideal line goes from the total work
+// on the start date to zero on the due date.
+func burndown(milestone *gitlab.Milestone, issues []*gitlab.Issue, now time.Time) (string, []Day) {
+	unit := unitIssues
+	var total int64
+	for _, issue := range issues {
+		total += issue.Weight
+	}
+	if total > 0 {
+		unit = unitWeight
+	} else {
+		total = int64(len(issues))
+	}
+
+	var start time.Time
+	switch {
+	case milestone.StartDate != nil:
+		start = time.Time(*milestone.StartDate)
+	case milestone.CreatedAt != nil:
+		start = *milestone.CreatedAt
+	default:
+		return unit, []Day{}
+	}
+	start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC)
+
+	end := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	span := 0
+	if milestone.DueDate != nil {
+		due := time.Time(*milestone.DueDate)
+		due = time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, time.UTC)
+		span = int(due.Sub(start).Hours() / 24)
+		if due.Before(end) {
+			end = due
+		}
+	}
+
+	days := []Day{}
+	for day, i := start, 0; !day.After(end); day, i = day.AddDate(0, 0, 1), i+1 {
+		dayEnd := day.AddDate(0, 0, 1)
+
+		remaining := total
+		for _, issue := range issues {
+			if issue.State == stateOpened || issue.ClosedAt == nil || !issue.ClosedAt.Before(dayEnd) {
+				continue
+			}
+			if unit == unitWeight {
+				remaining -= issue.Weight
+			} else {
+				remaining--
+			}
+		}
+
+		ideal := float64(total)
+		if span > 0 {
+			ideal = max(0, float64(total)*(1-float64(i)/float64(span)))
+		}
+
+		days = append(days, Day{Date: day.Format(dateFormat), Remaining: remaining, Ideal: ideal})
+	}
+
+	return unit, days
+}
+
+func dates(m MilestoneInfo) string {
+	switch {
+	case m.StartDate != "" && m.DueDate != "":
+		return m.StartDate + " → " + m.DueDate
+	case m.DueDate != "":
+		return "due " + m.DueDate
+	case m.StartDate != "":
+		return "from " + m.StartDate
+	default:
+		return "no dates"
+	}
+}
+
+func mergeRequestCounts(c Counts) string {
+	return fmt.Sprintf("%d open, %d merged, %d closed", c.Open, c.Merged, c.Closed-c.Merged)
+}
+
+func timeTracking(t TimeTracking) string {
+	if t.Estimate == 0 && t.Spent == 0 {
+		return "no time tracked"
+	}
+	return fmt.Sprintf("%s spent of %s estimated", utils.FmtTimeTracking(t.Spent), utils.FmtTimeTracking(t.Estimate))
+}
+
+// chart draws a bar for the remaining work of each day, with a marker at the ideal remaining work.
+func chart(r *Report) string {
+	var top float64
+	for _, d := range r.Burndown {
+		top = max(top, float64(d.Remaining), d.Ideal)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Burndown (remaining %s, | is ideal):\n", r.BurndownUnit)
+	for _, d := range r.Burndown {
+		bar := []rune(strings.Repeat(" ", chartWidth))
+		if top > 0 {
+			filled := int(float64(d.Remaining) / top * chartWidth)
+			for i := range filled {
+				bar[i] = '█'
+			}
+			ideal := min(int(d.Ideal/top*chartWidth), chartWidth-1)
+			if ideal >= filled {
+				bar[ideal] = '|'
+			}
+		}
+		fmt.Fprintf(&sb, "  %s %s %d\n", d.Date, string(bar), d.Remaining)
+	}
+
+	return sb.String()
+}
+
+func (o *options) printText(r *Report) {
+	c := o.io.Color()
+	out := o.io.StdOut
+
+	fmt.Fprintf(out, "%s (%s, %s)\n", c.Bold(r.Milestone.Title), r.Milestone.State, dates(r.Milestone))
+	fmt.Fprintf(out, "Issues:         %d open, %d closed, %d total\n", r.Issues.Open, r.Issues.Closed, r.Issues.Total)
+	fmt.Fprintf(out, "Merge requests: %s, %d total\n", mergeRequestCounts(r.MergeRequests), r.MergeRequests.Total)
+	fmt.Fprintf(out, "Weight:         %d remaining of %d\n", r.Weight.Remaining, r.Weight.Total)
+	fmt.Fprintf(out, "Time:           %s\n", timeTracking(r.Time))
+
+	if len(r.Burndown) > 0 {
+		fmt.Fprintln(out)
+		fmt.Fprint(out, chart(r))
+	}
+
+	if len(r.Overdue) > 0 {
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, c.Red(fmt.Sprintf("Overdue (%d):", len(r.Overdue))))
+		for _, item := range r.Overdue {
+			fmt.Fprintf(out, "  %s %s (due %s)\n", item.Reference, item.Title, item.DueDate)
+		}
+	}
+}
+
+func printMarkdown(out io.Writer, r *Report) {
+	title := r.Milestone.Title
+	if r.Milestone.WebURL != "" {
+		title = fmt.Sprintf("[%s](%s)", title, r.Milestone.WebURL)
+	}
+
+	fmt.Fprintf(out, "## Milestone %s\n\n", title)
+	fmt.Fprintf(out, "**State:** %s, %s\n\n", r.Milestone.State, dates(r.Milestone))
+	fmt.Fprintln(out, "| | Open | Closed | Total |")
+	fmt.Fprintln(out, "|---|---|---|---|")
+	fmt.Fprintf(out, "| Issues | %d | %d | %d |\n", r.Issues.Open, r.Issues.Closed, r.Issues.Total)
+	fmt.Fprintf(out, "| Merge requests | %d | %d (%d merged) | %d |\n\n", r.MergeRequests.Open, r.MergeRequests.Closed, r.MergeRequests.Merged, r.MergeRequests.Total)
+	fmt.Fprintf(out, "- **Weight:** %d remaining of %d\n", r.Weight.Remaining, r.Weight.Total)
+	fmt.Fprintf(out, "- **Time:** %s\n", timeTracking(r.Time))
+
+	if len(r.Burndown) > 0 {
+		fmt.Fprintf(out, "\n### Burndown\n\n```plaintext\n%s```\n", chart(r))
+	}
+
+	if len(r.Overdue) > 0 {
+		fmt.Fprint(out, "\n### Overdue\n\n")
+		for _, item := range r.Overdue {
+			fmt.Fprintf(out, "- [%s](%s) %s (due %s)\n", item.Reference, item.WebURL, item.Title, item.DueDate)
+		}
+	}
+}
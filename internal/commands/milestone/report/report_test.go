@@ -0,0 +1,149 @@
+package report
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func date(year int, month time.Month, day int) *gitlab.ISOTime {
+	return gitlab.Ptr(gitlab.ISOTime(time.Date(year, month, day, 0, 0, 0, 0, time.UTC)))
+}
+
+func at(year int, month time.Month, day, hour int) *time.Time {
+	return gitlab.Ptr(time.Date(year, month, day, hour, 0, 0, 0, time.UTC))
+}
+
+func Test_buildReport(t *testing.T) {
+	milestone := &gitlab.Milestone{
+		Title:     "Sprint 1",
+		State:     "active",
+		StartDate: date(2025, 1, 6),
+		DueDate:   date(2025, 1, 10),
+	}
+	issues := []*gitlab.Issue{
+		{IID: 1, State: "closed", Weight: 3, ClosedAt: at(2025, 1, 6, 15), TimeStats: &gitlab.TimeStats{TimeEstimate: 3600, TotalTimeSpent: 1800}},
+		{IID: 2, State: "closed", Weight: 2, ClosedAt: at(2025, 1, 8, 9)},
+		{IID: 3, State: "opened", Weight: 5, DueDate: date(2025, 1, 7), Title: "Late", TimeStats: &gitlab.TimeStats{TimeEstimate: 7200}},
+		{IID: 4, State: "opened", References: &gitlab.IssueReferences{Full: "group/project#4"}},
+	}
+	mrs := []*gitlab.BasicMergeRequest{
+		{IID: 1, State: "merged"},
+		{IID: 2, State: "closed"},
+		{IID: 3, State: "opened"},
+	}
+
+	r := buildReport(milestone, issues, mrs, time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, Counts{Total: 4, Open: 2, Closed: 2}, r.Issues)
+	assert.Equal(t, Counts{Total: 3, Open: 1, Closed: 2, Merged: 1}, r.MergeRequests)
+	assert.Equal(t, Weight{Total: 10, Remaining: 5}, r.Weight)
+	assert.Equal(t, TimeTracking{Estimate: 3 * 3600, Spent: 1800}, r.Time)
+
+	assert.Equal(t, "weight", r.BurndownUnit)
+	assert.Equal(t, []Day{
+		{Date: "2025-01-06", Remaining: 7, Ideal: 10},
+		{Date: "2025-01-07", Remaining: 7, Ideal: 7.5},
+		{Date: "2025-01-08", Remaining: 5, Ideal: 5},
+	}, r.Burndown)
+
+	require.Len(t, r.Overdue, 1)
+	assert.Equal(t, Item{Kind: "issue", Reference: "#3", Title: "Late", DueDate: "2025-01-07"}, r.Overdue[0])
+
+	// After the due date of the milestone, all open items are overdue.
+	r = buildReport(milestone, issues, mrs, time.Date(2025, 1, 20, 12, 0, 0, 0, time.UTC))
+	assert.Len(t, r.Burndown, 5)
+	assert.Len(t, r.Overdue, 3)
+	assert.Equal(t, "group/project#4", r.Overdue[1].Reference)
+	assert.Equal(t, "!3", r.Overdue[2].Reference)
+}
+
+func Test_buildReport_issueCount(t *testing.T) {
+	milestone := &gitlab.Milestone{Title: "Backlog", CreatedAt: at(2025, 1, 6, 10)}
+	issues := []*gitlab.Issue{
+		{IID: 1, State: "closed", ClosedAt: at(2025, 1, 7, 10)},
+		{IID: 2, State: "opened"},
+	}
+
+	r := buildReport(milestone, issues, nil, time.Date(2025, 1, 7, 12, 0, 0, 0, time.UTC))
+
+	assert.Equal(t, "issues", r.BurndownUnit)
+	assert.Equal(t, []Day{
+		{Date: "2025-01-06", Remaining: 2, Ideal: 2},
+		{Date: "2025-01-07", Remaining: 1, Ideal: 2},
+	}, r.Burndown)
+}
+
+func TestMilestoneReport(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockGroupMilestones.EXPECT().
+		ListGroupMilestones("example-group", gomock.Any()).
+		DoAndReturn(func(gid any, opts *gitlab.ListGroupMilestonesOptions, options ...gitlab.RequestOptionFunc) ([]*gitlab.GroupMilestone, *gitlab.Response, error) {
+			assert.Equal(t, "Sprint 1", *opts.Title)
+			return []*gitlab.GroupMilestone{{
+				ID: 7, IID: 1, GroupID: 3, Title: "Sprint 1", State: "closed", StartDate: date(2025, 1, 6), DueDate: date(2025, 1, 7),
+			}}, nil, nil
+		})
+	tc.MockGroups.EXPECT().
+		GetGroup(int64(3), gomock.Any()).
+		Return(&gitlab.Group{ID: 3, WebURL: "https://gitlab.com/groups/example-group"}, nil, nil)
+	tc.MockGroupMilestones.EXPECT().
+		GetGroupMilestoneIssues("example-group", int64(7), gomock.Any()).
+		Return([]*gitlab.Issue{
+			{IID: 1, State: "closed", Weight: 2, ClosedAt: at(2025, 1, 6, 12)},
+			{IID: 2, State: "opened", Weight: 2, Title: "Leftover", WebURL: "https://gitlab.com/example-group/project/-/issues/2"},
+		}, &gitlab.Response{}, nil)
+	tc.MockGroupMilestones.EXPECT().
+		GetGroupMilestoneMergeRequests("example-group", int64(7), gomock.Any()).
+		Return([]*gitlab.BasicMergeRequest{{IID: 1, State: "merged"}}, &gitlab.Response{}, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdReport, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	out, err := exec(`"Sprint 1" --group example-group --output markdown`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "## Milestone [Sprint 1](https://gitlab.com/groups/example-group/-/milestones/1)\n\n"+
+		"**State:** closed, 2025-01-06 → 2025-01-07\n\n"+
+		"| | Open | Closed | Total |\n"+
+		"|---|---|---|---|\n"+
+		"| Issues | 1 | 1 | 2 |\n"+
+		"| Merge requests | 0 | 1 (1 merged) | 1 |\n\n"+
+		"- **Weight:** 2 remaining of 4\n"+
+		"- **Time:** no time tracked\n\n"+
+		"### Burndown\n\n"+
+		"```plaintext\n"+
+		"Burndown (remaining weight, | is ideal):\n"+
+		"  2025-01-06 ████████████████████                   | 2\n"+
+		"  2025-01-07 ████████████████████                     2\n"+
+		"```\n\n"+
+		"### Overdue\n\n"+
+		"- [#2](https://gitlab.com/example-group/project/-/issues/2) Leftover (due 2025-01-07)\n",
+		out.String())
+}
+
+func TestMilestoneReport_notFound(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockMilestones.EXPECT().
+		ListMilestones("OWNER/REPO", gomock.Any()).
+		Return([]*gitlab.Milestone{}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdReport, false,
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(tc.Client))),
+	)
+
+	_, err := exec("nope")
+	require.EqualError(t, err, `milestone "nope" not found in project OWNER/REPO.`)
+}
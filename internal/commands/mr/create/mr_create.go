@@ -61,6 +61,7 @@ type options struct {
 	web           bool
 	recover       bool
 	signoff       bool
+	template      string
 
 	io              *iostreams.IOStreams             `json:"-"`
 	branch          func() (string, error)           `json:"-"`
@@ -103,6 +104,7 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 			$ glab mr create -f --draft --label RFC
 			$ glab mr create --fill --web
 			$ glab mr create --fill --fill-commit-body --yes
+			$ glab mr create --template Default -t "fix annoying bug"
 		`),
 		Args: cobra.ExactArgs(0),
 		PreRun: func(cmd *cobra.Command, args []string) {
@@ -163,6 +165,9 @@ func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
 	mrCreateCmd.Flags().StringVarP(&opts.RelatedIssue, "related-issue", "i", "", "Create a merge request for an issue. If --title is not provided, uses the issue title.")
 	mrCreateCmd.Flags().BoolVar(&opts.recover, "recover", false, "Save the options to a file if the merge request creation fails. If the file exists, the options are loaded from the recovery file. (EXPERIMENTAL)")
 	mrCreateCmd.Flags().BoolVar(&opts.signoff, "signoff", false, "Append a DCO signoff to the merge request description.")
+	mrCreateCmd.Flags().StringVar(&opts.template, "template", "", "Use the description template with this `name`, from '.gitlab/merge_request_templates' or the project templates. Quick actions in the template set labels, assignees, reviewers, and milestone.")
+	mrCreateCmd.MarkFlagsMutuallyExclusive("template", "description")
+	mrCreateCmd.MarkFlagsMutuallyExclusive("template", "fill")
 
 	mrCreateCmd.Flags().StringVarP(&opts.MRCreateTargetProject, "target-project", "", "", "Add target project by id, OWNER/REPO, or GROUP/NAMESPACE/REPO.")
 	_ = mrCreateCmd.Flags().MarkHidden("target-project")
@@ -176,7 +181,7 @@ func (o *options) complete(cmd *cobra.Command) {
 	hasDescription := cmd.Flags().Changed("description")
 
 	// disable interactive mode if title and description are explicitly defined
-	o.isInteractive = !(hasTitle && hasDescription)
+	o.isInteractive = !(hasTitle && (hasDescription || o.template != ""))
 }
 
 func (o *options) validate(cmd *cobra.Command) error {
@@ -210,6 +215,28 @@ func (o *options) validate(cmd *cobra.Command) error {
 	return nil
 }
 
+// applyQuickActions moves the quick actions of the description that glab can apply to the options.
+// A milestone set with --milestone takes precedence over the milestone of the description.
+func (o *options) applyQuickActions(client *gitlab.Client, repo glrepo.Interface) error {
+	var actions cmdutils.QuickActions
+	o.Description, actions = cmdutils.ExtractQuickActions(o.Description, cmdutils.QuickActionsForMergeRequest)
+
+	o.Labels = append(o.Labels, actions.Labels...)
+	o.Assignees = append(o.Assignees, actions.Assignees...)
+	o.Reviewers = append(o.Reviewers, actions.Reviewers...)
+
+	if actions.Milestone != "" && o.MilestoneFlag == "" {
+		milestone, err := cmdutils.ParseMilestone(client, repo, actions.Milestone)
+		if err != nil {
+			return err
+		}
+		o.MilestoneFlag = actions.Milestone
+		o.Milestone = milestone
+	}
+
+	return nil
+}
+
 func parseIssue(apiClientFunc func(repoHost string) (*api.Client, error), gitlabClient *gitlab.Client, opts *options) (*gitlab.Issue, error) {
 	issue, _, err := issueutils.IssueFromArg(apiClientFunc, gitlabClient, opts.baseRepo, opts.defaultHostname, opts.RelatedIssue)
 	if err != nil {
@@ -330,6 +357,23 @@ func (o *options) run(ctx context.Context) error {
 		o.TargetBranch = getTargetBranch(baseRepoRemote)
 	}
 
+	var templateName string
+	var templateContents string
+	fromTemplate := o.template != ""
+	templates := cmdutils.NewTemplateSource(client, baseRepo, o.remotes)
+	if fromTemplate {
+		templateName = o.template
+		templateContents, err = templates.Load(cmdutils.MergeRequestTemplate, templateName)
+		if err != nil {
+			return err
+		}
+
+		// merge requests for an issue don't prompt for a description
+		if !o.isInteractive || o.RelatedIssue != "" {
+			o.Description = templateContents
+		}
+	}
+
 	if o.RelatedIssue != "" {
 		issue, err := parseIssue(o.apiClient, client, o)
 		if err != nil {
@@ -400,16 +444,14 @@ func (o *options) run(ctx context.Context) error {
 
 			o.ShouldPush = true
 		} else if o.isInteractive {
-			var templateName string
-			var templateContents string
-			if o.Description == "" {
+			if o.Description == "" && templateName == "" {
 				if o.noEditor {
 					err = o.io.Multiline(ctx, &o.Description, "Description:", "")
 					if err != nil {
 						return err
 					}
 				} else {
-					templateNames, err := cmdutils.ListGitLabTemplates(cmdutils.MergeRequestTemplate)
+					templateNames, err := templates.List(cmdutils.MergeRequestTemplate)
 					if err != nil {
 						return fmt.Errorf("error getting templates: %w", err)
 					}
@@ -445,7 +487,8 @@ func (o *options) run(ctx context.Context) error {
 							templateContents += "Signed-off-by: " + u.Name + "<" + u.Email + ">"
 						}
 					default:
-						templateContents, err = cmdutils.LoadGitLabTemplate(cmdutils.MergeRequestTemplate, templateName)
+						fromTemplate = true
+						templateContents, err = templates.Load(cmdutils.MergeRequestTemplate, templateName)
 						if err != nil {
 							return fmt.Errorf("failed to get template contents: %w", err)
 						}
@@ -471,6 +514,10 @@ func (o *options) run(ctx context.Context) error {
 			}
 
 			if needsDescription {
+				if templateContents != "" {
+					o.Description = templateContents
+				}
+
 				if o.noEditor {
 					fields = append(fields, huh.NewText().
 						Title("Description").
@@ -481,10 +528,6 @@ func (o *options) run(ctx context.Context) error {
 						return err
 					}
 
-					if templateContents != "" {
-						o.Description = templateContents
-					}
-
 					textField := huh.NewText().
 						Title("Description").
 						Value(&o.Description).
@@ -509,6 +552,12 @@ func (o *options) run(ctx context.Context) error {
 		}
 	}
 
+	if fromTemplate {
+		if err := o.applyQuickActions(client, baseRepo); err != nil {
+			return err
+		}
+	}
+
 	if o.Title == "" {
 		return fmt.Errorf("title can't be blank.")
 	}
@@ -300,6 +300,11 @@ func TestNewCmdCreate_TemplateFromCommitMessages(t *testing.T) {
 			}, nil, nil
 		})
 
+	// The checkout has no templates, so they are listed through the API
+	testClient.MockProjectTemplates.EXPECT().
+		ListTemplates("OWNER/REPO", "merge_requests", gomock.Any()).
+		Return([]*gitlab.ProjectTemplate{}, &gitlab.Response{}, nil)
+
 	cs, csTeardown := test.InitCmdStubber()
 	defer csTeardown()
 
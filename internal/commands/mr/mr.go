@@ -25,6 +25,7 @@ import (
 	mrUnsubscribeCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/unsubscribe"
 	mrUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/update"
 	mrViewCmd "gitlab.com/gitlab-org/cli/internal/commands/mr/view"
+	"gitlab.com/gitlab-org/cli/internal/commands/timetracking"
 )
 
 func NewCmdMR(f cmdutils.Factory) *cobra.Command {
@@ -65,6 +66,7 @@ func NewCmdMR(f cmdutils.Factory) *cobra.Command {
 	mrCmd.AddCommand(mrReopenCmd.NewCmdReopen(f))
 	mrCmd.AddCommand(mrRevokeCmd.NewCmdRevoke(f))
 	mrCmd.AddCommand(mrSubscribeCmd.NewCmdSubscribe(f))
+	mrCmd.AddCommand(timetracking.NewCmdTime(f, timetracking.MergeRequest))
 	mrCmd.AddCommand(mrUnsubscribeCmd.NewCmdUnsubscribe(f))
 	mrCmd.AddCommand(mrTodoCmd.NewCmdTodo(f))
 	mrCmd.AddCommand(mrUpdateCmd.NewCmdUpdate(f))
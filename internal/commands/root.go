@@ -19,6 +19,7 @@ import (
 	configCmd "gitlab.com/gitlab-org/cli/internal/commands/config"
 	deployKeyCmd "gitlab.com/gitlab-org/cli/internal/commands/deploy-key"
 	duoCmd "gitlab.com/gitlab-org/cli/internal/commands/duo"
+	epicCmd "gitlab.com/gitlab-org/cli/internal/commands/epic"
 	gpgCmd "gitlab.com/gitlab-org/cli/internal/commands/gpg-key"
 	"gitlab.com/gitlab-org/cli/internal/commands/help"
 	incidentCmd "gitlab.com/gitlab-org/cli/internal/commands/incident"
@@ -37,11 +38,14 @@ import (
 	snippetCmd "gitlab.com/gitlab-org/cli/internal/commands/snippet"
 	sshCmd "gitlab.com/gitlab-org/cli/internal/commands/ssh-key"
 	stackCmd "gitlab.com/gitlab-org/cli/internal/commands/stack"
+	statusCmd "gitlab.com/gitlab-org/cli/internal/commands/status"
+	todoCmd "gitlab.com/gitlab-org/cli/internal/commands/todo"
 	tokenCmd "gitlab.com/gitlab-org/cli/internal/commands/token"
 	updateCmd "gitlab.com/gitlab-org/cli/internal/commands/update"
 	userCmd "gitlab.com/gitlab-org/cli/internal/commands/user"
 	variableCmd "gitlab.com/gitlab-org/cli/internal/commands/variable"
 	versionCmd "gitlab.com/gitlab-org/cli/internal/commands/version"
+	workItemCmd "gitlab.com/gitlab-org/cli/internal/commands/work-item"
 )
 
 // NewCmdRoot is the main root/parent command
@@ -80,6 +84,10 @@ func NewCmdRoot(f cmdutils.Factory) *cobra.Command {
 
 			GLAB_DEBUG_HTTP: Set to true to output HTTP transport information (request / response).
 
+			GLAB_PROFILE: The name of the authentication profile to use for every host. Overrides the
+			profile selected with 'glab auth switch' and the 'profile' key of the local configuration.
+			Can be overridden for a single command with the %[1]s--profile%[1]s flag.
+
 			GLAB_SEND_TELEMETRY: Set to false to disable telemetry being sent to your GitLab instance.
 			Can be set in the config with 'glab config set telemetry false'.
 			See https://docs.gitlab.com/administration/settings/usage_statistics/ for more information
@@ -149,6 +157,7 @@ func NewCmdRoot(f cmdutils.Factory) *cobra.Command {
 	rootCmd.AddCommand(clusterCmd.NewCmdCluster(f))
 	rootCmd.AddCommand(deployKeyCmd.NewCmdDeployKey(f))
 	rootCmd.AddCommand(duoCmd.NewCmdDuo(f))
+	rootCmd.AddCommand(epicCmd.NewCmdEpic(f))
 	rootCmd.AddCommand(gpgCmd.NewCmdGPGKey(f))
 	rootCmd.AddCommand(incidentCmd.NewCmdIncident(f))
 	rootCmd.AddCommand(issueCmd.NewCmdIssue(f))
@@ -168,14 +177,18 @@ func NewCmdRoot(f cmdutils.Factory) *cobra.Command {
 	rootCmd.AddCommand(snippetCmd.NewCmdSnippet(f))
 	rootCmd.AddCommand(sshCmd.NewCmdSSHKey(f))
 	rootCmd.AddCommand(stackCmd.NewCmdStack(f))
+	rootCmd.AddCommand(statusCmd.NewCmdStatus(f))
+	rootCmd.AddCommand(todoCmd.NewCmdTodo(f))
 	rootCmd.AddCommand(tokenCmd.NewTokenCmd(f))
 	rootCmd.AddCommand(userCmd.NewCmdUser(f))
 	rootCmd.AddCommand(variableCmd.NewVariableCmd(f))
+	rootCmd.AddCommand(workItemCmd.NewCmdWorkItem(f))
 
 	// TODO: This can probably be removed by GitLab 18.3
 	// See: https://gitlab.com/gitlab-org/cli/-/issues/7885
 	// Add global repo override flag but keep it hidden
 	cmdutils.AddGlobalRepoOverride(rootCmd, f)
+	cmdutils.AddGlobalProfileFlag(rootCmd)
 
 	rootCmd.Flags().BoolP("version", "v", false, "show glab version information")
 	return rootCmd
@@ -0,0 +1,77 @@
+package export
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/text"
+)
+
+func NewCmdExportStack(f cmdutils.Factory, gr git.GitRunner) *cobra.Command {
+	var push bool
+
+	cmd := &cobra.Command{
+		Use:   "export [flags]",
+		Short: "Export the metadata of the current stack, to share it with others. (EXPERIMENTAL)",
+		Long: heredoc.Docf(`
+			Export the metadata of the current stack: its title, base branch, and the
+			branch, description, and merge request of each entry, in order.
+
+			By default, the metadata is printed as JSON. Import it on another machine
+			with %[1]sglab stack import --from-file%[1]s.
+
+			With %[1]s--push%[1]s, the metadata is committed to the Git ref %[1]s%[2]s<title>%[1]s
+			and pushed to the remote. Others can then import it with
+			%[1]sglab stack import --from-ref <title>%[1]s, and push their changes the same way.
+		`, "`", git.StackMetadataRefPrefix) + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			$ glab stack export > my-stack.json
+			$ glab stack export --push
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			title, err := git.GetCurrentStackTitle()
+			if err != nil {
+				return fmt.Errorf("error getting current stack: %w", err)
+			}
+
+			stack, err := git.GatherStackRefs(title)
+			if err != nil {
+				return fmt.Errorf("error getting current stack references: %w", err)
+			}
+			if stack.Empty() {
+				return errors.New("you are on an empty stack. To use a stack, first save a diff.")
+			}
+
+			exported, err := git.ExportStack(&stack, gr)
+			if err != nil {
+				return err
+			}
+
+			if !push {
+				return f.IO().PrintJSON(exported)
+			}
+
+			if err := git.PushStackMetadata(exported, git.DefaultRemote); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(f.IO().StdOut, "%s Pushed stack %q to %s on %s.\n",
+				f.IO().Color().GreenCheck(), title, git.StackMetadataRef(title), git.DefaultRemote)
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&push, "push", false, "Push the metadata to the remote, instead of printing it.")
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+//go:build !integration
+
+package export
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestExportStack(t *testing.T) {
+	git.InitGitRepoWithCommit(t)
+	require.NoError(t, git.SetLocalConfig("glab.currentstack", "cool-stack"))
+
+	refs := map[string]git.StackRef{
+		"11111111": {SHA: "11111111", Next: "22222222", Branch: "Branch1", Description: "First"},
+		"22222222": {SHA: "22222222", Prev: "11111111", Branch: "Branch2", Description: "Second"},
+	}
+	require.NoError(t, git.CreateRefFiles(refs, "cool-stack"))
+	require.NoError(t, git.AddStackBaseBranch("cool-stack", "main"))
+
+	exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+		return NewCmdExportStack(f, git.StandardGitCommand{})
+	}, false)
+
+	out, err := exec("")
+	require.NoError(t, err)
+
+	exported, err := git.ParseExportedStack(out.OutBuf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, "cool-stack", exported.Title)
+	assert.Equal(t, "main", exported.Base)
+	assert.Equal(t, []string{"Branch1", "Branch2"}, exported.Branches())
+
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &raw))
+	assert.Contains(t, raw, "refs")
+}
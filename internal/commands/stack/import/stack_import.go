@@ -0,0 +1,687 @@
+package stackimport
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/sha3"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/text"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+const (
+	detectAncestry = "ancestry"
+	detectMR       = "mr"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	gr           git.GitRunner
+
+	branch   string
+	title    string
+	base     string
+	detect   string
+	fromMR   int64
+	fromRef  string
+	fromFile string
+}
+
+// entry is a branch of the imported chain.
+type entry struct {
+	branch string
+	mr     *gitlab.BasicMergeRequest
+}
+
+func NewCmdImportStack(f cmdutils.Factory, gr git.GitRunner) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		gr:           gr,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "import [<branch>] [flags]",
+		Short: "Import an existing chain of branches as a stack. (EXPERIMENTAL)",
+		Long: heredoc.Docf(`
+			Import an existing chain of branches, such as feat-1 → feat-2 → feat-3, as a new stack.
+
+			The chain ends at the given branch, or the current branch, and is followed down to the base branch:
+
+			- %[1]sancestry%[1]s: The parent of each branch is the closest local branch that the branch is based on.
+			- %[1]smr%[1]s: The parent of each branch is the target branch of its open merge request.
+
+			Open merge requests of the branches are linked to the stack entries, so %[1]sglab stack sync%[1]s
+			updates them instead of creating new ones. The imported stack becomes the current stack.
+
+			To pick up a stack that someone else created, use one of these instead:
+
+			- %[1]s--from-mr%[1]s: Rebuild the stack from the open merge requests around the given merge request,
+			  following their source and target branches.
+			- %[1]s--from-ref%[1]s: Fetch the stack metadata that was pushed with %[1]sglab stack export --push%[1]s.
+			  If the stack exists locally, its metadata is replaced, so the same stack can be shared by several people.
+			- %[1]s--from-file%[1]s: Read the stack metadata printed by %[1]sglab stack export%[1]s.
+
+			Branches that don't exist locally are fetched from the remote.
+		`, "`") + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			# Import the chain of branches that ends at the current branch
+			$ glab stack import
+
+			# Import the chain that ends at feat-3, following merge request target branches
+			$ glab stack import feat-3 --detect mr --title my-feature
+
+			# Import a chain based on the develop branch
+			$ glab stack import feat-3 --base develop
+
+			# Pick up the stack that merge request !42 belongs to
+			$ glab stack import --from-mr 42
+
+			# Pick up a stack that was pushed with glab stack export --push
+			$ glab stack import --from-ref my-feature
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 1 {
+				opts.branch = args[0]
+			}
+
+			if opts.fromMR != 0 || opts.fromRef != "" || opts.fromFile != "" {
+				if opts.branch != "" || cmd.Flags().Changed("detect") {
+					return &cmdutils.FlagError{Err: errors.New("a branch or --detect can't be used with --from-mr, --from-ref, or --from-file.")}
+				}
+			}
+
+			if opts.fromRef != "" || opts.fromFile != "" {
+				if cmd.Flags().Changed("base") {
+					return &cmdutils.FlagError{Err: errors.New("--base can't be used with --from-ref or --from-file.")}
+				}
+				return opts.runExported()
+			}
+
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.title, "title", "t", "", "Title of the new stack. Defaults to the name of the last branch.")
+	cmd.Flags().StringVarP(&opts.base, "base", "b", "", "Branch that the first branch of the chain is based on. Defaults to the default branch of the remote.")
+	cmd.Flags().VarP(cmdutils.NewEnumValue([]string{detectAncestry, detectMR}, detectAncestry, &opts.detect), "detect", "d", "How to find the parent of each branch: ancestry, mr.")
+	cmd.Flags().Int64Var(&opts.fromMR, "from-mr", 0, "Rebuild the stack that the merge request with this ID belongs to.")
+	cmd.Flags().StringVar(&opts.fromRef, "from-ref", "", "Fetch the metadata of the stack with this title from the remote.")
+	cmd.Flags().StringVar(&opts.fromFile, "from-file", "", "Read the stack metadata from a file exported with glab stack export. Use - for standard input.")
+	cmd.MarkFlagsMutuallyExclusive("from-mr", "from-ref", "from-file")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	var err error
+
+	if o.branch == "" && o.fromMR == 0 {
+		o.branch, err = git.CurrentBranch()
+		if err != nil {
+			return fmt.Errorf("error getting current branch: %w", err)
+		}
+	}
+
+	if o.base == "" && o.detect == detectAncestry && o.fromMR == 0 {
+		o.base, err = defaultBranch(o.gr)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the title of a stack imported from a merge request is known only after the chain is found
+	if o.title == "" {
+		o.title = o.branch
+	}
+	if o.title != "" {
+		if err := checkNewTitle(o.title); err != nil {
+			return err
+		}
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return fmt.Errorf("error connecting to GitLab: %w", err)
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return fmt.Errorf("error determining base repo: %w", err)
+	}
+	openMR := func(branch string) (*gitlab.BasicMergeRequest, error) {
+		return openMergeRequest(client, repo.FullName(), branch)
+	}
+
+	var chain []entry
+	switch {
+	case o.fromMR != 0:
+		chain, err = o.chainFromMR(client, repo.FullName(), openMR)
+	case o.detect == detectMR:
+		chain, o.base, err = chainFromMRs(o.branch, o.base, openMR)
+	default:
+		chain, err = chainFromAncestry(o.branch, o.base, o.gr)
+		if err == nil {
+			err = linkMRs(chain, openMR)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if o.title == "" {
+		o.title = chain[len(chain)-1].branch
+		if err := checkNewTitle(o.title); err != nil {
+			return err
+		}
+	}
+	title := utils.ReplaceNonAlphaNumericChars(o.title, "-")
+
+	refs, err := o.refsFromChain(chain)
+	if err != nil {
+		return err
+	}
+
+	stack, err := o.writeStack(title, refs)
+	if err != nil {
+		return err
+	}
+
+	return o.finish(stack)
+}
+
+// checkNewTitle returns an error if a stack with the title already exists.
+func checkNewTitle(title string) error {
+	title = utils.ReplaceNonAlphaNumericChars(title, "-")
+
+	existing, err := git.GatherStackRefs(title)
+	if err != nil {
+		return fmt.Errorf("error reading stack %q: %w", title, err)
+	}
+	if !existing.Empty() {
+		return fmt.Errorf("a stack with the title %q already exists. Use --title to choose another title.", title)
+	}
+
+	return nil
+}
+
+// runExported imports a stack from metadata exported with glab stack export.
+func (o *options) runExported() error {
+	var exported git.ExportedStack
+	var err error
+
+	if o.fromRef != "" {
+		exported, err = git.FetchStackMetadata(o.fromRef, git.DefaultRemote)
+	} else {
+		var data []byte
+		if o.fromFile == "-" {
+			data, err = io.ReadAll(o.io.In)
+		} else {
+			data, err = os.ReadFile(o.fromFile)
+		}
+		if err != nil {
+			return fmt.Errorf("error reading %s: %w", o.fromFile, err)
+		}
+		exported, err = git.ParseExportedStack(data)
+	}
+	if err != nil {
+		return err
+	}
+
+	title := exported.Title
+	if o.title != "" {
+		title = utils.ReplaceNonAlphaNumericChars(o.title, "-")
+	}
+	if err := checkBranchName(exported.Base, o.gr); err != nil {
+		return err
+	}
+	o.base = exported.Base
+
+	existing, err := git.GatherStackRefs(title)
+	if err != nil {
+		return fmt.Errorf("error reading stack %q: %w", title, err)
+	}
+	if existing.Empty() {
+		if err := fetchMissingBranches(exported.Branches(), o.gr); err != nil {
+			return err
+		}
+
+		stack, err := o.writeStack(title, exported.Refs)
+		if err != nil {
+			return err
+		}
+
+		return o.finish(stack)
+	}
+
+	// a stack shared through the remote is updated in place
+	if o.fromRef == "" {
+		return fmt.Errorf("a stack with the title %q already exists. Use --title to choose another title.", title)
+	}
+	if git.RestackInProgress(title) {
+		return fmt.Errorf("a restack of stack %q is in progress. Run `glab stack continue` or `glab stack abort` first.", title)
+	}
+
+	if err := fetchMissingBranches(exported.Branches(), o.gr); err != nil {
+		return err
+	}
+
+	stack, err := o.replaceStack(title, exported.Refs)
+	if err != nil {
+		return err
+	}
+
+	return o.finish(stack)
+}
+
+// replaceStack replaces the metadata of an existing stack. The new metadata is written
+// next to the old one first, so the old metadata is kept if the new one can't be written.
+func (o *options) replaceStack(title string, refs []git.StackRef) (git.Stack, error) {
+	dir, err := git.StackRootDir(title)
+	if err != nil {
+		return git.Stack{}, err
+	}
+
+	// the dots keep the temporary directory apart from other stacks, whose titles can't contain them
+	tmp, err := os.MkdirTemp(filepath.Dir(dir), "."+title+".import-*")
+	if err != nil {
+		return git.Stack{}, fmt.Errorf("error adding stack metadata directory: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+
+	if _, err := o.writeStack(filepath.Base(tmp), refs); err != nil {
+		return git.Stack{}, err
+	}
+
+	old := tmp + ".old"
+	if err := os.Rename(dir, old); err != nil {
+		return git.Stack{}, fmt.Errorf("error replacing metadata of stack %q: %w", title, err)
+	}
+	if err := os.Rename(tmp, dir); err != nil {
+		_ = os.Rename(old, dir)
+		return git.Stack{}, fmt.Errorf("error replacing metadata of stack %q: %w", title, err)
+	}
+	_ = os.RemoveAll(old)
+
+	stack, err := git.GatherStackRefs(title)
+	if err != nil {
+		return git.Stack{}, fmt.Errorf("error validating imported stack: %w", err)
+	}
+
+	return stack, nil
+}
+
+// finish makes the imported stack the current stack, and prints it.
+func (o *options) finish(stack git.Stack) error {
+	err := git.SetLocalConfig("glab.currentstack", stack.Title)
+	if err != nil {
+		return fmt.Errorf("error setting local Git config: %w", err)
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "%s Imported %d branches into stack %q, based on %s:\n", c.GreenCheck(), len(stack.Refs), stack.Title, o.base)
+	for ref := range stack.Iter() {
+		mr := ""
+		if ref.MR != "" {
+			mr = " " + c.Gray(ref.MR)
+		}
+		fmt.Fprintf(o.io.StdOut, "  %s - %s%s\n", ref.Branch, c.Cyan(ref.Subject()), mr)
+	}
+
+	return nil
+}
+
+// chainFromMR finds the chain of open merge requests that the merge request belongs to.
+// It follows the target branches down to the base branch, and the merge requests that
+// target the source branches up to the last merge request. Missing branches are fetched.
+func (o *options) chainFromMR(client *gitlab.Client, project string, openMR func(string) (*gitlab.BasicMergeRequest, error)) ([]entry, error) {
+	mr, _, err := client.MergeRequests.GetMergeRequest(project, o.fromMR, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error getting merge request !%d: %w", o.fromMR, err)
+	}
+	if mr.State != "opened" {
+		return nil, fmt.Errorf("merge request !%d is %s. Import the stack from an open merge request.", o.fromMR, mr.State)
+	}
+
+	chain, base, err := chainFromMRs(mr.SourceBranch, o.base, openMR)
+	if err != nil {
+		return nil, err
+	}
+	o.base = base
+
+	visited := map[string]bool{}
+	for _, e := range chain {
+		visited[e.branch] = true
+	}
+
+	for current := mr.SourceBranch; ; {
+		mrs, _, err := client.MergeRequests.ListProjectMergeRequests(project, &gitlab.ListProjectMergeRequestsOptions{
+			TargetBranch: gitlab.Ptr(current),
+			State:        gitlab.Ptr("opened"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error getting merge requests that target branch %s: %w", current, err)
+		}
+		if len(mrs) == 0 {
+			break
+		}
+		if len(mrs) > 1 {
+			return nil, fmt.Errorf("more than one open merge request targets branch %s. Import the stack from its last merge request instead.", current)
+		}
+		if visited[mrs[0].SourceBranch] {
+			return nil, fmt.Errorf("merge requests of branch %s form a cycle.", mrs[0].SourceBranch)
+		}
+
+		chain = append(chain, entry{branch: mrs[0].SourceBranch, mr: mrs[0]})
+		visited[mrs[0].SourceBranch] = true
+		current = mrs[0].SourceBranch
+	}
+
+	branches := make([]string, len(chain))
+	for i, e := range chain {
+		branches[i] = e.branch
+	}
+	if err := fetchMissingBranches(branches, o.gr); err != nil {
+		return nil, err
+	}
+
+	return chain, nil
+}
+
+// fetchMissingBranches creates local branches that track the remote branches, for the branches that don't exist locally.
+// The names come from imported metadata or merge requests, so they're checked before they're passed to Git.
+func fetchMissingBranches(branches []string, gr git.GitRunner) error {
+	for _, branch := range branches {
+		if err := checkBranchName(branch, gr); err != nil {
+			return err
+		}
+
+		if _, err := gr.Git("rev-parse", "--verify", "--quiet", "refs/heads/"+branch); err == nil {
+			continue
+		}
+
+		if _, err := gr.Git("fetch", git.DefaultRemote, branch); err != nil {
+			return fmt.Errorf("error fetching branch %s from %s: %w", branch, git.DefaultRemote, err)
+		}
+		if _, err := gr.Git("branch", "--track", branch, git.DefaultRemote+"/"+branch); err != nil {
+			return fmt.Errorf("error creating branch %s: %w", branch, err)
+		}
+	}
+
+	return nil
+}
+
+// checkBranchName returns an error if the branch name from imported metadata is not a valid branch name.
+func checkBranchName(branch string, gr git.GitRunner) error {
+	if strings.HasPrefix(branch, "-") {
+		return fmt.Errorf("invalid branch name %q.", branch)
+	}
+	if _, err := gr.Git("check-ref-format", "--branch", branch); err != nil {
+		return fmt.Errorf("invalid branch name %q.", branch)
+	}
+
+	return nil
+}
+
+// writeStack writes the stack metadata, and checks that the stack can be read back in the same order.
+func (o *options) writeStack(title string, refs []git.StackRef) (git.Stack, error) {
+	dir, err := git.AddStackRefDir(title)
+	if err != nil {
+		return git.Stack{}, fmt.Errorf("error adding stack metadata directory: %w", err)
+	}
+
+	stack, err := o.writeRefs(title, refs)
+	if err != nil {
+		// don't leave a half-imported stack behind
+		_ = os.RemoveAll(dir)
+		return git.Stack{}, err
+	}
+
+	return stack, nil
+}
+
+func (o *options) writeRefs(title string, refs []git.StackRef) (git.Stack, error) {
+	if err := git.AddStackBaseBranch(title, o.base); err != nil {
+		return git.Stack{}, fmt.Errorf("error adding base branch to metadata: %w", err)
+	}
+
+	branches := make([]string, len(refs))
+	for i := range refs {
+		if err := git.AddStackRefFile(title, refs[i]); err != nil {
+			return git.Stack{}, fmt.Errorf("error creating stack file: %w", err)
+		}
+		branches[i] = refs[i].Branch
+	}
+
+	stack, err := git.GatherStackRefs(title)
+	if err != nil {
+		return git.Stack{}, fmt.Errorf("error validating imported stack: %w", err)
+	}
+
+	if !slices.Equal(stack.Branches(), branches) {
+		return git.Stack{}, fmt.Errorf("imported stack is inconsistent: expected branches %s, got %s",
+			strings.Join(branches, ", "), strings.Join(stack.Branches(), ", "))
+	}
+
+	return stack, nil
+}
+
+// refsFromChain creates linked stack refs for the branches of the chain.
+// The ID of each ref is based on its branch and commit, so branches on the same commit get different IDs.
+func (o *options) refsFromChain(chain []entry) ([]git.StackRef, error) {
+	refs := make([]git.StackRef, len(chain))
+	for i, e := range chain {
+		sha, err := o.gr.Git("rev-parse", e.branch)
+		if err != nil {
+			return nil, fmt.Errorf("error getting commit of branch %s: %w", e.branch, err)
+		}
+		sha = strings.TrimSpace(sha)
+
+		description, err := o.gr.Git("log", "-1", "--format=%B", e.branch)
+		if err != nil {
+			return nil, fmt.Errorf("error getting commit message of branch %s: %w", e.branch, err)
+		}
+
+		id, err := stackRefID(e.branch, sha)
+		if err != nil {
+			return nil, err
+		}
+
+		refs[i] = git.StackRef{
+			Branch:      e.branch,
+			SHA:         id,
+			Description: strings.TrimSpace(description),
+		}
+		if e.mr != nil {
+			refs[i].MR = e.mr.WebURL
+		}
+	}
+
+	for i := range refs {
+		if i > 0 {
+			refs[i].Prev = refs[i-1].SHA
+		}
+		if i < len(refs)-1 {
+			refs[i].Next = refs[i+1].SHA
+		}
+	}
+
+	return refs, nil
+}
+
+// stackRefID hashes the branch and its commit into an ID in the format of `glab stack save`.
+func stackRefID(branch, sha string) (string, error) {
+	hashData := make([]byte, 4)
+
+	shakeHash := sha3.NewShake256()
+	shakeHash.Write([]byte(branch + sha))
+	if _, err := shakeHash.Read(hashData); err != nil {
+		return "", fmt.Errorf("error generating hash for stack branch: %v", err)
+	}
+
+	return hex.EncodeToString(hashData), nil
+}
+
+// chainFromAncestry walks down from the branch to the base branch. The parent of a branch
+// is the closest local branch whose tip is an ancestor of the branch.
+// The chain is returned in stack order, from the first to the last branch.
+func chainFromAncestry(branch, base string, gr git.GitRunner) ([]entry, error) {
+	output, err := gr.Git("for-each-ref", "--format=%(refname:short)", "refs/heads/")
+	if err != nil {
+		return nil, fmt.Errorf("error listing branches: %w", err)
+	}
+	branches := strings.Fields(output)
+
+	if branch == base {
+		return nil, fmt.Errorf("%s is the base branch. Check out the last branch of the chain, or pass it as an argument.", branch)
+	}
+
+	chain := []entry{{branch: branch}}
+	visited := map[string]bool{branch: true}
+
+	for current := branch; ; {
+		parent := ""
+		distance := -1
+
+		for _, candidate := range branches {
+			if visited[candidate] {
+				continue
+			}
+
+			// exits with a non-zero status if the candidate is not an ancestor
+			if _, err := gr.Git("merge-base", "--is-ancestor", candidate, current); err != nil {
+				continue
+			}
+
+			count, err := gr.Git("rev-list", "--count", candidate+".."+current)
+			if err != nil {
+				return nil, fmt.Errorf("error comparing %s and %s: %w", candidate, current, err)
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(count))
+			if err != nil {
+				return nil, fmt.Errorf("error comparing %s and %s: %w", candidate, current, err)
+			}
+
+			// branches that point to the same commit are not a separate entry of the chain
+			if n > 0 && (distance < 0 || n < distance || (n == distance && candidate == base)) {
+				parent, distance = candidate, n
+			}
+		}
+
+		if parent == "" || parent == base {
+			break
+		}
+
+		chain = append(chain, entry{branch: parent})
+		visited[parent] = true
+		current = parent
+	}
+
+	slices.Reverse(chain)
+	return chain, nil
+}
+
+// chainFromMRs walks down from the branch by following the target branches of open merge requests.
+// The walk stops at the base branch or, if no base branch is given, at the first target branch
+// without an open merge request, which becomes the base branch.
+func chainFromMRs(branch, base string, openMR func(string) (*gitlab.BasicMergeRequest, error)) ([]entry, string, error) {
+	var chain []entry
+	visited := map[string]bool{}
+
+	for current := branch; ; {
+		mr, err := openMR(current)
+		if err != nil {
+			return nil, "", err
+		}
+		if mr == nil {
+			if len(chain) == 0 {
+				return nil, "", fmt.Errorf("branch %s has no open merge request.", current)
+			}
+			if base != "" && current != base {
+				return nil, "", fmt.Errorf("branch %s has no open merge request, and is not the base branch %s.", current, base)
+			}
+			base = current
+			break
+		}
+
+		chain = append(chain, entry{branch: current, mr: mr})
+		visited[current] = true
+
+		if mr.TargetBranch == base {
+			break
+		}
+		if visited[mr.TargetBranch] {
+			return nil, "", fmt.Errorf("merge requests of branch %s form a cycle.", mr.TargetBranch)
+		}
+		current = mr.TargetBranch
+	}
+
+	slices.Reverse(chain)
+	return chain, base, nil
+}
+
+// linkMRs adds the open merge request of each branch to the chain.
+func linkMRs(chain []entry, openMR func(string) (*gitlab.BasicMergeRequest, error)) error {
+	for i := range chain {
+		mr, err := openMR(chain[i].branch)
+		if err != nil {
+			return err
+		}
+		chain[i].mr = mr
+	}
+
+	return nil
+}
+
+// openMergeRequest returns the open merge request with the branch as source branch, or nil if there is none.
+func openMergeRequest(client *gitlab.Client, project, branch string) (*gitlab.BasicMergeRequest, error) {
+	mrs, _, err := client.MergeRequests.ListProjectMergeRequests(project, &gitlab.ListProjectMergeRequestsOptions{
+		SourceBranch: gitlab.Ptr(branch),
+		State:        gitlab.Ptr("opened"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting merge requests of branch %s: %w", branch, err)
+	}
+
+	switch len(mrs) {
+	case 0:
+		return nil, nil
+	case 1:
+		return mrs[0], nil
+	default:
+		return nil, fmt.Errorf("branch %s has more than one open merge request.", branch)
+	}
+}
+
+// defaultBranch returns the default branch of the remote, as known locally.
+func defaultBranch(gr git.GitRunner) (string, error) {
+	output, err := gr.Git("symbolic-ref", "--quiet", "--short", "refs/remotes/"+git.DefaultRemote+"/HEAD")
+	if err != nil {
+		return "", errors.New("could not determine the default branch of the remote. Use --base to set the base branch.")
+	}
+
+	return strings.TrimPrefix(strings.TrimSpace(output), git.DefaultRemote+"/"), nil
+}
@@ -0,0 +1,325 @@
+//go:build !integration
+
+package stackimport
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/run"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func runGit(t *testing.T, args ...string) {
+	t.Helper()
+
+	_, err := run.PrepareCmd(git.GitCommand(args...)).Output()
+	require.NoError(t, err)
+}
+
+// commitOnNewBranch creates a branch from the current branch with one commit.
+func commitOnNewBranch(t *testing.T, branch string) {
+	t.Helper()
+
+	runGit(t, "checkout", "-b", branch)
+	require.NoError(t, os.WriteFile(branch+".txt", []byte(branch), 0o644))
+	runGit(t, "add", branch+".txt")
+	runGit(t, "commit", "-m", "Add "+branch)
+}
+
+// setupChain creates the chain main → feat-1 → feat-2 → feat-3, and an unrelated branch.
+func setupChain(t *testing.T) {
+	t.Helper()
+
+	git.InitGitRepoWithCommit(t)
+	runGit(t, "branch", "-M", "main")
+	commitOnNewBranch(t, "other")
+	runGit(t, "checkout", "main")
+	commitOnNewBranch(t, "feat-1")
+	commitOnNewBranch(t, "feat-2")
+	commitOnNewBranch(t, "feat-3")
+}
+
+func setupCmd(t *testing.T, setupMock func(tc *gitlabtesting.TestClient)) cmdtest.CmdExecFunc {
+	t.Helper()
+
+	testClient := gitlabtesting.NewTestClient(t)
+	setupMock(testClient)
+
+	return cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+		return NewCmdImportStack(f, git.StandardGitCommand{})
+	}, false, cmdtest.WithGitLabClient(testClient.Client))
+}
+
+func expectOpenMR(tc *gitlabtesting.TestClient, branch string, mr *gitlab.BasicMergeRequest) {
+	var mrs []*gitlab.BasicMergeRequest
+	if mr != nil {
+		mrs = append(mrs, mr)
+	}
+
+	tc.MockMergeRequests.EXPECT().
+		ListProjectMergeRequests("OWNER/REPO", &gitlab.ListProjectMergeRequestsOptions{
+			SourceBranch: gitlab.Ptr(branch),
+			State:        gitlab.Ptr("opened"),
+		}).
+		Return(mrs, nil, nil)
+}
+
+func TestImportStack_ancestry(t *testing.T) {
+	setupChain(t)
+
+	exec := setupCmd(t, func(tc *gitlabtesting.TestClient) {
+		expectOpenMR(tc, "feat-1", nil)
+		expectOpenMR(tc, "feat-2", &gitlab.BasicMergeRequest{IID: 2, WebURL: "https://gitlab.com/OWNER/REPO/-/merge_requests/2", TargetBranch: "feat-1"})
+		expectOpenMR(tc, "feat-3", nil)
+	})
+
+	out, err := exec("--base main --title my-feature")
+	require.NoError(t, err)
+	assert.Contains(t, out.OutBuf.String(), `Imported 3 branches into stack "my-feature", based on main`)
+
+	title, err := git.GetCurrentStackTitle()
+	require.NoError(t, err)
+	assert.Equal(t, "my-feature", title)
+
+	stack, err := git.GatherStackRefs("my-feature")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feat-1", "feat-2", "feat-3"}, stack.Branches())
+
+	ref, err := stack.RefFromBranch("feat-2")
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitlab.com/OWNER/REPO/-/merge_requests/2", ref.MR)
+	assert.Equal(t, "Add feat-2", ref.Description)
+
+	base, err := stack.BaseBranch(git.StandardGitCommand{})
+	require.NoError(t, err)
+	assert.Equal(t, "main", base)
+}
+
+func TestImportStack_mr(t *testing.T) {
+	setupChain(t)
+
+	exec := setupCmd(t, func(tc *gitlabtesting.TestClient) {
+		expectOpenMR(tc, "feat-3", &gitlab.BasicMergeRequest{IID: 3, WebURL: "mr-3", TargetBranch: "feat-2"})
+		expectOpenMR(tc, "feat-2", &gitlab.BasicMergeRequest{IID: 2, WebURL: "mr-2", TargetBranch: "main"})
+		expectOpenMR(tc, "main", nil)
+	})
+
+	_, err := exec("feat-3 --detect mr")
+	require.NoError(t, err)
+
+	stack, err := git.GatherStackRefs("feat-3")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feat-2", "feat-3"}, stack.Branches())
+	assert.Equal(t, "mr-2", stack.First().MR)
+	assert.Equal(t, "mr-3", stack.Last().MR)
+
+	base, err := stack.BaseBranch(git.StandardGitCommand{})
+	require.NoError(t, err)
+	assert.Equal(t, "main", base)
+}
+
+func TestImportStack_mrSameCommit(t *testing.T) {
+	setupChain(t)
+	runGit(t, "branch", "feat-3-copy", "feat-3")
+
+	exec := setupCmd(t, func(tc *gitlabtesting.TestClient) {
+		expectOpenMR(tc, "feat-3-copy", &gitlab.BasicMergeRequest{IID: 4, WebURL: "mr-4", TargetBranch: "feat-3"})
+		expectOpenMR(tc, "feat-3", &gitlab.BasicMergeRequest{IID: 3, WebURL: "mr-3", TargetBranch: "main"})
+		expectOpenMR(tc, "main", nil)
+	})
+
+	_, err := exec("feat-3-copy --detect mr")
+	require.NoError(t, err)
+
+	stack, err := git.GatherStackRefs("feat-3-copy")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feat-3", "feat-3-copy"}, stack.Branches())
+	assert.NotEqual(t, stack.First().SHA, stack.Last().SHA)
+	assert.Equal(t, "mr-3", stack.First().MR)
+	assert.Equal(t, "mr-4", stack.Last().MR)
+}
+
+func TestImportStack_existingStack(t *testing.T) {
+	setupChain(t)
+	require.NoError(t, git.AddStackRefFile("feat-3", git.StackRef{SHA: "123", Branch: "feat-3"}))
+
+	exec := setupCmd(t, func(tc *gitlabtesting.TestClient) {})
+
+	_, err := exec("--base main")
+	require.ErrorContains(t, err, `a stack with the title "feat-3" already exists`)
+}
+
+func TestImportStack_fromMR(t *testing.T) {
+	setupChain(t)
+
+	exec := setupCmd(t, func(tc *gitlabtesting.TestClient) {
+		tc.MockMergeRequests.EXPECT().
+			GetMergeRequest("OWNER/REPO", int64(2), nil).
+			Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 2, SourceBranch: "feat-2", State: "opened"}}, nil, nil)
+
+		expectOpenMR(tc, "feat-2", &gitlab.BasicMergeRequest{IID: 2, WebURL: "mr-2", TargetBranch: "feat-1"})
+		expectOpenMR(tc, "feat-1", &gitlab.BasicMergeRequest{IID: 1, WebURL: "mr-1", TargetBranch: "main"})
+		expectOpenMR(tc, "main", nil)
+
+		tc.MockMergeRequests.EXPECT().
+			ListProjectMergeRequests("OWNER/REPO", &gitlab.ListProjectMergeRequestsOptions{
+				TargetBranch: gitlab.Ptr("feat-2"),
+				State:        gitlab.Ptr("opened"),
+			}).
+			Return([]*gitlab.BasicMergeRequest{{IID: 3, WebURL: "mr-3", SourceBranch: "feat-3", TargetBranch: "feat-2"}}, nil, nil)
+		tc.MockMergeRequests.EXPECT().
+			ListProjectMergeRequests("OWNER/REPO", &gitlab.ListProjectMergeRequestsOptions{
+				TargetBranch: gitlab.Ptr("feat-3"),
+				State:        gitlab.Ptr("opened"),
+			}).
+			Return(nil, nil, nil)
+	})
+
+	_, err := exec("--from-mr 2")
+	require.NoError(t, err)
+
+	stack, err := git.GatherStackRefs("feat-3")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feat-1", "feat-2", "feat-3"}, stack.Branches())
+	assert.Equal(t, "mr-1", stack.First().MR)
+	assert.Equal(t, "mr-3", stack.Last().MR)
+}
+
+func TestImportStack_fromFile(t *testing.T) {
+	setupChain(t)
+
+	data := `{"title": "shared", "base": "main", "refs": [
+		{"sha": "aaaaaaaa", "next": "bbbbbbbb", "branch": "feat-1", "description": "First", "mr": "mr-1"},
+		{"sha": "bbbbbbbb", "prev": "aaaaaaaa", "branch": "feat-2", "description": "Second"}
+	]}`
+	require.NoError(t, os.WriteFile("stack.json", []byte(data), 0o644))
+
+	exec := setupCmd(t, func(tc *gitlabtesting.TestClient) {})
+
+	out, err := exec("--from-file stack.json")
+	require.NoError(t, err)
+	assert.Contains(t, out.OutBuf.String(), `Imported 2 branches into stack "shared", based on main`)
+
+	stack, err := git.GatherStackRefs("shared")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feat-1", "feat-2"}, stack.Branches())
+	assert.Equal(t, "aaaaaaaa", stack.First().SHA)
+	assert.Equal(t, "mr-1", stack.First().MR)
+
+	_, err = exec("--from-file stack.json")
+	require.ErrorContains(t, err, `a stack with the title "shared" already exists`)
+}
+
+func TestImportStack_fromFileInvalid(t *testing.T) {
+	setupChain(t)
+
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{
+			name:    "title outside of the stacks",
+			data:    `{"title": "../../x", "base": "main", "refs": [{"sha": "aaaaaaaa", "branch": "feat-1"}]}`,
+			wantErr: `stack metadata has an invalid title "../../x"`,
+		},
+		{
+			name:    "branch like an option",
+			data:    `{"title": "shared", "base": "main", "refs": [{"sha": "aaaaaaaa", "branch": "--upload-pack=x"}]}`,
+			wantErr: `invalid branch name "--upload-pack=x".`,
+		},
+		{
+			name:    "ID outside of the stack",
+			data:    `{"title": "shared", "base": "main", "refs": [{"sha": "../../hooks/x", "branch": "feat-1"}]}`,
+			wantErr: `stack metadata has an invalid ID "../../hooks/x"`,
+		},
+		{
+			name:    "base like an option",
+			data:    `{"title": "shared", "base": "--upload-pack=x", "refs": [{"sha": "aaaaaaaa", "branch": "feat-1"}]}`,
+			wantErr: `invalid branch name "--upload-pack=x".`,
+		},
+		{
+			name:    "invalid branch",
+			data:    `{"title": "shared", "base": "main", "refs": [{"sha": "aaaaaaaa", "branch": "feat..1"}]}`,
+			wantErr: `invalid branch name "feat..1".`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.NoError(t, os.WriteFile("stack.json", []byte(tt.data), 0o644))
+
+			exec := setupCmd(t, func(tc *gitlabtesting.TestClient) {})
+
+			_, err := exec("--from-file stack.json")
+			require.ErrorContains(t, err, tt.wantErr)
+
+			stack, err := git.GatherStackRefs("shared")
+			require.NoError(t, err)
+			assert.True(t, stack.Empty())
+		})
+	}
+}
+
+func TestImportStack_fromRef(t *testing.T) {
+	setupChain(t)
+	remote := t.TempDir()
+	runGit(t, "init", "--bare", remote)
+	runGit(t, "remote", "add", git.DefaultRemote, remote)
+	runGit(t, "push", git.DefaultRemote, "feat-1", "feat-2")
+
+	require.NoError(t, git.AddStackRefFile("shared", git.StackRef{SHA: "cccccccc", Branch: "feat-3"}))
+	require.NoError(t, git.AddStackBaseBranch("shared", "main"))
+
+	push := func(refs ...git.StackRef) {
+		require.NoError(t, git.PushStackMetadata(git.ExportedStack{Title: "shared", Base: "main", Refs: refs}, git.DefaultRemote))
+	}
+
+	t.Run("keeps the local stack if a branch can't be fetched", func(t *testing.T) {
+		push(
+			git.StackRef{SHA: "aaaaaaaa", Next: "dddddddd", Branch: "feat-1"},
+			git.StackRef{SHA: "dddddddd", Prev: "aaaaaaaa", Branch: "missing"},
+		)
+
+		exec := setupCmd(t, func(tc *gitlabtesting.TestClient) {})
+
+		_, err := exec("--from-ref shared")
+		require.ErrorContains(t, err, "error fetching branch missing")
+
+		stack, err := git.GatherStackRefs("shared")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"feat-3"}, stack.Branches())
+	})
+
+	t.Run("replaces the local stack", func(t *testing.T) {
+		push(
+			git.StackRef{SHA: "aaaaaaaa", Next: "bbbbbbbb", Branch: "feat-1"},
+			git.StackRef{SHA: "bbbbbbbb", Prev: "aaaaaaaa", Branch: "feat-2"},
+		)
+
+		exec := setupCmd(t, func(tc *gitlabtesting.TestClient) {})
+
+		out, err := exec("--from-ref shared")
+		require.NoError(t, err)
+		assert.Contains(t, out.OutBuf.String(), `Imported 2 branches into stack "shared", based on main`)
+
+		stack, err := git.GatherStackRefs("shared")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"feat-1", "feat-2"}, stack.Branches())
+
+		entries, err := os.ReadDir(filepath.Dir(filepath.Join(git.StackLocation, "shared")))
+		require.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+}
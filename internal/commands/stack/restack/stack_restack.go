@@ -0,0 +1,135 @@
+package restack
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/text"
+)
+
+func NewCmdContinueStack(f cmdutils.Factory, gr git.GitRunner) *cobra.Command {
+	return &cobra.Command{
+		Use:   "continue",
+		Short: "Continue a restack that stopped because of a conflict. (EXPERIMENTAL)",
+		Long: heredoc.Doc(`
+			Continue a restack that stopped because of a conflict.
+
+			When glab stack sync rebases the branches of a stack and a rebase stops
+			because of a conflict, resolve the conflict, stage the changes with
+			git add, and run this command. It finishes the rebase of the current
+			branch, and rebases the remaining branches of the stack.
+		`) + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			$ git add conflicting-file.go
+			$ glab stack continue
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			restack, err := loadRestack()
+			if err != nil {
+				return err
+			}
+
+			err = restack.Continue(gr)
+			if errors.Is(err, git.ErrRestackConflict) {
+				return ConflictError(f.IO(), restack)
+			}
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintf(f.IO().StdOut, "%s Restack finished. Run %s to push the branches.\n",
+				f.IO().Color().GreenCheck(), f.IO().Color().Bold("glab stack sync"))
+			return nil
+		},
+	}
+}
+
+func NewCmdAbortStack(f cmdutils.Factory, gr git.GitRunner) *cobra.Command {
+	return &cobra.Command{
+		Use:   "abort",
+		Short: "Abort a restack and restore the branches of the stack. (EXPERIMENTAL)",
+		Long: heredoc.Doc(`
+			Abort a restack that stopped because of a conflict.
+
+			Stops the rebase in progress, and resets every branch of the stack to
+			the commit it had before the restack started.
+		`) + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			$ glab stack abort
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			restack, err := loadRestack()
+			if err != nil {
+				return err
+			}
+
+			if err := restack.Abort(gr); err != nil {
+				return err
+			}
+
+			if restack.After != "" {
+				fmt.Fprintf(f.IO().StdOut, "%s Restack aborted. The branches after the %s are back to where they were, but the %s itself is not undone.\n",
+					f.IO().Color().GreenCheck(), restack.After, restack.After)
+				return nil
+			}
+
+			fmt.Fprintf(f.IO().StdOut, "%s Restack aborted. The branches of the stack are back to where they were.\n",
+				f.IO().Color().GreenCheck())
+			return nil
+		},
+	}
+}
+
+func loadRestack() (*git.Restack, error) {
+	title, err := git.GetCurrentStackTitle()
+	if err != nil {
+		return nil, fmt.Errorf("error getting current stack: %w", err)
+	}
+
+	restack, err := git.LoadRestack(title)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("no restack is in progress for stack %q.", title)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return restack, nil
+}
+
+// ConflictError explains how to resume or undo a restack that stopped because of a conflict.
+func ConflictError(io *iostreams.IOStreams, restack *git.Restack) error {
+	c := io.Color()
+
+	step := restack.Current()
+	if step == nil {
+		return errors.New("the restack stopped because of a conflict.")
+	}
+
+	restore := "To restore the branches as they were before"
+	if restack.After != "" {
+		restore = fmt.Sprintf("To restore the branches after the %s as they were before, without undoing the %s", restack.After, restack.After)
+	}
+
+	return fmt.Errorf("%s could not rebase %s onto %s because of a conflict.\n"+
+		"  Resolve the conflicts, stage them with %s, and run %s.\n"+
+		"  %s, run %s.",
+		c.Red("✘"), step.Branch, step.Onto,
+		c.Bold("git add"), c.Bold("glab stack continue"), restore, c.Bold("glab stack abort"))
+}
@@ -0,0 +1,84 @@
+//go:build !integration
+
+package restack
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func setupCmd(t *testing.T, newCmd func(cmdutils.Factory, git.GitRunner) *cobra.Command) cmdtest.CmdExecFunc {
+	t.Helper()
+
+	return cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+		return newCmd(f, git.StandardGitCommand{})
+	}, false)
+}
+
+func TestContinue_noRestack(t *testing.T) {
+	git.InitGitRepoWithCommit(t)
+	require.NoError(t, git.SetLocalConfig("glab.currentstack", "cool-stack"))
+
+	exec := setupCmd(t, NewCmdContinueStack)
+
+	_, err := exec("")
+	require.ErrorContains(t, err, `no restack is in progress for stack "cool-stack"`)
+}
+
+// setupStoppedRestack creates a stack with a restack that stopped before its first step.
+func setupStoppedRestack(t *testing.T, after string) {
+	t.Helper()
+
+	git.InitGitRepoWithCommit(t)
+	require.NoError(t, git.SetLocalConfig("glab.currentstack", "cool-stack"))
+
+	refs := map[string]git.StackRef{
+		"1": {SHA: "1", Next: "2", Branch: "Branch1"},
+		"2": {SHA: "2", Prev: "1", Branch: "Branch2"},
+	}
+	require.NoError(t, git.CreateRefFiles(refs, "cool-stack"))
+	git.CreateBranches(t, []string{"Branch1", "Branch2"})
+
+	gr := git.StandardGitCommand{}
+	_, err := gr.Git("checkout", "Branch1")
+	require.NoError(t, err)
+
+	stack, err := git.GatherStackRefs("cool-stack")
+	require.NoError(t, err)
+
+	restack, err := git.NewRestack(&stack, stack.First(), gr)
+	require.NoError(t, err)
+	restack.Steps[0].Upstream = "does-not-exist"
+	restack.After = after
+	require.ErrorIs(t, restack.Run(gr), git.ErrRestackConflict)
+	require.True(t, git.RestackInProgress("cool-stack"))
+}
+
+func TestAbort(t *testing.T) {
+	setupStoppedRestack(t, "")
+
+	exec := setupCmd(t, NewCmdAbortStack)
+
+	out, err := exec("")
+	require.NoError(t, err)
+	assert.Contains(t, out.OutBuf.String(), "Restack aborted. The branches of the stack are back to where they were.")
+	assert.False(t, git.RestackInProgress("cool-stack"))
+}
+
+func TestAbort_afterFold(t *testing.T) {
+	setupStoppedRestack(t, "fold")
+
+	exec := setupCmd(t, NewCmdAbortStack)
+
+	out, err := exec("")
+	require.NoError(t, err)
+	assert.Contains(t, out.OutBuf.String(), "The branches after the fold are back to where they were, but the fold itself is not undone.")
+	assert.False(t, git.RestackInProgress("cool-stack"))
+}
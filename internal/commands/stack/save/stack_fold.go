@@ -0,0 +1,146 @@
+package save
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	restackCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/restack"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/text"
+)
+
+func NewCmdFoldStack(f cmdutils.Factory, gr git.GitRunner) *cobra.Command {
+	var message string
+
+	stackFoldCmd := &cobra.Command{
+		Use:   "fold",
+		Short: `Fold the current stacked diff into the diff before it. (EXPERIMENTAL)`,
+		Long: heredoc.Docf(`
+			Fold the changes of the current stacked diff into the diff before it.
+
+			The commit of the previous diff is amended with the changes of the current
+			diff, and the branch of the current diff is deleted. The previous diff keeps
+			its description, unless you set a new one with %[1]s--message%[1]s.
+			The diffs after the folded diff are rebased onto the previous diff.
+
+			The next %[1]sglab stack sync%[1]s closes the merge request of the folded diff,
+			and retargets the merge requests that follow it.
+		`, "`") + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			$ glab stack fold
+			$ glab stack fold -m "Add the API client and use it in the CLI"`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return foldFunc(f, gr, message)
+		},
+	}
+	stackFoldCmd.Flags().StringVarP(&message, "message", "m", "", "New description of the combined diff.")
+
+	return stackFoldCmd
+}
+
+func foldFunc(f cmdutils.Factory, gr git.GitRunner, message string) error {
+	if err := checkForChanges(); err == nil {
+		return errors.New("you have uncommitted changes. Commit or stash them before folding.")
+	}
+
+	title, err := git.GetCurrentStackTitle()
+	if err != nil {
+		return fmt.Errorf("error running Git command: %v", err)
+	}
+
+	stack, err := git.GatherStackRefs(title)
+	if err != nil {
+		return fmt.Errorf("error getting refs from file system: %v", err)
+	}
+
+	branch, err := git.CurrentBranch()
+	if err != nil {
+		return err
+	}
+
+	ref, err := stack.RefFromBranch(branch)
+	if err != nil {
+		return fmt.Errorf("not currently in a stack. Change to the branch you want to fold: %v", err)
+	}
+
+	if ref.IsFirst() {
+		return errors.New("the first diff of the stack has no previous diff to fold into.")
+	}
+	parent := stack.Refs[ref.Prev]
+
+	if _, err := gr.Git("merge-base", "--is-ancestor", parent.Branch, ref.Branch); err != nil {
+		return fmt.Errorf("%s is not based on %s. Run `glab stack sync` first.", ref.Branch, parent.Branch)
+	}
+
+	if message == "" {
+		message = parent.Description
+	}
+
+	// plan the rebase of the following diffs before their parent changes
+	restack, err := git.NewRestack(&stack, ref, gr)
+	if err != nil {
+		return err
+	}
+
+	// combine both diffs into one commit, and move the parent branch to it
+	if _, err := gr.Git("reset", "--soft", parent.Branch); err != nil {
+		return fmt.Errorf("error folding %s: %v", ref.Branch, err)
+	}
+	if _, err := gr.Git("commit", "--amend", "-m", message); err != nil {
+		return fmt.Errorf("error amending commit with Git: %v", err)
+	}
+	if _, err := gr.Git("checkout", "-B", parent.Branch); err != nil {
+		return fmt.Errorf("error updating branch %s: %v", parent.Branch, err)
+	}
+
+	// remove the ref, and delete its branch
+	if err := stack.RemoveRef(ref, gr); err != nil {
+		return fmt.Errorf("error removing %s from the stack: %v", ref.Branch, err)
+	}
+
+	parent = stack.Refs[ref.Prev]
+	parent.Description = message
+	if err := git.UpdateStackRefFile(title, parent); err != nil {
+		return fmt.Errorf("error updating ref: %v", err)
+	}
+
+	if ref.MR != "" {
+		if err := git.AddStackMRToClose(title, ref.MR); err != nil {
+			return err
+		}
+	}
+
+	if len(restack.Steps) > 0 {
+		restack.Steps[0].Onto = parent.Branch
+		restack.OrigBranch = parent.Branch
+		restack.After = "fold"
+
+		err = restack.Run(gr)
+		if errors.Is(err, git.ErrRestackConflict) {
+			return restackCmd.ConflictError(f.IO(), restack)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if f.IO().IsOutputTTY() {
+		color := f.IO().Color()
+		fmt.Fprintf(f.IO().StdOut, "%s %s: Folded %s into %s.\n", color.ProgressIcon(), color.Blue(title), ref.Branch, parent.Branch)
+		if ref.MR != "" {
+			fmt.Fprintf(f.IO().StdOut, "  The next %s closes %s.\n", color.Bold("glab stack sync"), strings.TrimSpace(ref.MR))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+//go:build !integration
+
+package save
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestStackFold(t *testing.T) {
+	stack := setupSplitStack(t, [][]string{{"a.txt"}, {"b.txt"}, {"c.txt"}})
+
+	ref := stack.Refs["2"]
+	ref.MR = "https://gitlab.com/OWNER/REPO/-/merge_requests/2"
+	require.NoError(t, git.UpdateStackRefFile("cool-stack", ref))
+	runGit(t, "checkout", "Branch2")
+
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	f := cmdtest.NewTestFactory(ios)
+
+	err := foldFunc(f, git.StandardGitCommand{}, "Add a and b")
+	require.NoError(t, err)
+
+	stack, err = git.GatherStackRefs("cool-stack")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Branch1", "Branch3"}, stack.Branches())
+	assert.Equal(t, "Add a and b", stack.First().Description)
+
+	assert.Equal(t, "a.txt\nb.txt", runGit(t, "diff", "--name-only", "main", "Branch1"))
+	assert.Equal(t, "c.txt", runGit(t, "diff", "--name-only", "Branch1", "Branch3"))
+	assert.Equal(t, "Branch1", runGit(t, "branch", "--show-current"))
+
+	_, err = git.StandardGitCommand{}.Git("rev-parse", "--verify", "Branch2")
+	assert.Error(t, err)
+
+	mrs, err := git.StackMRsToClose("cool-stack")
+	require.NoError(t, err)
+	assert.Equal(t, []string{ref.MR}, mrs)
+}
+
+func TestStackFold_first(t *testing.T) {
+	setupSplitStack(t, [][]string{{"a.txt"}, {"b.txt"}})
+	runGit(t, "checkout", "Branch1")
+
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	f := cmdtest.NewTestFactory(ios)
+
+	err := foldFunc(f, git.StandardGitCommand{}, "")
+	require.ErrorContains(t, err, "no previous diff to fold into")
+}
@@ -0,0 +1,303 @@
+package save
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	restackCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/restack"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/run"
+	"gitlab.com/gitlab-org/cli/internal/text"
+)
+
+type splitOptions struct {
+	messages []string
+
+	// selectHunks lets the user stage the changes of the next entry. It is replaced in tests.
+	selectHunks func() error
+}
+
+func NewCmdSplitStack(f cmdutils.Factory, gr git.GitRunner, getText cmdutils.GetTextUsingEditor) *cobra.Command {
+	opts := &splitOptions{}
+
+	stackSplitCmd := &cobra.Command{
+		Use:   "split",
+		Short: `Split the current stacked diff into several diffs. (EXPERIMENTAL)`,
+		Long: heredoc.Docf(`
+			Split the commit of the current stacked diff into several diffs.
+
+			The changes of the commit are unstaged, and you select the hunks of each
+			new diff with %[1]sgit add --patch%[1]s. The first diff keeps the branch and merge
+			request of the current diff. Each following diff gets a new branch. To put all
+			remaining changes into the last diff, don't select any hunks.
+
+			The diffs after the split diff are rebased onto the last new diff.
+			Run %[1]sglab stack sync%[1]s afterwards to create merge requests for the new diffs,
+			and retarget the merge requests that follow them.
+		`, "`") + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			$ glab stack split
+			$ glab stack split -m "Add the API client" -m "Use the API client in the CLI"`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.selectHunks == nil {
+				opts.selectHunks = func() error { return addPatch(f) }
+			}
+
+			return splitFunc(cmd.Context(), f, gr, getText, opts)
+		},
+	}
+	stackSplitCmd.Flags().StringArrayVarP(&opts.messages, "message", "m", nil, "Description of each new diff, in order. Can be used multiple times.")
+
+	return stackSplitCmd
+}
+
+func splitFunc(ctx context.Context, f cmdutils.Factory, gr git.GitRunner, getText cmdutils.GetTextUsingEditor, opts *splitOptions) error {
+	if err := checkForChanges(); err == nil {
+		return errors.New("you have uncommitted changes. Commit or stash them before splitting.")
+	}
+
+	title, err := git.GetCurrentStackTitle()
+	if err != nil {
+		return fmt.Errorf("error running Git command: %v", err)
+	}
+
+	stack, err := git.GatherStackRefs(title)
+	if err != nil {
+		return fmt.Errorf("error getting refs from file system: %v", err)
+	}
+
+	branch, err := git.CurrentBranch()
+	if err != nil {
+		return err
+	}
+
+	ref, err := stack.RefFromBranch(branch)
+	if err != nil {
+		return fmt.Errorf("not currently in a stack. Change to the branch you want to split: %v", err)
+	}
+
+	parentBranch, err := parentBranch(&stack, ref, gr)
+	if err != nil {
+		return err
+	}
+
+	forkPoint, err := gr.Git("merge-base", parentBranch, ref.Branch)
+	if err != nil {
+		return fmt.Errorf("error finding where %s forked from %s: %v", ref.Branch, parentBranch, err)
+	}
+
+	origHead, err := gr.Git("rev-parse", "HEAD")
+	if err != nil {
+		return err
+	}
+	origHead = strings.TrimSpace(origHead)
+
+	// plan the rebase of the following diffs before their parent changes
+	restack, err := git.NewRestack(&stack, ref, gr)
+	if err != nil {
+		return err
+	}
+
+	// keep new files in the index, so git add --patch can select them
+	_, err = gr.Git("reset", "--mixed", "--intent-to-add", strings.TrimSpace(forkPoint))
+	if err != nil {
+		return fmt.Errorf("error unstaging the changes of %s: %v", ref.Branch, err)
+	}
+
+	refs, err := splitCommits(ctx, f, gr, getText, opts, title, ref)
+	if err != nil {
+		return fmt.Errorf("%v\nTo undo the split, run `git checkout %s && git reset --hard %s`.", err, ref.Branch, origHead)
+	}
+
+	if err := writeSplitRefs(&stack, ref, refs); err != nil {
+		return err
+	}
+
+	last := refs[len(refs)-1]
+	if len(restack.Steps) > 0 {
+		restack.Steps[0].Onto = last.Branch
+		restack.OrigBranch = last.Branch
+		restack.After = "split"
+
+		err = restack.Run(gr)
+		if errors.Is(err, git.ErrRestackConflict) {
+			return restackCmd.ConflictError(f.IO(), restack)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if f.IO().IsOutputTTY() {
+		color := f.IO().Color()
+		fmt.Fprintf(f.IO().StdOut, "%s %s: Split %s into %d diffs:\n", color.ProgressIcon(), color.Blue(title), ref.Branch, len(refs))
+		for _, r := range refs {
+			fmt.Fprintf(f.IO().StdOut, "  %s %s\n", r.Branch, color.Gray(r.Subject()))
+		}
+	}
+
+	return nil
+}
+
+// splitCommits commits the unstaged changes in parts selected by the user. The first part is
+// committed to the branch of ref, and each following part to a new branch.
+func splitCommits(ctx context.Context, f cmdutils.Factory, gr git.GitRunner, getText cmdutils.GetTextUsingEditor, opts *splitOptions, title string, ref git.StackRef) ([]git.StackRef, error) {
+	var refs []git.StackRef
+
+	for i := 0; ; i++ {
+		status, err := gr.Git("status", "--porcelain")
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(status) == "" {
+			break
+		}
+
+		if err := opts.selectHunks(); err != nil {
+			return nil, fmt.Errorf("error selecting changes: %v", err)
+		}
+
+		// nothing selected means the rest of the changes belong to this diff
+		if _, err := gr.Git("diff", "--cached", "--quiet"); err == nil {
+			if _, err := gr.Git("add", "--all"); err != nil {
+				return nil, err
+			}
+		}
+
+		var description string
+		switch {
+		case i < len(opts.messages):
+			description = opts.messages[i]
+		case i == 0:
+			description, err = promptForCommit(ctx, f, getText, ref.Description)
+		default:
+			description, err = promptForCommit(ctx, f, getText, "")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error getting commit message: %v", err)
+		}
+
+		part := git.StackRef{SHA: ref.SHA, Branch: ref.Branch, MR: ref.MR, Description: description}
+		if i > 0 {
+			part, err = newSplitRef(f, title, description)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := git.CheckoutNewBranch(part.Branch); err != nil {
+				return nil, fmt.Errorf("error running branch checkout: %v", err)
+			}
+		}
+
+		if _, err := commitFiles(description); err != nil {
+			return nil, fmt.Errorf("error committing files: %v", err)
+		}
+
+		refs = append(refs, part)
+	}
+
+	if len(refs) == 0 {
+		return nil, fmt.Errorf("%s has no changes to split.", ref.Branch)
+	}
+
+	return refs, nil
+}
+
+func newSplitRef(f cmdutils.Factory, title, description string) (git.StackRef, error) {
+	author, err := git.GitUserName()
+	if err != nil {
+		return git.StackRef{}, fmt.Errorf("error getting Git author: %v", err)
+	}
+
+	sha, err := generateStackSha(description, title, string(author), time.Now())
+	if err != nil {
+		return git.StackRef{}, fmt.Errorf("error generating hash for stack branch name: %v", err)
+	}
+
+	branch, err := createShaBranch(f, sha, title)
+	if err != nil {
+		return git.StackRef{}, fmt.Errorf("error creating branch name: %v", err)
+	}
+
+	return git.StackRef{SHA: sha, Branch: branch, Description: description}, nil
+}
+
+// writeSplitRefs replaces ref in the stack with refs, linked in order.
+func writeSplitRefs(stack *git.Stack, ref git.StackRef, refs []git.StackRef) error {
+	for i := range refs {
+		if i == 0 {
+			refs[i].Prev = ref.Prev
+		} else {
+			refs[i].Prev = refs[i-1].SHA
+		}
+
+		if i == len(refs)-1 {
+			refs[i].Next = ref.Next
+		} else {
+			refs[i].Next = refs[i+1].SHA
+		}
+	}
+
+	if !ref.IsLast() {
+		next := stack.Refs[ref.Next]
+		next.Prev = refs[len(refs)-1].SHA
+		if err := git.UpdateStackRefFile(stack.Title, next); err != nil {
+			return fmt.Errorf("error updating ref: %v", err)
+		}
+		stack.Refs[next.SHA] = next
+	}
+
+	for i, r := range refs {
+		var err error
+		if i == 0 {
+			err = git.UpdateStackRefFile(stack.Title, r)
+		} else {
+			err = git.AddStackRefFile(stack.Title, r)
+		}
+		if err != nil {
+			return fmt.Errorf("error creating stack file: %v", err)
+		}
+		stack.Refs[r.SHA] = r
+	}
+
+	return nil
+}
+
+// parentBranch returns the branch that ref is based on.
+func parentBranch(stack *git.Stack, ref git.StackRef, gr git.GitRunner) (string, error) {
+	if ref.IsFirst() {
+		branch, err := stack.BaseBranch(gr)
+		if err != nil {
+			return "", fmt.Errorf("error getting base branch: %v", err)
+		}
+		return branch, nil
+	}
+
+	return stack.Refs[ref.Prev].Branch, nil
+}
+
+// addPatch runs git add --patch attached to the terminal.
+func addPatch(f cmdutils.Factory) error {
+	if !f.IO().IsInputTTY() {
+		return errors.New("selecting changes requires a terminal.")
+	}
+
+	cmd := git.GitCommand("add", "--patch")
+	cmd.Stdin = f.IO().In
+	cmd.Stdout = f.IO().StdOut
+	cmd.Stderr = f.IO().StdErr
+
+	return run.PrepareCmd(cmd).Run()
+}
@@ -0,0 +1,102 @@
+//go:build !integration
+
+package save
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func runGit(t *testing.T, args ...string) string {
+	t.Helper()
+
+	out, err := git.StandardGitCommand{}.Git(args...)
+	require.NoError(t, err)
+
+	return strings.TrimSpace(out)
+}
+
+// setupSplitStack creates a stack with one branch per entry, each with a commit that adds the given files.
+func setupSplitStack(t *testing.T, entries [][]string) git.Stack {
+	t.Helper()
+
+	git.InitGitRepoWithCommit(t)
+	runGit(t, "branch", "-M", "main")
+	require.NoError(t, git.SetLocalConfig("glab.currentstack", "cool-stack"))
+
+	refs := map[string]git.StackRef{}
+	for i, files := range entries {
+		sha := string(rune('1' + i))
+		branch := "Branch" + sha
+		runGit(t, "checkout", "-b", branch)
+		for _, file := range files {
+			require.NoError(t, os.WriteFile(file, []byte(file+"\n"), 0o644))
+		}
+		runGit(t, "add", ".")
+		runGit(t, "commit", "-m", "Change "+sha)
+
+		ref := git.StackRef{SHA: sha, Branch: branch, Description: "Change " + sha}
+		if i > 0 {
+			ref.Prev = string(rune('1' + i - 1))
+		}
+		if i < len(entries)-1 {
+			ref.Next = string(rune('1' + i + 1))
+		}
+		refs[sha] = ref
+	}
+
+	require.NoError(t, git.CreateRefFiles(refs, "cool-stack"))
+	require.NoError(t, git.AddStackBaseBranch("cool-stack", "main"))
+
+	stack, err := git.GatherStackRefs("cool-stack")
+	require.NoError(t, err)
+
+	return stack
+}
+
+func TestStackSplit(t *testing.T) {
+	setupSplitStack(t, [][]string{{"a.txt", "b.txt"}, {"c.txt"}})
+	runGit(t, "checkout", "Branch1")
+
+	ios, _, stdout, _ := cmdtest.TestIOStreams(cmdtest.WithTestIOStreamsAsTTY(true))
+	f := cmdtest.NewTestFactory(ios)
+
+	calls := 0
+	opts := &splitOptions{
+		messages: []string{"Add a", "Add b"},
+		selectHunks: func() error {
+			calls++
+			if calls == 1 {
+				runGit(t, "add", "a.txt")
+			}
+			return nil
+		},
+	}
+
+	err := splitFunc(t.Context(), f, git.StandardGitCommand{}, nil, opts)
+	require.NoError(t, err)
+	assert.Contains(t, stdout.String(), "Split Branch1 into 2 diffs")
+
+	stack, err := git.GatherStackRefs("cool-stack")
+	require.NoError(t, err)
+
+	branches := stack.Branches()
+	require.Len(t, branches, 3)
+	assert.Equal(t, "Branch1", branches[0])
+	assert.Equal(t, "Branch2", branches[2])
+	assert.Equal(t, "Add a", stack.First().Description)
+
+	second := stack.Refs[stack.First().Next]
+	assert.Equal(t, "Add b", second.Description)
+
+	assert.Equal(t, "a.txt", runGit(t, "diff", "--name-only", "main", "Branch1"))
+	assert.Equal(t, "b.txt", runGit(t, "diff", "--name-only", "Branch1", second.Branch))
+	assert.Equal(t, "c.txt", runGit(t, "diff", "--name-only", second.Branch, "Branch2"))
+}
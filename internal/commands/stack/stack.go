@@ -8,12 +8,16 @@ import (
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	stackCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/create"
+	stackExportCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/export"
+	stackImportCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/import"
 	stackListCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/list"
 	stackMoveCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/navigate"
 	stackReorderCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/reorder"
+	stackRestackCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/restack"
 	stackSaveCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/save"
 	stackSwitchCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/switch"
 	stackSyncCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/sync"
+	stackViewCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/view"
 	"gitlab.com/gitlab-org/cli/internal/git"
 	"gitlab.com/gitlab-org/cli/internal/text"
 )
@@ -46,7 +50,11 @@ func NewCmdStack(f cmdutils.Factory) *cobra.Command {
 	stackCmd.AddCommand(stackCreateCmd.NewCmdCreateStack(f, gr))
 	stackCmd.AddCommand(stackSaveCmd.NewCmdSaveStack(f, gr, getTextFromEditor))
 	stackCmd.AddCommand(stackSaveCmd.NewCmdAmendStack(f, gr, getTextFromEditor))
+	stackCmd.AddCommand(stackSaveCmd.NewCmdSplitStack(f, gr, getTextFromEditor))
+	stackCmd.AddCommand(stackSaveCmd.NewCmdFoldStack(f, gr))
 	stackCmd.AddCommand(stackSyncCmd.NewCmdSyncStack(f, gr))
+	stackCmd.AddCommand(stackRestackCmd.NewCmdContinueStack(f, gr))
+	stackCmd.AddCommand(stackRestackCmd.NewCmdAbortStack(f, gr))
 	stackCmd.AddCommand(stackMoveCmd.NewCmdStackPrev(f, gr))
 	stackCmd.AddCommand(stackMoveCmd.NewCmdStackNext(f, gr))
 	stackCmd.AddCommand(stackMoveCmd.NewCmdStackFirst(f, gr))
@@ -55,6 +63,9 @@ func NewCmdStack(f cmdutils.Factory) *cobra.Command {
 	stackCmd.AddCommand(stackListCmd.NewCmdStackList(f, gr))
 	stackCmd.AddCommand(stackReorderCmd.NewCmdReorderStack(f, gr, getTextFromEditor))
 	stackCmd.AddCommand(stackSwitchCmd.NewCmdStackSwitch(f, gr))
+	stackCmd.AddCommand(stackImportCmd.NewCmdImportStack(f, gr))
+	stackCmd.AddCommand(stackExportCmd.NewCmdExportStack(f, gr))
+	stackCmd.AddCommand(stackViewCmd.NewCmdStackView(f, gr))
 
 	return stackCmd
 }
@@ -0,0 +1,84 @@
+package sync
+
+import (
+	"fmt"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+// The stack block in merge request descriptions is between these markers.
+// Sync replaces the block, and leaves the rest of the description untouched.
+const (
+	stackBlockStart = "<!-- glab-stack:start -->"
+	stackBlockEnd   = "<!-- glab-stack:end -->"
+)
+
+// stackBlock renders the list of merge requests in the stack, and points to the current one.
+func stackBlock(title string, mrs []*gitlab.MergeRequest, current int64) string {
+	var b strings.Builder
+
+	b.WriteString(stackBlockStart + "\n")
+	fmt.Fprintf(&b, "**Stack `%s`**, managed by `glab stack sync`:\n\n", title)
+
+	for _, mr := range mrs {
+		if mr.IID == current {
+			fmt.Fprintf(&b, "1. **!%d %s** (%s) ← this merge request\n", mr.IID, mr.Title, mr.State)
+			continue
+		}
+		fmt.Fprintf(&b, "1. !%d %s (%s)\n", mr.IID, mr.Title, mr.State)
+	}
+
+	b.WriteString(stackBlockEnd)
+
+	return b.String()
+}
+
+// replaceStackBlock puts block in place of the stack block of description,
+// or appends it if the description has none.
+func replaceStackBlock(description, block string) string {
+	start := strings.Index(description, stackBlockStart)
+	end := strings.Index(description, stackBlockEnd)
+
+	if start >= 0 && end > start {
+		return description[:start] + block + description[end+len(stackBlockEnd):]
+	}
+
+	if strings.TrimSpace(description) == "" {
+		return block
+	}
+
+	return strings.TrimRight(description, "\n") + "\n\n" + block
+}
+
+// updateStackBlocks updates the stack block of every open merge request in the stack.
+// The block lists all merge requests in the stack with their status, including closed ones.
+func updateStackBlocks(io *iostreams.IOStreams, client *gitlab.Client, title string, mrs []*gitlab.MergeRequest) error {
+	updated := false
+	for _, mr := range mrs {
+		if mr.State != openedStatus {
+			continue
+		}
+
+		description := replaceStackBlock(mr.Description, stackBlock(title, mrs, mr.IID))
+		if description == mr.Description {
+			continue
+		}
+
+		_, _, err := client.MergeRequests.UpdateMergeRequest(mr.ProjectID, mr.IID, &gitlab.UpdateMergeRequestOptions{
+			Description: gitlab.Ptr(description),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating the description of merge request !%v: %v", mr.IID, err)
+		}
+		updated = true
+	}
+
+	if updated {
+		fmt.Print(progressString(io, "Updated the stack in merge request descriptions."))
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+//go:build !integration
+
+package sync
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func Test_replaceStackBlock(t *testing.T) {
+	mrs := []*gitlab.MergeRequest{
+		{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 1, Title: "First", State: "merged"}},
+		{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 2, Title: "Second", State: "opened"}},
+	}
+	block := stackBlock("cool-stack", mrs, 2)
+
+	assert.Equal(t, "<!-- glab-stack:start -->\n"+
+		"**Stack `cool-stack`**, managed by `glab stack sync`:\n\n"+
+		"1. !1 First (merged)\n"+
+		"1. **!2 Second** (opened) ← this merge request\n"+
+		"<!-- glab-stack:end -->", block)
+
+	tests := []struct {
+		name        string
+		description string
+		want        string
+	}{
+		{
+			name:        "empty description",
+			description: "",
+			want:        block,
+		},
+		{
+			name:        "description without block",
+			description: "Some text.\n",
+			want:        "Some text.\n\n" + block,
+		},
+		{
+			name:        "description with block",
+			description: "Before.\n\n<!-- glab-stack:start -->\nold\n<!-- glab-stack:end -->\n\nAfter.",
+			want:        "Before.\n\n" + block + "\n\nAfter.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, replaceStackBlock(tc.description, block))
+		})
+	}
+}
+
+func Test_updateStackBlocks(t *testing.T) {
+	mrs := []*gitlab.MergeRequest{
+		{BasicMergeRequest: gitlab.BasicMergeRequest{ProjectID: 3, IID: 1, Title: "First", State: "merged"}},
+		{BasicMergeRequest: gitlab.BasicMergeRequest{ProjectID: 3, IID: 2, Title: "Second", State: "opened"}},
+		{BasicMergeRequest: gitlab.BasicMergeRequest{ProjectID: 3, IID: 3, Title: "Third", State: "closed"}},
+		{BasicMergeRequest: gitlab.BasicMergeRequest{ProjectID: 3, IID: 4, Title: "Fourth", State: "opened"}},
+	}
+
+	tc := gitlabtesting.NewTestClient(t)
+	for _, iid := range []int64{2, 4} {
+		tc.MockMergeRequests.EXPECT().
+			UpdateMergeRequest(int64(3), iid, gomock.Any()).
+			DoAndReturn(func(pid any, mr int64, opts *gitlab.UpdateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+				assert.Equal(t, stackBlock("cool-stack", mrs, iid), *opts.Description)
+				assert.Contains(t, *opts.Description, "1. !3 Third (closed)\n")
+				return &gitlab.MergeRequest{}, nil, nil
+			})
+	}
+
+	ios, _, _, _ := cmdtest.TestIOStreams()
+	require.NoError(t, updateStackBlocks(ios, tc.Client, "cool-stack", mrs))
+
+	// descriptions that already have the current block are not updated
+	for _, mr := range mrs {
+		mr.Description = stackBlock("cool-stack", mrs, mr.IID)
+	}
+	require.NoError(t, updateStackBlocks(ios, tc.Client, "cool-stack", mrs))
+}
@@ -15,6 +15,7 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
 	"gitlab.com/gitlab-org/cli/internal/commands/mr/create"
 	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	restackCmd "gitlab.com/gitlab-org/cli/internal/commands/stack/restack"
 	"gitlab.com/gitlab-org/cli/internal/dbg"
 	"gitlab.com/gitlab-org/cli/internal/git"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
@@ -41,6 +42,7 @@ const (
 	BranchIsBehind    = "Your branch is behind"
 	BranchHasDiverged = "have diverged"
 	NothingToCommit   = "nothing to commit"
+	openedStatus      = "opened"
 	mergedStatus      = "merged"
 	closedStatus      = "closed"
 )
@@ -60,8 +62,14 @@ func NewCmdSyncStack(f cmdutils.Factory, gr git.GitRunner) *cobra.Command {
 1. Optional. If working in a fork, select whether to push to the fork,
    or the upstream repository.
 1. Pushes any amended changes to their merge requests.
-1. Rebases any changes that happened previously in the stack.
+1. Rebases any changes that happened previously in the stack. If a rebase
+   stops because of a conflict, resolve it and run glab stack continue,
+   or run glab stack abort to restore the branches.
 1. Removes any branches that were already merged, or with a closed merge request.
+1. Retargets merge requests whose previous diff changed, and closes the merge
+   requests of diffs that were folded.
+1. Updates the list of merge requests in the stack, with their status, at the end
+   of each open merge request description. The rest of the description is not changed.
 ` + text.ExperimentalString),
 		Example: heredoc.Doc(`
 			$ glab stack sync
@@ -110,6 +118,14 @@ func (o *options) run(ctx context.Context, f cmdutils.Factory, gr git.GitRunner)
 		return fmt.Errorf("error getting current stack: %v", err)
 	}
 
+	if git.RestackInProgress(stack.Title) {
+		return errors.New(errorString(
+			o.io,
+			"a restack of this stack is in progress.",
+			"Run `glab stack continue` to finish it, or `glab stack abort` to undo it.",
+		))
+	}
+
 	user, _, err := client.Users.CurrentUser()
 	if err != nil {
 		return fmt.Errorf("error getting current user: %v", err)
@@ -125,7 +141,13 @@ func (o *options) run(ctx context.Context, f cmdutils.Factory, gr git.GitRunner)
 		return err
 	}
 
+	err = closeFoldedMRs(ctx, f, o.io, client, stack.Title)
+	if err != nil {
+		return err
+	}
+
 	pushAfterSync := false
+	var mrs []*gitlab.MergeRequest
 
 	for ref := range stack.Iter() {
 		status, err := branchStatus(&ref, gr)
@@ -155,10 +177,11 @@ func (o *options) run(ctx context.Context, f cmdutils.Factory, gr git.GitRunner)
 		}
 
 		if ref.MR == "" {
-			err := populateMR(o.io, &ref, o, client, gr)
+			mr, err := populateMR(o.io, &ref, o, client, gr)
 			if err != nil {
 				return err
 			}
+			mrs = append(mrs, mr)
 		} else {
 			// we found an MR. let's get the status:
 			mr, _, err := mrutils.MRFromArgsWithOpts(ctx, f, []string{ref.Branch}, nil, "any")
@@ -173,6 +196,19 @@ func (o *options) run(ctx context.Context, f cmdutils.Factory, gr git.GitRunner)
 			if err != nil {
 				return fmt.Errorf("error removing merged merge request: %v", err)
 			}
+
+			// merged merge requests are no longer in the stack
+			if _, ok := stack.Refs[ref.SHA]; !ok {
+				continue
+			}
+
+			// diffs that were split or folded can change the parent of a diff
+			err = retargetMR(o.io, client, &ref, mr, &stack, gr)
+			if err != nil {
+				return fmt.Errorf("error retargeting merge request: %v", err)
+			}
+
+			mrs = append(mrs, mr)
 		}
 	}
 
@@ -183,6 +219,11 @@ func (o *options) run(ctx context.Context, f cmdutils.Factory, gr git.GitRunner)
 		}
 	}
 
+	err = updateStackBlocks(o.io, client, stack.Title, mrs)
+	if err != nil {
+		return err
+	}
+
 	fmt.Print(progressString(o.io, "Sync finished!"))
 	return nil
 }
@@ -237,24 +278,6 @@ func branchStatus(ref *git.StackRef, gr git.GitRunner) (string, error) {
 	return output, nil
 }
 
-func rebaseWithUpdateRefs(ref *git.StackRef, stack *git.Stack, gr git.GitRunner) error {
-	lastRef := stack.Last()
-
-	checkout, err := gr.Git("checkout", lastRef.Branch)
-	if err != nil {
-		return err
-	}
-	dbg.Debug("Checked out:", checkout)
-
-	rebase, err := gr.Git("rebase", "--fork-point", "--update-refs", ref.Branch)
-	if err != nil {
-		return err
-	}
-	dbg.Debug("Rebased:", rebase)
-
-	return nil
-}
-
 func forcePushAllWithLease(io *iostreams.IOStreams, stack *git.Stack, gr git.GitRunner) error {
 	fmt.Print(progressString(
 		io,
@@ -349,6 +372,65 @@ func removeOldMrs(io *iostreams.IOStreams, ref *git.StackRef, mr *gitlab.MergeRe
 	return nil
 }
 
+// retargetMR makes the merge request target the branch of the previous diff, or the base
+// branch for the first diff of the stack.
+func retargetMR(io *iostreams.IOStreams, client *gitlab.Client, ref *git.StackRef, mr *gitlab.MergeRequest, stack *git.Stack, gr git.GitRunner) error {
+	if mr.State != openedStatus {
+		return nil
+	}
+
+	var target string
+	if ref.IsFirst() {
+		base, err := stack.BaseBranch(gr)
+		if err != nil {
+			return err
+		}
+		target = base
+	} else {
+		target = stack.Refs[ref.Prev].Branch
+	}
+	if mr.TargetBranch == target {
+		return nil
+	}
+
+	fmt.Println(progressString(io, fmt.Sprintf("Retargeting merge request !%v to %s.", mr.IID, target)))
+
+	_, _, err := client.MergeRequests.UpdateMergeRequest(mr.ProjectID, mr.IID, &gitlab.UpdateMergeRequestOptions{
+		TargetBranch: gitlab.Ptr(target),
+	})
+	return err
+}
+
+// closeFoldedMRs closes the merge requests of diffs that were folded into other diffs.
+func closeFoldedMRs(ctx context.Context, f cmdutils.Factory, io *iostreams.IOStreams, client *gitlab.Client, title string) error {
+	urls, err := git.StackMRsToClose(title)
+	if err != nil {
+		return err
+	}
+
+	for _, url := range urls {
+		mr, _, err := mrutils.MRFromArgsWithOpts(ctx, f, []string{url}, nil, "any")
+		if err != nil {
+			return fmt.Errorf("error getting folded merge request %s: %v", url, err)
+		}
+
+		if mr.State != openedStatus {
+			continue
+		}
+
+		fmt.Println(progressString(io, fmt.Sprintf("Closing merge request !%v, which was folded into another diff.", mr.IID)))
+
+		_, _, err = client.MergeRequests.UpdateMergeRequest(mr.ProjectID, mr.IID, &gitlab.UpdateMergeRequestOptions{
+			StateEvent: gitlab.Ptr("close"),
+		})
+		if err != nil {
+			return fmt.Errorf("error closing merge request !%v: %v", mr.IID, err)
+		}
+	}
+
+	return git.ClearStackMRsToClose(title)
+}
+
 func errorString(io *iostreams.IOStreams, lines ...string) string {
 	redCheck := io.Color().Red("✘")
 
@@ -374,13 +456,17 @@ func progressString(io *iostreams.IOStreams, lines ...string) string {
 func branchDiverged(io *iostreams.IOStreams, ref *git.StackRef, stack *git.Stack, gr git.GitRunner) (bool, error) {
 	fmt.Println(progressString(io, ref.Branch+" has diverged. Rebasing..."))
 
-	err := rebaseWithUpdateRefs(ref, stack, gr)
+	restack, err := git.NewRestack(stack, *ref, gr)
 	if err != nil {
-		return false, errors.New(errorString(
-			io,
-			"could not rebase, likely due to a merge conflict.",
-			"Fix the issues with Git and run `glab stack sync` again.",
-		))
+		return false, err
+	}
+
+	err = restack.Run(gr)
+	if errors.Is(err, git.ErrRestackConflict) {
+		return false, restackCmd.ConflictError(io, restack)
+	}
+	if err != nil {
+		return false, err
 	}
 
 	return true, nil
@@ -399,13 +485,13 @@ func branchBehind(io *iostreams.IOStreams, ref *git.StackRef, gr git.GitRunner)
 	return nil
 }
 
-func populateMR(io *iostreams.IOStreams, ref *git.StackRef, opts *options, client *gitlab.Client, gr git.GitRunner) error {
+func populateMR(io *iostreams.IOStreams, ref *git.StackRef, opts *options, client *gitlab.Client, gr git.GitRunner) (*gitlab.MergeRequest, error) {
 	// no MR - lets create one!
 	fmt.Println(progressString(io, ref.Branch+" needs a merge request. Creating it now."))
 
 	mr, err := createMR(client, opts, ref, gr)
 	if err != nil {
-		return fmt.Errorf("error updating stack ref files: %v", err)
+		return nil, fmt.Errorf("error updating stack ref files: %v", err)
 	}
 
 	fmt.Println(progressString(io, "Merge request created!"))
@@ -415,8 +501,8 @@ func populateMR(io *iostreams.IOStreams, ref *git.StackRef, opts *options, clien
 	ref.MR = mr.WebURL
 	err = git.UpdateStackRefFile(opts.stack.Title, *ref)
 	if err != nil {
-		return fmt.Errorf("error updating stack ref files: %v", err)
+		return nil, fmt.Errorf("error updating stack ref files: %v", err)
 	}
 
-	return nil
+	return mr, nil
 }
@@ -170,13 +170,31 @@ func Test_stackSync(t *testing.T) {
 						return &gitlab.MergeRequest{
 							BasicMergeRequest: gitlab.BasicMergeRequest{
 								IID:          42,
+								ProjectID:    3,
 								SourceBranch: "Branch2",
 								TargetBranch: "Branch1",
 								Title:        "multi line desc",
 								Description:  "description, bark!",
+								State:        "opened",
 							},
 						}, nil, nil
 					})
+
+				// the stack block is added to both descriptions
+				testClient.MockMergeRequests.EXPECT().
+					UpdateMergeRequest(int64(3), int64(25), gomock.Any()).
+					DoAndReturn(func(pid any, mr int64, opts *gitlab.UpdateMergeRequestOptions, options ...gitlab.RequestOptionFunc) (*gitlab.MergeRequest, *gitlab.Response, error) {
+						assert.Equal(t, "test mr description25\n\n"+
+							"<!-- glab-stack:start -->\n"+
+							"**Stack `my cool stack`**, managed by `glab stack sync`:\n\n"+
+							"1. **!25 test mr title** (opened) ← this merge request\n"+
+							"1. !42 multi line desc (opened)\n"+
+							"<!-- glab-stack:end -->", *opts.Description)
+						return &gitlab.MergeRequest{}, nil, nil
+					})
+				testClient.MockMergeRequests.EXPECT().
+					UpdateMergeRequest(int64(3), int64(42), gomock.Any()).
+					Return(&gitlab.MergeRequest{}, nil, nil)
 			},
 		},
 
@@ -293,6 +311,7 @@ func Test_stackSync(t *testing.T) {
 								IID:          25,
 								ProjectID:    3,
 								SourceBranch: "Branch1",
+								TargetBranch: "main",
 								State:        "opened",
 							},
 						}, nil, nil
@@ -307,10 +326,15 @@ func Test_stackSync(t *testing.T) {
 							IID:          25,
 							ProjectID:    3,
 							SourceBranch: "Branch1",
+							TargetBranch: "main",
 							State:        "opened",
 						},
 					}, nil, nil)
 
+				testClient.MockMergeRequests.EXPECT().
+					UpdateMergeRequest(int64(3), int64(25), gomock.Any()).
+					Return(&gitlab.MergeRequest{}, nil, nil)
+
 				// Create MRs for Branch2-6
 				for i := 2; i <= 6; i++ {
 					testClient.MockMergeRequests.EXPECT().
@@ -413,12 +437,23 @@ func Test_stackSync(t *testing.T) {
 					mockCmd.EXPECT().Git([]string{"pull"}).Return(state, nil)
 
 				case BranchHasDiverged:
-					mockCmd.EXPECT().Git([]string{"checkout", stack.Last().Branch})
-					mockCmd.EXPECT().Git([]string{"rebase", "--fork-point", "--update-refs", ref.Branch})
+					mockCmd.EXPECT().Git([]string{"branch", "--show-current"}).Return(ref.Branch, nil)
+					for parent := ref; !parent.IsLast(); parent = stack.Refs[parent.Next] {
+						child := stack.Refs[parent.Next]
+						mockCmd.EXPECT().Git([]string{"merge-base", "--fork-point", parent.Branch, child.Branch}).Return("abc", nil)
+						mockCmd.EXPECT().Git([]string{"rev-parse", child.Branch}).Return("def", nil)
+						mockCmd.EXPECT().Git([]string{"rebase", "--onto", parent.Branch, "abc", child.Branch})
+					}
+					mockCmd.EXPECT().Git([]string{"checkout", ref.Branch})
 
 				case NothingToCommit:
 				}
 
+				if ref.MR != "" && ref.IsFirst() && tc.args.stack.baseBranch == "" {
+					// the first merge request is retargeted to the default branch
+					mockCmd.EXPECT().Git([]string{"remote", "show", "origin"}).Return("HEAD branch: main", nil)
+				}
+
 				if ref.MR == "" {
 					if ref.IsFirst() == true {
 						if tc.args.stack.baseBranch != "" {
@@ -465,3 +500,49 @@ func createStack(t *testing.T, title string, scenario map[string]TestRef) {
 		require.NoError(t, err)
 	}
 }
+
+func Test_retargetMR(t *testing.T) {
+	stack := git.Stack{Title: "cool-stack", Refs: map[string]git.StackRef{
+		"1": {SHA: "1", Next: "2", Branch: "Branch1"},
+		"2": {SHA: "2", Prev: "1", Branch: "Branch2"},
+	}}
+	ios, _, _, _ := cmdtest.TestIOStreams()
+
+	t.Run("retargets merge requests with a different parent", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockMergeRequests.EXPECT().
+			UpdateMergeRequest(int64(3), int64(2), &gitlab.UpdateMergeRequestOptions{TargetBranch: gitlab.Ptr("Branch1")}).
+			Return(&gitlab.MergeRequest{}, nil, nil)
+
+		ref := stack.Refs["2"]
+		mr := &gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 2, ProjectID: 3, State: "opened", TargetBranch: "old-branch"}}
+
+		require.NoError(t, retargetMR(ios, testClient.Client, &ref, mr, &stack, git.StandardGitCommand{}))
+	})
+
+	t.Run("keeps merge requests with the right parent", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+
+		ref := stack.Refs["2"]
+		mr := &gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 2, ProjectID: 3, State: "opened", TargetBranch: "Branch1"}}
+
+		require.NoError(t, retargetMR(ios, testClient.Client, &ref, mr, &stack, git.StandardGitCommand{}))
+	})
+
+	t.Run("retargets the first merge request to the base branch", func(t *testing.T) {
+		git.InitGitRepoWithCommit(t)
+		_, err := git.AddStackRefDir(stack.Title)
+		require.NoError(t, err)
+		require.NoError(t, git.AddStackBaseBranch(stack.Title, "main"))
+
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockMergeRequests.EXPECT().
+			UpdateMergeRequest(int64(3), int64(1), &gitlab.UpdateMergeRequestOptions{TargetBranch: gitlab.Ptr("main")}).
+			Return(&gitlab.MergeRequest{}, nil, nil)
+
+		ref := stack.Refs["1"]
+		mr := &gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{IID: 1, ProjectID: 3, State: "opened", TargetBranch: "Branch0"}}
+
+		require.NoError(t, retargetMR(ios, testClient.Client, &ref, mr, &stack, git.StandardGitCommand{}))
+	})
+}
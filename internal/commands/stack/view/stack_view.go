@@ -0,0 +1,412 @@
+package view
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/text"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+const keyHelp = "[::b]↑/↓[::-] select  [::b]enter[::-] check out  [::b]o[::-] open merge request  [::b]a[::-] amend  [::b]r[::-] reorder  [::b]q[::-] quit"
+
+type options struct {
+	io           *iostreams.IOStreams
+	config       func() config.Config
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	gr           git.GitRunner
+
+	// runGlab runs a glab command attached to the terminal. It is replaced in tests.
+	runGlab func(args ...string) error
+}
+
+// entry is a stack ref with the state of its branch and merge request.
+type entry struct {
+	ref       git.StackRef
+	shortSHA  string
+	current   bool
+	mr        *gitlab.MergeRequest
+	approvals *gitlab.MergeRequestApprovals
+}
+
+func NewCmdStackView(f cmdutils.Factory, gr git.GitRunner) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		config:       f.Config,
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		gr:           gr,
+		runGlab:      runGlab,
+	}
+
+	return &cobra.Command{
+		Use:   "view",
+		Short: "View and navigate the current stack in an interactive view. (EXPERIMENTAL)",
+		Long: heredoc.Doc(`
+			View every entry of the current stack in order, with its branch, commit, description,
+			and the state, pipeline status, and approvals of its merge request.
+
+			Key bindings:
+
+			- Up and down arrows, or k and j: select an entry.
+			- Enter: check out the branch of the entry.
+			- o: open the merge request of the entry in the browser.
+			- a: check out the entry and amend it with glab stack amend.
+			- r: reorder the stack with glab stack reorder.
+			- q or Esc: quit.
+
+			If the output is not a terminal, the entries are printed as a table.
+		`) + text.ExperimentalString,
+		Example: heredoc.Doc(`
+			$ glab stack view
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return fmt.Errorf("error connecting to GitLab: %w", err)
+	}
+
+	repo, err := o.baseRepo()
+	if err != nil {
+		return fmt.Errorf("error determining base repo: %w", err)
+	}
+
+	load := func() (git.Stack, []entry, error) {
+		stack, err := currentStack()
+		if err != nil {
+			return git.Stack{}, nil, err
+		}
+		entries, err := loadEntries(stack, client, repo.FullName(), o.gr)
+		return stack, entries, err
+	}
+
+	stack, entries, err := load()
+	if err != nil {
+		return err
+	}
+	if stack.Empty() {
+		return errors.New("you are on an empty stack. To use a stack, first save a diff.")
+	}
+
+	if !o.io.IsOutputTTY() {
+		o.printTable(entries)
+		return nil
+	}
+
+	return o.runTUI(stack, entries, repo, load)
+}
+
+func currentStack() (git.Stack, error) {
+	title, err := git.GetCurrentStackTitle()
+	if err != nil {
+		return git.Stack{}, fmt.Errorf("error getting current stack: %w", err)
+	}
+
+	stack, err := git.GatherStackRefs(title)
+	if err != nil {
+		return git.Stack{}, fmt.Errorf("error getting current stack references: %w", err)
+	}
+
+	return stack, nil
+}
+
+// loadEntries reads the commit of each branch in the stack, and its merge request: the one saved in
+// the ref, or else the open merge request of the branch.
+func loadEntries(stack git.Stack, client *gitlab.Client, project string, gr git.GitRunner) ([]entry, error) {
+	currentBranch, _ := git.CurrentBranch()
+
+	var entries []entry
+	for ref := range stack.Iter() {
+		e := entry{ref: ref, current: ref.Branch == currentBranch}
+
+		sha, err := gr.Git("rev-parse", "--short", ref.Branch)
+		if err == nil {
+			e.shortSHA = strings.TrimSpace(sha)
+		}
+
+		var iid int64
+		if ref.MR != "" {
+			id, _ := cmdutils.ParseMergeRequestFromURL(ref.MR, "")
+			if id == 0 {
+				return nil, fmt.Errorf("invalid merge request %q of branch %s.", ref.MR, ref.Branch)
+			}
+			iid = int64(id)
+		} else {
+			// The merge request of the branch may have been created outside of the stack.
+			mrs, _, err := client.MergeRequests.ListProjectMergeRequests(project, &gitlab.ListProjectMergeRequestsOptions{
+				SourceBranch: gitlab.Ptr(ref.Branch),
+				State:        gitlab.Ptr("opened"),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("error getting merge request of branch %s: %w", ref.Branch, err)
+			}
+			if len(mrs) > 0 {
+				iid = mrs[0].IID
+			}
+		}
+
+		if iid != 0 {
+			e.mr, _, err = client.MergeRequests.GetMergeRequest(project, iid, nil)
+			if err != nil {
+				return nil, fmt.Errorf("error getting merge request !%d: %w", iid, err)
+			}
+
+			// approvals are not available on every GitLab tier, so they are optional
+			e.approvals, _, _ = client.MergeRequestApprovals.GetConfiguration(project, iid)
+		}
+
+		entries = append(entries, e)
+	}
+
+	return entries, nil
+}
+
+func (e entry) mrText() string {
+	if e.mr == nil {
+		return "-"
+	}
+	return fmt.Sprintf("!%d %s", e.mr.IID, e.mr.State)
+}
+
+func (e entry) pipelineText() string {
+	if e.mr == nil || e.mr.HeadPipeline == nil {
+		return "-"
+	}
+	return e.mr.HeadPipeline.Status
+}
+
+func (e entry) approvalsText() string {
+	if e.approvals == nil {
+		return "-"
+	}
+	if e.approvals.ApprovalsRequired == 0 {
+		return fmt.Sprintf("%d", len(e.approvals.ApprovedBy))
+	}
+	return fmt.Sprintf("%d/%d", len(e.approvals.ApprovedBy), e.approvals.ApprovalsRequired)
+}
+
+func (o *options) printTable(entries []entry) {
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("", "BRANCH", "SHA", "DESCRIPTION", "MERGE REQUEST", "PIPELINE", "APPROVALS")
+	for _, e := range entries {
+		marker := ""
+		if e.current {
+			marker = ">"
+		}
+		table.AddRow(marker, e.ref.Branch, e.shortSHA, e.ref.Subject(), e.mrText(), e.pipelineText(), e.approvalsText())
+	}
+	o.io.LogInfo(table.String())
+}
+
+func (o *options) runTUI(stack git.Stack, entries []entry, repo glrepo.Interface, load func() (git.Stack, []entry, error)) error {
+	app := tview.NewApplication()
+
+	table := tview.NewTable().
+		SetSelectable(true, false).
+		SetFixed(1, 0)
+	table.
+		SetBackgroundColor(tcell.ColorDefault).
+		SetBorderPadding(1, 1, 2, 2).
+		SetBorder(true).
+		SetTitle(fmt.Sprintf(" Stack %s ", stack.Title))
+
+	status := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText(keyHelp)
+	status.SetBackgroundColor(tcell.ColorDefault)
+
+	layout := tview.NewFlex().
+		SetDirection(tview.FlexRow).
+		AddItem(table, 0, 1, true).
+		AddItem(status, 1, 0, false)
+
+	fillTable(table, entries)
+
+	selected := func() *entry {
+		row, _ := table.GetSelection()
+		if row < 1 || row > len(entries) {
+			return nil
+		}
+		return &entries[row-1]
+	}
+
+	reload := func() {
+		var err error
+		stack, entries, err = load()
+		if err != nil {
+			status.SetText(fmt.Sprintf("[red]%s", err))
+			return
+		}
+		table.SetTitle(fmt.Sprintf(" Stack %s ", stack.Title))
+		fillTable(table, entries)
+	}
+
+	// suspend runs a glab command in the terminal, and reloads the stack afterwards.
+	suspend := func(args ...string) {
+		var err error
+		app.Suspend(func() {
+			err = o.runGlab(args...)
+		})
+		reload()
+		if err != nil {
+			status.SetText(fmt.Sprintf("[red]glab %s: %s", strings.Join(args, " "), err))
+		}
+	}
+
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		e := selected()
+
+		switch {
+		case event.Key() == tcell.KeyEscape || event.Rune() == 'q':
+			app.Stop()
+			return nil
+		case e == nil:
+			return event
+		case event.Key() == tcell.KeyEnter:
+			if err := git.CheckoutBranch(e.ref.Branch, o.gr); err != nil {
+				status.SetText(fmt.Sprintf("[red]%s", err))
+				return nil
+			}
+			reload()
+			status.SetText(fmt.Sprintf("Checked out %s.  %s", e.ref.Branch, keyHelp))
+			return nil
+		case event.Rune() == 'o':
+			if e.mr == nil {
+				status.SetText(fmt.Sprintf("[yellow]%s has no merge request. Run glab stack sync to create one.", e.ref.Branch))
+				return nil
+			}
+			browser, _ := o.config().Get(repo.RepoHost(), "browser")
+			if err := utils.OpenInBrowser(e.mr.WebURL, browser); err != nil {
+				status.SetText(fmt.Sprintf("[red]%s", err))
+			}
+			return nil
+		case event.Rune() == 'a':
+			if err := git.CheckoutBranch(e.ref.Branch, o.gr); err != nil {
+				status.SetText(fmt.Sprintf("[red]%s", err))
+				return nil
+			}
+			suspend("stack", "amend")
+			return nil
+		case event.Rune() == 'r':
+			suspend("stack", "reorder")
+			return nil
+		}
+
+		return event
+	})
+
+	// select the current branch
+	for i, e := range entries {
+		if e.current {
+			table.Select(i+1, 0)
+		}
+	}
+
+	return app.SetRoot(layout, true).Run()
+}
+
+func fillTable(table *tview.Table, entries []entry) {
+	row, _ := table.GetSelection()
+	table.Clear()
+
+	for col, header := range []string{"", "BRANCH", "SHA", "DESCRIPTION", "MERGE REQUEST", "PIPELINE", "APPROVALS"} {
+		table.SetCell(0, col, tview.NewTableCell(header).
+			SetAttributes(tcell.AttrBold).
+			SetSelectable(false))
+	}
+
+	for i, e := range entries {
+		marker := " "
+		if e.current {
+			marker = "▶"
+		}
+
+		cells := []*tview.TableCell{
+			tview.NewTableCell(marker).SetTextColor(tcell.ColorGreen),
+			tview.NewTableCell(e.ref.Branch),
+			tview.NewTableCell(e.shortSHA).SetTextColor(tcell.ColorYellow),
+			tview.NewTableCell(e.ref.Subject()).SetExpansion(1),
+			tview.NewTableCell(e.mrText()).SetTextColor(stateColor(e.mr)),
+			tview.NewTableCell(e.pipelineText()).SetTextColor(pipelineColor(e.pipelineText())),
+			tview.NewTableCell(e.approvalsText()),
+		}
+		for col, cell := range cells {
+			table.SetCell(i+1, col, cell)
+		}
+	}
+
+	if row >= 1 && row <= len(entries) {
+		table.Select(row, 0)
+	}
+}
+
+func stateColor(mr *gitlab.MergeRequest) tcell.Color {
+	if mr == nil {
+		return tcell.ColorGrey
+	}
+
+	switch mr.State {
+	case "opened":
+		return tcell.ColorGreen
+	case "merged":
+		return tcell.ColorBlue
+	default:
+		return tcell.ColorRed
+	}
+}
+
+func pipelineColor(status string) tcell.Color {
+	switch status {
+	case "success":
+		return tcell.ColorGreen
+	case "failed", "canceled":
+		return tcell.ColorRed
+	case "running", "pending", "created":
+		return tcell.ColorYellow
+	default:
+		return tcell.ColorGrey
+	}
+}
+
+// runGlab runs the current glab executable with its input and output attached to the terminal.
+func runGlab(args ...string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(executable, args...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
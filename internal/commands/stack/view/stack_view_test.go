@@ -0,0 +1,79 @@
+//go:build !integration
+
+package view
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/git"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func setupStack(t *testing.T) {
+	t.Helper()
+
+	git.InitGitRepoWithCommit(t)
+	require.NoError(t, git.SetLocalConfig("glab.currentstack", "cool-stack"))
+
+	refs := map[string]git.StackRef{
+		"1": {SHA: "1", Prev: "", Next: "2", Branch: "Branch1", Description: "First change", MR: "https://gitlab.com/OWNER/REPO/-/merge_requests/1"},
+		"2": {SHA: "2", Prev: "1", Next: "", Branch: "Branch2", Description: "Second change"},
+	}
+	require.NoError(t, git.CreateRefFiles(refs, "cool-stack"))
+	git.CreateBranches(t, []string{"Branch1", "Branch2"})
+}
+
+func TestStackView_table(t *testing.T) {
+	setupStack(t)
+
+	tc := gitlabtesting.NewTestClient(t)
+	// The merge request of Branch1 is saved in the stack, and Branch2 has no open merge request.
+	tc.MockMergeRequests.EXPECT().
+		ListProjectMergeRequests("OWNER/REPO", &gitlab.ListProjectMergeRequestsOptions{SourceBranch: gitlab.Ptr("Branch2"), State: gitlab.Ptr("opened")}).
+		Return([]*gitlab.BasicMergeRequest{}, nil, nil)
+	tc.MockMergeRequests.EXPECT().
+		GetMergeRequest("OWNER/REPO", int64(1), nil).
+		Return(&gitlab.MergeRequest{
+			BasicMergeRequest: gitlab.BasicMergeRequest{IID: 1, State: "opened"},
+			HeadPipeline:      &gitlab.Pipeline{Status: "success"},
+		}, nil, nil)
+	tc.MockMergeRequestApprovals.EXPECT().
+		GetConfiguration("OWNER/REPO", int64(1)).
+		Return(&gitlab.MergeRequestApprovals{
+			ApprovalsRequired: 2,
+			ApprovedBy:        []*gitlab.MergeRequestApproverUser{{User: &gitlab.BasicUser{Username: "alice"}}},
+		}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, func(f cmdutils.Factory) *cobra.Command {
+		return NewCmdStackView(f, git.StandardGitCommand{})
+	}, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("")
+	require.NoError(t, err)
+
+	output := out.OutBuf.String()
+	assert.Contains(t, output, "Branch1")
+	assert.Contains(t, output, "First change")
+	assert.Contains(t, output, "!1 opened")
+	assert.Contains(t, output, "success")
+	assert.Contains(t, output, "1/2")
+	assert.Contains(t, output, "Second change")
+}
+
+func Test_entryText(t *testing.T) {
+	e := entry{}
+	assert.Equal(t, "-", e.mrText())
+	assert.Equal(t, "-", e.pipelineText())
+	assert.Equal(t, "-", e.approvalsText())
+
+	e.approvals = &gitlab.MergeRequestApprovals{ApprovedBy: []*gitlab.MergeRequestApproverUser{{}}}
+	assert.Equal(t, "1", e.approvalsText())
+}
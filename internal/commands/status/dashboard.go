@@ -0,0 +1,231 @@
+package status
+
+import (
+	"fmt"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/commands/todo/todoutils"
+)
+
+// Item is an issue, a merge request, or a to-do item that needs the attention of the user.
+type Item struct {
+	// Reference is the full reference of the item, like group/project!12.
+	Reference string `json:"reference"`
+	Title     string `json:"title"`
+	// Reason says why the item needs attention, like "pipeline failed".
+	Reason    string     `json:"reason,omitempty"`
+	WebURL    string     `json:"web_url"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// Counts are the numbers of items of each section, for status bars. They include the items
+// that aren't listed because a section is limited to perPage items.
+type Counts struct {
+	Reviews       int `json:"reviews"`
+	MergeRequests int `json:"merge_requests"`
+	Issues        int `json:"issues"`
+	Mentions      int `json:"mentions"`
+	Todos         int `json:"todos"`
+}
+
+// Dashboard is what needs the attention of the user on one GitLab instance.
+type Dashboard struct {
+	Host string `json:"host"`
+	User string `json:"user,omitempty"`
+	// Error is set when the dashboard of the instance couldn't be loaded, and the other
+	// fields are then empty.
+	Error string `json:"error,omitempty"`
+
+	Counts Counts `json:"counts"`
+	// Reviews are the merge requests awaiting the review of the user.
+	Reviews []*Item `json:"reviews"`
+	// MergeRequests are the merge requests of the user with a failed pipeline or unresolved
+	// threads.
+	MergeRequests []*Item `json:"merge_requests"`
+	// Issues are the open issues assigned to the user.
+	Issues []*Item `json:"issues"`
+	// Mentions and Todos are the pending to-do items of the user, split between the ones
+	// created by mentions and the others.
+	Mentions []*Item `json:"mentions"`
+	Todos    []*Item `json:"todos"`
+}
+
+// perPage is the maximum number of items of each section.
+const perPage = 20
+
+// load returns the dashboard of the instance of the client.
+func load(client *gitlab.Client, host string) (*Dashboard, error) {
+	user, _, err := client.Users.CurrentUser()
+	if err != nil {
+		return nil, err
+	}
+	d := &Dashboard{
+		Host:          host,
+		User:          user.Username,
+		Reviews:       []*Item{},
+		MergeRequests: []*Item{},
+		Issues:        []*Item{},
+		Mentions:      []*Item{},
+		Todos:         []*Item{},
+	}
+
+	reviews, resp, err := client.MergeRequests.ListMergeRequests(&gitlab.ListMergeRequestsOptions{
+		ListOptions:      gitlab.ListOptions{PerPage: perPage},
+		State:            gitlab.Ptr("opened"),
+		Scope:            gitlab.Ptr("all"),
+		ReviewerUsername: gitlab.Ptr(user.Username),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing merge requests awaiting review: %w", err)
+	}
+	for _, mr := range reviews {
+		d.Reviews = append(d.Reviews, mrItem(mr, ""))
+	}
+	d.Counts.Reviews = total(resp, len(reviews))
+
+	// Only the merge requests needing attention are counted, so all of them are checked.
+	mrOptions := &gitlab.ListMergeRequestsOptions{
+		ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		State:       gitlab.Ptr("opened"),
+		Scope:       gitlab.Ptr("created_by_me"),
+	}
+	mrs, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.BasicMergeRequest, *gitlab.Response, error) {
+		return client.MergeRequests.ListMergeRequests(mrOptions, p)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing merge requests: %w", err)
+	}
+	for _, mr := range mrs {
+		reason, err := attention(client, mr)
+		if err != nil {
+			return nil, err
+		}
+		if reason == "" {
+			continue
+		}
+		d.Counts.MergeRequests++
+		if len(d.MergeRequests) < perPage {
+			d.MergeRequests = append(d.MergeRequests, mrItem(mr, reason))
+		}
+	}
+
+	issues, resp, err := client.Issues.ListIssues(&gitlab.ListIssuesOptions{
+		ListOptions: gitlab.ListOptions{PerPage: perPage},
+		State:       gitlab.Ptr("opened"),
+		Scope:       gitlab.Ptr("assigned_to_me"),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing issues: %w", err)
+	}
+	for _, issue := range issues {
+		item := &Item{Title: issue.Title, WebURL: issue.WebURL, UpdatedAt: issue.UpdatedAt}
+		if issue.References != nil {
+			item.Reference = issue.References.Full
+		}
+		d.Issues = append(d.Issues, item)
+	}
+	d.Counts.Issues = total(resp, len(issues))
+
+	// Mentions and other to-do items are counted separately, so all of them are fetched.
+	todoOptions := &gitlab.ListTodosOptions{
+		ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		State:       gitlab.Ptr("pending"),
+	}
+	todos, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Todo, *gitlab.Response, error) {
+		return client.Todos.ListTodos(todoOptions, p)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing to-do items: %w", err)
+	}
+	for _, t := range todos {
+		item := &Item{
+			Reference: todoutils.Reference(t),
+			Title:     todoutils.Title(t),
+			Reason:    string(t.ActionName),
+			WebURL:    t.TargetURL,
+			UpdatedAt: t.CreatedAt,
+		}
+		if t.ActionName == gitlab.TodoMentioned || t.ActionName == gitlab.TodoDirectlyAddressed {
+			d.Counts.Mentions++
+			if len(d.Mentions) < perPage {
+				d.Mentions = append(d.Mentions, item)
+			}
+		} else {
+			d.Counts.Todos++
+			if len(d.Todos) < perPage {
+				d.Todos = append(d.Todos, item)
+			}
+		}
+	}
+
+	return d, nil
+}
+
+// total returns the number of items of a list from the X-Total header of its first page.
+// GitLab leaves the header out when counting would be too slow, and then only the items of
+// the page are counted.
+func total(resp *gitlab.Response, page int) int {
+	if resp != nil && resp.TotalItems > int64(page) {
+		return int(resp.TotalItems)
+	}
+	return page
+}
+
+func mrItem(mr *gitlab.BasicMergeRequest, reason string) *Item {
+	item := &Item{Title: mr.Title, Reason: reason, WebURL: mr.WebURL, UpdatedAt: mr.UpdatedAt}
+	if mr.References != nil {
+		item.Reference = mr.References.Full
+	}
+	return item
+}
+
+// attention returns why a merge request of the user needs their attention, or "" when it
+// doesn't. The pipeline and the threads aren't in the list of merge requests, so they're fetched
+// for each of them.
+func attention(client *gitlab.Client, mr *gitlab.BasicMergeRequest) (string, error) {
+	pipelines, _, err := client.MergeRequests.ListMergeRequestPipelines(mr.ProjectID, mr.IID)
+	if err != nil {
+		return "", fmt.Errorf("error listing the pipelines of merge request !%d: %w", mr.IID, err)
+	}
+
+	unresolved, err := unresolvedThreads(client, mr)
+	if err != nil {
+		return "", err
+	}
+
+	failed := len(pipelines) > 0 && pipelines[0].Status == "failed"
+	switch {
+	case failed && unresolved:
+		return "pipeline failed, unresolved threads", nil
+	case failed:
+		return "pipeline failed", nil
+	case unresolved:
+		return "unresolved threads", nil
+	}
+	return "", nil
+}
+
+// unresolvedThreads reports whether the merge request has threads to resolve. The
+// blocking_discussions_resolved field of the merge request can't tell, because it's also
+// true when the project doesn't require threads to be resolved before merging.
+func unresolvedThreads(client *gitlab.Client, mr *gitlab.BasicMergeRequest) (bool, error) {
+	options := &gitlab.ListMergeRequestDiscussionsOptions{ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage}}
+	discussions, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Discussion, *gitlab.Response, error) {
+		return client.Discussions.ListMergeRequestDiscussions(mr.ProjectID, mr.IID, options, p)
+	})
+	if err != nil {
+		return false, fmt.Errorf("error listing the threads of merge request !%d: %w", mr.IID, err)
+	}
+
+	for _, d := range discussions {
+		for _, note := range d.Notes {
+			if note.Resolvable && !note.Resolved {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
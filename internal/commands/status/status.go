@@ -0,0 +1,230 @@
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// minInterval keeps --watch from polling the API too often, because each refresh makes
+// several requests to each instance.
+const minInterval = 10 * time.Second
+
+type options struct {
+	io        *iostreams.IOStreams
+	apiClient func(repoHost string) (*api.Client, error)
+	config    func() config.Config
+
+	outputFormat string
+	watch        bool
+	interval     time.Duration
+}
+
+func NewCmdStatus(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:        f.IO(),
+		apiClient: f.ApiClient,
+		config:    f.Config,
+	}
+
+	statusCmd := &cobra.Command{
+		Use:   "status [flags]",
+		Short: `View what needs your attention on all your GitLab instances.`,
+		Long: heredoc.Docf(`
+			View what needs your attention on all the GitLab instances you're authenticated with:
+
+			- Merge requests awaiting your review.
+			- Your merge requests with a failed pipeline or unresolved threads.
+			- Open issues assigned to you.
+			- Mentions and other pending to-do items.
+
+			The instances are queried in parallel. With %[1]s--output json%[1]s, the status of each
+			instance includes the number of items of each section, for status bars.
+
+			With %[1]s--watch%[1]s, the status is refreshed until you stop the command with %[1]sCtrl+C%[1]s.
+			With JSON output, each refresh prints one line.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab status
+			$ glab status --output json
+			$ glab status --watch --interval 5m
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.watch {
+				if opts.interval < minInterval {
+					return &cmdutils.FlagError{Err: fmt.Errorf("--interval must be at least %s.", minInterval)}
+				}
+				return opts.runWatch(cmd.Context())
+			}
+			return opts.run()
+		},
+	}
+
+	fl := statusCmd.Flags()
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+	fl.BoolVar(&opts.watch, "watch", false, "Keep running and refresh the status.")
+	fl.DurationVar(&opts.interval, "interval", time.Minute, "How often to refresh the status with --watch.")
+
+	return statusCmd
+}
+
+// loadAll returns the dashboards of all the configured instances, in the order of the
+// configuration. An instance that fails has its error in its dashboard, so that the others
+// are still shown.
+func (o *options) loadAll() ([]*Dashboard, error) {
+	// A configuration without hosts reports them as not found.
+	hosts, err := o.config().Hosts()
+	var notFound *config.NotFoundError
+	if err != nil && !errors.As(err, &notFound) {
+		return nil, err
+	}
+	if len(hosts) == 0 {
+		return nil, errors.New("no GitLab instances have been authenticated with glab. Run `glab auth login` to authenticate.")
+	}
+
+	dashboards := make([]*Dashboard, len(hosts))
+	var wg sync.WaitGroup
+	for i, host := range hosts {
+		wg.Go(func() {
+			d, err := o.load(host)
+			if err != nil {
+				d = &Dashboard{Host: host, Error: err.Error()}
+			}
+			dashboards[i] = d
+		})
+	}
+	wg.Wait()
+
+	return dashboards, nil
+}
+
+func (o *options) load(host string) (*Dashboard, error) {
+	client, err := o.apiClient(host)
+	if err != nil {
+		return nil, err
+	}
+	return load(client.Lab(), host)
+}
+
+func (o *options) run() error {
+	dashboards, err := o.loadAll()
+	if err != nil {
+		return err
+	}
+	if err := o.print(dashboards); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, d := range dashboards {
+		if d.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("could not load the status of %s.", utils.Pluralize(failed, "GitLab instance"))
+	}
+	return nil
+}
+
+// runWatch refreshes the status until the context is cancelled. Errors of instances are shown
+// with their status and don't stop watching.
+func (o *options) runWatch(ctx context.Context) error {
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+	for {
+		dashboards, err := o.loadAll()
+		if err != nil {
+			return err
+		}
+
+		// In a terminal, the status replaces the previous one.
+		redraw := o.outputFormat == "text" && o.io.IsaTTY
+		if redraw {
+			fmt.Fprint(o.io.StdOut, "\x1b[H\x1b[2J")
+		}
+		if err := o.print(dashboards); err != nil {
+			return err
+		}
+		if redraw {
+			fmt.Fprintln(o.io.StdOut, o.io.Color().Gray(fmt.Sprintf("Updated at %s. Refreshing every %s.", time.Now().Format("15:04:05"), o.interval)))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (o *options) print(dashboards []*Dashboard) error {
+	if o.outputFormat == "json" {
+		return o.io.PrintJSON(dashboards)
+	}
+
+	c := o.io.Color()
+	for i, d := range dashboards {
+		if i > 0 {
+			fmt.Fprintln(o.io.StdOut)
+		}
+		if d.Error != "" {
+			fmt.Fprintf(o.io.StdOut, "%s\n  %s %s\n", c.Bold(d.Host), c.FailedIcon(), d.Error)
+			continue
+		}
+
+		fmt.Fprintf(o.io.StdOut, "%s %s\n", c.Bold(d.Host), c.Gray("@"+d.User))
+		sections := []struct {
+			title string
+			count int
+			items []*Item
+		}{
+			{"Awaiting your review", d.Counts.Reviews, d.Reviews},
+			{"Your merge requests needing attention", d.Counts.MergeRequests, d.MergeRequests},
+			{"Issues assigned to you", d.Counts.Issues, d.Issues},
+			{"Mentions", d.Counts.Mentions, d.Mentions},
+			{"To-do items", d.Counts.Todos, d.Todos},
+		}
+		empty := true
+		for _, s := range sections {
+			if len(s.items) == 0 {
+				continue
+			}
+			empty = false
+
+			fmt.Fprintf(o.io.StdOut, "\n  %s %s\n", c.Bold(s.title), c.Gray(fmt.Sprintf("(%d)", s.count)))
+			table := tableprinter.NewTablePrinter()
+			for _, item := range s.items {
+				updated := ""
+				if item.UpdatedAt != nil {
+					updated = c.Gray(utils.TimeToPrettyTimeAgo(*item.UpdatedAt))
+				}
+				reason := c.Yellow(strings.ReplaceAll(item.Reason, "_", " "))
+				table.AddRow("   ", item.Reference, item.Title, reason, updated)
+			}
+			fmt.Fprint(o.io.StdOut, table.Render())
+		}
+		if empty {
+			fmt.Fprintf(o.io.StdOut, "  %s Nothing needs your attention.\n", c.GreenCheck())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package timetracking
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func newCmdEstimate(f cmdutils.Factory, target *Target) *cobra.Command {
+	estimateCmd := &cobra.Command{
+		Use:   target.use("estimate", "<duration>"),
+		Short: fmt.Sprintf(`Set the time estimate of %s %s.`, article(target.name), target.name),
+		Long:  ``,
+		Example: heredoc.Docf(`
+			$ glab %[1]s time estimate 42 3d
+			$ glab %[1]s time estimate 42 1w2d4h
+		`, target.command),
+		Args: target.args(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			idArgs, rest := split(args, 1)
+			duration := rest[0]
+
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+			it, err := target.resolve(cmd.Context(), f, client, idArgs)
+			if err != nil {
+				return err
+			}
+
+			stats, _, err := target.service(client).SetTimeEstimate(it.repo.FullName(), it.iid, &gitlab.SetTimeEstimateOptions{Duration: gitlab.Ptr(duration)})
+			if err != nil {
+				return cmdutils.WrapError(err, "failed to set the time estimate")
+			}
+
+			fmt.Fprintf(f.IO().StdOut, "%s Set time estimate of %s to %s\n", f.IO().Color().GreenCheck(), target.ref(it.iid), stats.HumanTimeEstimate)
+			fmt.Fprintln(f.IO().StdOut, summary(stats))
+			return nil
+		},
+	}
+
+	return estimateCmd
+}
+
+// article returns the indefinite article of the name of a target.
+func article(name string) string {
+	if name == "issue" {
+		return "an"
+	}
+	return "a"
+}
@@ -0,0 +1,97 @@
+package timetracking
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func newCmdReset(f cmdutils.Factory, target *Target) *cobra.Command {
+	var estimate, spent, yes bool
+
+	resetCmd := &cobra.Command{
+		Use:   target.use("reset", ""),
+		Short: fmt.Sprintf(`Reset the time estimate or the time spent on %s %s.`, article(target.name), target.name),
+		Long: heredoc.Docf(`
+			Reset the time estimate and delete all the time logged on %[2]s %[3]s.
+			Use %[1]s--estimate%[1]s or %[1]s--spent%[1]s to reset only one of them.
+		`, "`", article(target.name), target.name),
+		Example: heredoc.Docf(`
+			$ glab %[1]s time reset 42
+			$ glab %[1]s time reset 42 --spent --yes
+		`, target.command),
+		Args: target.args(0),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if !estimate && !spent {
+				estimate, spent = true, true
+			}
+			if !yes && !f.IO().PromptEnabled() {
+				return &cmdutils.FlagError{Err: errors.New("--yes is required when not running interactively.")}
+			}
+
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+			it, err := target.resolve(cmd.Context(), f, client, args)
+			if err != nil {
+				return err
+			}
+			svc := target.service(client)
+
+			if !yes {
+				what := "the time estimate and all the time logged"
+				switch {
+				case !spent:
+					what = "the time estimate"
+				case !estimate:
+					what = "all the time logged"
+				}
+				confirmed := false
+				err := f.IO().Confirm(cmd.Context(), &confirmed, fmt.Sprintf("Reset %s on %s?", what, target.ref(it.iid)))
+				if err != nil {
+					return cmdutils.WrapError(err, "could not prompt")
+				}
+				if !confirmed {
+					return cmdutils.CancelError()
+				}
+			}
+
+			c := f.IO().Color()
+			var stats *gitlab.TimeStats
+			if estimate {
+				stats, _, err = svc.ResetTimeEstimate(it.repo.FullName(), it.iid)
+				if err != nil {
+					return cmdutils.WrapError(err, "failed to reset the time estimate")
+				}
+				fmt.Fprintf(f.IO().StdOut, "%s Reset the time estimate of %s\n", c.RedCheck(), target.ref(it.iid))
+			}
+			if spent {
+				stats, _, err = svc.ResetSpentTime(it.repo.FullName(), it.iid)
+				if err != nil {
+					return cmdutils.WrapError(err, "failed to reset the time spent")
+				}
+				fmt.Fprintf(f.IO().StdOut, "%s Reset the time spent on %s\n", c.RedCheck(), target.ref(it.iid))
+			}
+			fmt.Fprintln(f.IO().StdOut, summary(stats))
+			return nil
+		},
+	}
+
+	resetCmd.Flags().BoolVar(&estimate, "estimate", false, "Only reset the time estimate.")
+	resetCmd.Flags().BoolVar(&spent, "spent", false, "Only reset the time spent.")
+	resetCmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt.")
+	resetCmd.MarkFlagsMutuallyExclusive("estimate", "spent")
+
+	return resetCmd
+}
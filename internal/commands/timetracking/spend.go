@@ -0,0 +1,85 @@
+package timetracking
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func newCmdSpend(f cmdutils.Factory, target *Target) *cobra.Command {
+	var date, summaryText string
+
+	spendCmd := &cobra.Command{
+		Use:   target.use("spend", "<duration>"),
+		Short: fmt.Sprintf(`Log time spent on %s %s.`, article(target.name), target.name),
+		Long: heredoc.Docf(`
+			Log time spent on %[2]s %[3]s. The time is logged today, unless you give another day
+			with %[1]s--date%[1]s. Use a negative duration, like %[1]s-30m%[1]s, to remove time logged by mistake.
+		`, "`", article(target.name), target.name),
+		Example: heredoc.Docf(`
+			$ glab %[1]s time spend 42 2h30m
+			$ glab %[1]s time spend 42 1d --date 2026-10-12 --summary "Load testing"
+			$ glab %[1]s time spend 42 -- -30m
+		`, target.command),
+		Args: target.args(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			idArgs, rest := split(args, 1)
+			duration := rest[0]
+
+			var spentAt time.Time
+			if date != "" {
+				var err error
+				spentAt, err = time.ParseInLocation(time.DateOnly, date, time.Local)
+				if err != nil {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid date %q. Use the YYYY-MM-DD format.", date)}
+				}
+			}
+
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+			it, err := target.resolve(cmd.Context(), f, client, idArgs)
+			if err != nil {
+				return err
+			}
+
+			svc := target.service(client)
+			var stats *gitlab.TimeStats
+			if date == "" {
+				opts := &gitlab.AddSpentTimeOptions{Duration: gitlab.Ptr(duration)}
+				if summaryText != "" {
+					opts.Summary = gitlab.Ptr(summaryText)
+				}
+				stats, _, err = svc.AddSpentTime(it.repo.FullName(), it.iid, opts)
+			} else {
+				err = target.createTimelog(client, it, duration, spentAt, summaryText)
+				if err == nil {
+					stats, _, err = svc.GetTimeSpent(it.repo.FullName(), it.iid)
+				}
+			}
+			if err != nil {
+				return cmdutils.WrapError(err, "failed to log time")
+			}
+
+			fmt.Fprintf(f.IO().StdOut, "%s Logged %s on %s\n", f.IO().Color().GreenCheck(), duration, target.ref(it.iid))
+			fmt.Fprintln(f.IO().StdOut, summary(stats))
+			return nil
+		},
+	}
+
+	spendCmd.Flags().StringVar(&date, "date", "", "Day the time was spent, in YYYY-MM-DD format. Defaults to today.")
+	spendCmd.Flags().StringVarP(&summaryText, "summary", "s", "", "Summary of the work done.")
+
+	return spendCmd
+}
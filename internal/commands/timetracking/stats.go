@@ -0,0 +1,91 @@
+package timetracking
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// Stats are the time estimate and the time spent on an item, with the time logs, as printed in
+// JSON.
+type Stats struct {
+	*gitlab.TimeStats
+	Timelogs []*Timelog `json:"timelogs"`
+}
+
+func newCmdStats(f cmdutils.Factory, target *Target) *cobra.Command {
+	var outputFormat string
+
+	statsCmd := &cobra.Command{
+		Use:   target.use("stats", ""),
+		Short: fmt.Sprintf(`Show the time estimate and the time logged on %s %s.`, article(target.name), target.name),
+		Long:  ``,
+		Example: heredoc.Docf(`
+			$ glab %[1]s time stats 42
+			$ glab %[1]s time stats 42 -F json
+		`, target.command),
+		Args: target.args(0),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+			it, err := target.resolve(cmd.Context(), f, client, args)
+			if err != nil {
+				return err
+			}
+
+			timeStats, _, err := target.service(client).GetTimeSpent(it.repo.FullName(), it.iid)
+			if err != nil {
+				return err
+			}
+			timelogs, err := target.listItemTimelogs(client, it)
+			if err != nil {
+				return err
+			}
+			stats := &Stats{TimeStats: timeStats, Timelogs: timelogs}
+
+			if outputFormat == "json" {
+				data, err := json.Marshal(stats)
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(f.IO().StdOut, string(data))
+				return nil
+			}
+
+			c := f.IO().Color()
+			out := f.IO().StdOut
+			fmt.Fprintf(out, "%s %s\n", c.Bold(fmt.Sprintf("%s%d", target.prefix, it.iid)), summary(timeStats))
+			if len(timelogs) == 0 {
+				fmt.Fprintln(out, c.Gray("No time logged."))
+				return nil
+			}
+
+			fmt.Fprintf(out, "\n%s\n", c.Bold(fmt.Sprintf("Time logs (%d)", len(timelogs))))
+			table := tableprinter.NewTablePrinter()
+			for _, t := range timelogs {
+				table.AddRow(t.SpentAt.In(time.Local).Format(time.DateOnly), t.User, utils.FmtTimeTracking(t.TimeSpent), t.Summary)
+			}
+			fmt.Fprint(out, table.Render())
+			return nil
+		},
+	}
+
+	statsCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &outputFormat), "output", "F", "Format output as: text, json.")
+
+	return statsCmd
+}
@@ -0,0 +1,266 @@
+package timetracking
+
+import (
+	"fmt"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+)
+
+// Timelog is time logged on an issue or a merge request.
+type Timelog struct {
+	SpentAt   time.Time `json:"spent_at"`
+	TimeSpent int64     `json:"time_spent"`
+	Summary   string    `json:"summary"`
+	User      string    `json:"user"`
+	Project   string    `json:"project"`
+	// Reference is the full reference of the issue or merge request, like group/project#12.
+	Reference string `json:"reference"`
+	Title     string `json:"title"`
+	WebURL    string `json:"web_url"`
+}
+
+const issuableFields = `
+  title
+  webUrl
+  reference(full: true)`
+
+const timelogFields = `
+  spentAt
+  timeSpent
+  summary
+  user {
+    username
+  }
+  project {
+    fullPath
+  }
+  issue {` + issuableFields + `
+  }
+  mergeRequest {` + issuableFields + `
+  }`
+
+type issuableNode struct {
+	Title     string `json:"title"`
+	WebURL    string `json:"webUrl"`
+	Reference string `json:"reference"`
+}
+
+type timelogNode struct {
+	SpentAt   time.Time `json:"spentAt"`
+	TimeSpent int64     `json:"timeSpent"`
+	Summary   string    `json:"summary"`
+	User      *struct {
+		Username string `json:"username"`
+	} `json:"user"`
+	Project *struct {
+		FullPath string `json:"fullPath"`
+	} `json:"project"`
+	Issue        *issuableNode `json:"issue"`
+	MergeRequest *issuableNode `json:"mergeRequest"`
+}
+
+func (n *timelogNode) timelog() *Timelog {
+	t := &Timelog{
+		SpentAt:   n.SpentAt,
+		TimeSpent: n.TimeSpent,
+		Summary:   n.Summary,
+	}
+	if n.User != nil {
+		t.User = n.User.Username
+	}
+	if n.Project != nil {
+		t.Project = n.Project.FullPath
+	}
+	issuable := n.Issue
+	if issuable == nil {
+		issuable = n.MergeRequest
+	}
+	if issuable != nil {
+		t.Reference = issuable.Reference
+		t.Title = issuable.Title
+		t.WebURL = issuable.WebURL
+	}
+	return t
+}
+
+type pageInfo struct {
+	HasNextPage bool   `json:"hasNextPage"`
+	EndCursor   string `json:"endCursor"`
+}
+
+type timelogConnection struct {
+	Nodes    []timelogNode `json:"nodes"`
+	PageInfo pageInfo      `json:"pageInfo"`
+}
+
+type itemTimelogs struct {
+	Timelogs timelogConnection `json:"timelogs"`
+}
+
+const itemTimelogsQuery = `
+query($fullPath: ID!, $iid: String!, $after: String) {
+  project(fullPath: $fullPath) {
+    %s(iid: $iid) {
+      timelogs(first: 100, after: $after) {
+        nodes {` + timelogFields + `
+        }
+        pageInfo {
+          hasNextPage
+          endCursor
+        }
+      }
+    }
+  }
+}`
+
+// listItemTimelogs returns the time logged on the item, in the order it was spent.
+func (t *Target) listItemTimelogs(client *gitlab.Client, it *item) ([]*Timelog, error) {
+	query := fmt.Sprintf(itemTimelogsQuery, t.field)
+	variables := map[string]any{
+		"fullPath": it.repo.FullName(),
+		"iid":      fmt.Sprint(it.iid),
+	}
+
+	timelogs := []*Timelog{}
+	for {
+		var resp struct {
+			Data struct {
+				Project *struct {
+					Issue        *itemTimelogs `json:"issue"`
+					MergeRequest *itemTimelogs `json:"mergeRequest"`
+				} `json:"project"`
+			} `json:"data"`
+			api.GraphQLErrors
+		}
+		if _, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: query, Variables: variables}, &resp); err != nil {
+			return nil, fmt.Errorf("error listing time logs: %w", err)
+		}
+		if err := resp.Err(); err != nil {
+			return nil, fmt.Errorf("error listing time logs: %w", err)
+		}
+		if resp.Data.Project == nil {
+			return nil, fmt.Errorf("project %s not found.", it.repo.FullName())
+		}
+
+		var c *timelogConnection
+		switch {
+		case resp.Data.Project.Issue != nil:
+			c = &resp.Data.Project.Issue.Timelogs
+		case resp.Data.Project.MergeRequest != nil:
+			c = &resp.Data.Project.MergeRequest.Timelogs
+		default:
+			return nil, fmt.Errorf("%s not found.", t.ref(it.iid))
+		}
+
+		for _, n := range c.Nodes {
+			timelogs = append(timelogs, n.timelog())
+		}
+		if !c.PageInfo.HasNextPage {
+			return timelogs, nil
+		}
+		variables["after"] = c.PageInfo.EndCursor
+	}
+}
+
+const timelogsQuery = `
+query($username: String, $groupId: GroupID, $startDate: Time, $endDate: Time, $after: String) {
+  timelogs(username: $username, groupId: $groupId, startDate: $startDate, endDate: $endDate, sort: SPENT_AT_ASC, first: 100, after: $after) {
+    nodes {` + timelogFields + `
+    }
+    pageInfo {
+      hasNextPage
+      endCursor
+    }
+  }
+}`
+
+// ListOptions are the filters of ListTimelogs.
+type ListOptions struct {
+	Username string
+	// GroupID is the ID of a group, to only list the time logged in its projects.
+	GroupID int64
+	// Since and Until are the first and the last days of the time logged, in YYYY-MM-DD format.
+	Since string
+	Until string
+}
+
+// ListTimelogs returns the time logged by a user, in the order it was spent.
+func ListTimelogs(client *gitlab.Client, opts *ListOptions) ([]*Timelog, error) {
+	variables := map[string]any{}
+	if opts.Username != "" {
+		variables["username"] = opts.Username
+	}
+	if opts.GroupID != 0 {
+		variables["groupId"] = fmt.Sprintf("gid://gitlab/Group/%d", opts.GroupID)
+	}
+	if opts.Since != "" {
+		variables["startDate"] = opts.Since
+	}
+	if opts.Until != "" {
+		variables["endDate"] = opts.Until
+	}
+
+	timelogs := []*Timelog{}
+	for {
+		var resp struct {
+			Data struct {
+				Timelogs *timelogConnection `json:"timelogs"`
+			} `json:"data"`
+			api.GraphQLErrors
+		}
+		if _, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: timelogsQuery, Variables: variables}, &resp); err != nil {
+			return nil, fmt.Errorf("error listing time logs: %w", err)
+		}
+		if err := resp.Err(); err != nil {
+			return nil, fmt.Errorf("error listing time logs: %w", err)
+		}
+		if resp.Data.Timelogs == nil {
+			return timelogs, nil
+		}
+
+		for _, n := range resp.Data.Timelogs.Nodes {
+			timelogs = append(timelogs, n.timelog())
+		}
+		if !resp.Data.Timelogs.PageInfo.HasNextPage {
+			return timelogs, nil
+		}
+		variables["after"] = resp.Data.Timelogs.PageInfo.EndCursor
+	}
+}
+
+const createTimelogMutation = `
+mutation($issuableId: IssuableID!, $timeSpent: String!, $spentAt: Time!, $summary: String!) {
+  timelogCreate(input: {issuableId: $issuableId, timeSpent: $timeSpent, spentAt: $spentAt, summary: $summary}) {
+    errors
+  }
+}`
+
+// createTimelog logs time spent on the item on another day than today, which the REST API
+// can't do.
+func (t *Target) createTimelog(client *gitlab.Client, it *item, duration string, spentAt time.Time, summary string) error {
+	variables := map[string]any{
+		"issuableId": t.gid(it.id),
+		"timeSpent":  duration,
+		"spentAt":    spentAt.Format(time.RFC3339),
+		"summary":    summary,
+	}
+
+	var resp struct {
+		Data struct {
+			TimelogCreate struct {
+				Errors []string `json:"errors"`
+			} `json:"timelogCreate"`
+		} `json:"data"`
+		api.GraphQLErrors
+	}
+	if _, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: createTimelogMutation, Variables: variables}, &resp); err != nil {
+		return err
+	}
+	if err := resp.Err(); err != nil {
+		return err
+	}
+	return api.MutationErr(resp.Data.TimelogCreate.Errors)
+}
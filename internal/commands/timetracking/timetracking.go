@@ -0,0 +1,154 @@
+package timetracking
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/issue/issueutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/mr/mrutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// service is the part of the issues and merge requests services that tracks time.
+type service interface {
+	SetTimeEstimate(pid any, iid int64, opt *gitlab.SetTimeEstimateOptions, options ...gitlab.RequestOptionFunc) (*gitlab.TimeStats, *gitlab.Response, error)
+	ResetTimeEstimate(pid any, iid int64, options ...gitlab.RequestOptionFunc) (*gitlab.TimeStats, *gitlab.Response, error)
+	AddSpentTime(pid any, iid int64, opt *gitlab.AddSpentTimeOptions, options ...gitlab.RequestOptionFunc) (*gitlab.TimeStats, *gitlab.Response, error)
+	ResetSpentTime(pid any, iid int64, options ...gitlab.RequestOptionFunc) (*gitlab.TimeStats, *gitlab.Response, error)
+	GetTimeSpent(pid any, iid int64, options ...gitlab.RequestOptionFunc) (*gitlab.TimeStats, *gitlab.Response, error)
+}
+
+// Target is the kind of item whose time is tracked: issues or merge requests.
+type Target struct {
+	name    string
+	command string
+	prefix  string
+	// field is the field of the item in the GraphQL project type.
+	field string
+	// gidType is the type of the item in GraphQL global IDs.
+	gidType string
+	// optionalID is set when the item defaults to the one of the current branch.
+	optionalID bool
+
+	service func(client *gitlab.Client) service
+	resolve func(ctx context.Context, f cmdutils.Factory, client *gitlab.Client, args []string) (*item, error)
+}
+
+// item is the issue or merge request whose time is tracked.
+type item struct {
+	id   int64
+	iid  int64
+	repo glrepo.Interface
+}
+
+var Issue = &Target{
+	name:    "issue",
+	command: "issue",
+	prefix:  "#",
+	field:   "issue",
+	gidType: "Issue",
+	service: func(client *gitlab.Client) service { return client.Issues },
+	resolve: func(ctx context.Context, f cmdutils.Factory, client *gitlab.Client, args []string) (*item, error) {
+		issue, repo, err := issueutils.IssueFromArg(f.ApiClient, client, f.BaseRepo, f.DefaultHostname(), args[0])
+		if err != nil {
+			return nil, err
+		}
+		return &item{id: issue.ID, iid: issue.IID, repo: repo}, nil
+	},
+}
+
+var MergeRequest = &Target{
+	name:       "merge request",
+	command:    "mr",
+	prefix:     "!",
+	field:      "mergeRequest",
+	gidType:    "MergeRequest",
+	optionalID: true,
+	service:    func(client *gitlab.Client) service { return client.MergeRequests },
+	resolve: func(ctx context.Context, f cmdutils.Factory, client *gitlab.Client, args []string) (*item, error) {
+		mr, repo, err := mrutils.MRFromArgs(ctx, f, args, "any")
+		if err != nil {
+			return nil, err
+		}
+		return &item{id: mr.ID, iid: mr.IID, repo: repo}, nil
+	},
+}
+
+func (t *Target) ref(iid int64) string {
+	return fmt.Sprintf("%s %s%d", t.name, t.prefix, iid)
+}
+
+// use returns the usage of a subcommand that takes the item and the given arguments.
+func (t *Target) use(name, args string) string {
+	id := "<id>"
+	if t.optionalID {
+		id = "[<id> | <branch>]"
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s %s %s [flags]", name, id, args))
+}
+
+// args validates the arguments of a subcommand that takes the item and n other arguments.
+func (t *Target) args(n int) cobra.PositionalArgs {
+	if t.optionalID {
+		return cobra.RangeArgs(n, n+1)
+	}
+	return cobra.ExactArgs(n + 1)
+}
+
+// split returns the arguments that select the item, and the other n arguments.
+func split(args []string, n int) ([]string, []string) {
+	return args[:len(args)-n], args[len(args)-n:]
+}
+
+func (t *Target) gid(id int64) string {
+	return "gid://gitlab/" + t.gidType + "/" + strconv.FormatInt(id, 10)
+}
+
+// NewCmdTime returns the time command of issues or merge requests.
+func NewCmdTime(f cmdutils.Factory, target *Target) *cobra.Command {
+	timeCmd := &cobra.Command{
+		Use:   "time <command> [flags]",
+		Short: fmt.Sprintf(`Track the time spent on %ss.`, target.name),
+		Long: heredoc.Docf(`
+			Estimate and log the time spent on %[2]ss.
+
+			Durations are given like in GitLab quick actions, with months, weeks, days, hours,
+			and minutes: %[1]s1mo 2w 3d 4h 30m%[1]s. A day is 8 hours, and a week is 5 days.
+		`, "`", target.name),
+		Example: heredoc.Docf(`
+			$ glab %[1]s time estimate 42 1d
+			$ glab %[1]s time spend 42 2h30m --summary "Code review"
+			$ glab %[1]s time stats 42
+		`, target.command),
+	}
+
+	timeCmd.AddCommand(newCmdEstimate(f, target))
+	timeCmd.AddCommand(newCmdSpend(f, target))
+	timeCmd.AddCommand(newCmdReset(f, target))
+	timeCmd.AddCommand(newCmdStats(f, target))
+	return timeCmd
+}
+
+// summary describes the time spent on an item, like "5h spent of 1d estimated, 3h remaining".
+func summary(stats *gitlab.TimeStats) string {
+	if stats.TimeEstimate == 0 {
+		return fmt.Sprintf("%s spent, no estimate", utils.FmtTimeTracking(stats.TotalTimeSpent))
+	}
+
+	s := fmt.Sprintf("%s spent of %s estimated", utils.FmtTimeTracking(stats.TotalTimeSpent), utils.FmtTimeTracking(stats.TimeEstimate))
+	if remaining := stats.TimeEstimate - stats.TotalTimeSpent; remaining >= 0 {
+		s += fmt.Sprintf(", %s remaining", utils.FmtTimeTracking(remaining))
+	} else {
+		s += fmt.Sprintf(", %s over", utils.FmtTimeTracking(-remaining))
+	}
+	return s
+}
@@ -0,0 +1,197 @@
+//go:build !integration
+
+package timetracking
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func issueTimeCmd(f cmdutils.Factory) *cobra.Command {
+	return NewCmdTime(f, Issue)
+}
+
+func mrTimeCmd(f cmdutils.Factory) *cobra.Command {
+	return NewCmdTime(f, MergeRequest)
+}
+
+func expectIssue(tc *gitlabtesting.TestClient) {
+	tc.MockIssues.EXPECT().
+		GetIssue("OWNER/REPO", int64(42), gomock.Any()).
+		Return(&gitlab.Issue{ID: 4200, IID: 42}, nil, nil)
+}
+
+func TestSummary(t *testing.T) {
+	tests := []struct {
+		stats gitlab.TimeStats
+		want  string
+	}{
+		{gitlab.TimeStats{TotalTimeSpent: 5400}, "1h 30m spent, no estimate"},
+		{gitlab.TimeStats{TimeEstimate: 8 * 3600, TotalTimeSpent: 5 * 3600}, "5h spent of 1d estimated, 3h remaining"},
+		{gitlab.TimeStats{TimeEstimate: 3600, TotalTimeSpent: 5400}, "1h 30m spent of 1h estimated, 30m over"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, summary(&tt.stats))
+	}
+}
+
+func TestTimeEstimate(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	expectIssue(tc)
+	tc.MockIssues.EXPECT().
+		SetTimeEstimate("OWNER/REPO", int64(42), &gitlab.SetTimeEstimateOptions{Duration: gitlab.Ptr("1d")}).
+		Return(&gitlab.TimeStats{HumanTimeEstimate: "1d", TimeEstimate: 8 * 3600, TotalTimeSpent: 3600}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, issueTimeCmd, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("estimate 42 1d")
+	require.NoError(t, err)
+	assert.Equal(t, "✓ Set time estimate of issue #42 to 1d\n1h spent of 1d estimated, 7h remaining\n", out.String())
+}
+
+func TestTimeSpend(t *testing.T) {
+	t.Run("today", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		tc.MockMergeRequests.EXPECT().
+			GetMergeRequest("OWNER/REPO", int64(12), gomock.Any()).
+			Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{ID: 1200, IID: 12}}, nil, nil)
+		tc.MockMergeRequests.EXPECT().
+			AddSpentTime("OWNER/REPO", int64(12), &gitlab.AddSpentTimeOptions{Duration: gitlab.Ptr("2h30m"), Summary: gitlab.Ptr("Code review")}).
+			Return(&gitlab.TimeStats{TotalTimeSpent: 9000}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, mrTimeCmd, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec(`spend 12 2h30m --summary "Code review"`)
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Logged 2h30m on merge request !12\n2h 30m spent, no estimate\n", out.String())
+	})
+
+	t.Run("another day", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		expectIssue(tc)
+		cmdtest.ExpectGraphQL(tc, `{"data": {"timelogCreate": {"errors": []}}}`, func(query gitlab.GraphQLQuery) {
+			assert.Equal(t, "gid://gitlab/Issue/4200", query.Variables["issuableId"])
+			assert.Equal(t, "1d", query.Variables["timeSpent"])
+			assert.Equal(t, time.Date(2026, 10, 12, 0, 0, 0, 0, time.Local).Format(time.RFC3339), query.Variables["spentAt"])
+			assert.Equal(t, "Load testing", query.Variables["summary"])
+		})
+		tc.MockIssues.EXPECT().
+			GetTimeSpent("OWNER/REPO", int64(42)).
+			Return(&gitlab.TimeStats{TotalTimeSpent: 8 * 3600}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, issueTimeCmd, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec(`spend 42 1d --date 2026-10-12 -s "Load testing"`)
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Logged 1d on issue #42\n1d spent, no estimate\n", out.String())
+	})
+
+	t.Run("invalid date", func(t *testing.T) {
+		exec := cmdtest.SetupCmdForTest(t, issueTimeCmd, false)
+
+		_, err := exec("spend 42 1d --date yesterday")
+		require.EqualError(t, err, `invalid date "yesterday". Use the YYYY-MM-DD format.`)
+	})
+}
+
+func TestTimeReset(t *testing.T) {
+	t.Run("spent only", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		expectIssue(tc)
+		tc.MockIssues.EXPECT().
+			ResetSpentTime("OWNER/REPO", int64(42)).
+			Return(&gitlab.TimeStats{TimeEstimate: 3600}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, issueTimeCmd, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("reset 42 --spent -y")
+		require.NoError(t, err)
+		assert.Equal(t, "✓ Reset the time spent on issue #42\n0h spent of 1h estimated, 1h remaining\n", out.String())
+	})
+
+	t.Run("both", func(t *testing.T) {
+		tc := gitlabtesting.NewTestClient(t)
+		expectIssue(tc)
+		tc.MockIssues.EXPECT().
+			ResetTimeEstimate("OWNER/REPO", int64(42)).
+			Return(&gitlab.TimeStats{TotalTimeSpent: 3600}, nil, nil)
+		tc.MockIssues.EXPECT().
+			ResetSpentTime("OWNER/REPO", int64(42)).
+			Return(&gitlab.TimeStats{}, nil, nil)
+
+		exec := cmdtest.SetupCmdForTest(t, issueTimeCmd, false, cmdtest.WithGitLabClient(tc.Client))
+
+		out, err := exec("reset 42 --yes")
+		require.NoError(t, err)
+		assert.Contains(t, out.String(), "Reset the time estimate of issue #42\n")
+		assert.Contains(t, out.String(), "Reset the time spent on issue #42\n0h spent, no estimate\n")
+	})
+
+	t.Run("requires --yes", func(t *testing.T) {
+		exec := cmdtest.SetupCmdForTest(t, issueTimeCmd, false)
+
+		_, err := exec("reset 42")
+		require.EqualError(t, err, "--yes is required when not running interactively.")
+	})
+}
+
+const timelogsResponse = `{"data": {"project": {"issue": {"timelogs": {
+	"nodes": [
+		{"spentAt": "2026-10-12T10:00:00Z", "timeSpent": 7200, "summary": "Load testing", "user": {"username": "alice"},
+		 "project": {"fullPath": "OWNER/REPO"}, "issue": {"title": "Slow invoices", "reference": "OWNER/REPO#42"}},
+		{"spentAt": "2026-10-13T10:00:00Z", "timeSpent": 1800, "summary": "", "user": {"username": "bob"},
+		 "project": {"fullPath": "OWNER/REPO"}, "issue": {"title": "Slow invoices", "reference": "OWNER/REPO#42"}}
+	],
+	"pageInfo": {"hasNextPage": false}
+}}}}}`
+
+func TestTimeStats(t *testing.T) {
+	setup := func(t *testing.T) cmdtest.CmdExecFunc {
+		tc := gitlabtesting.NewTestClient(t)
+		expectIssue(tc)
+		tc.MockIssues.EXPECT().
+			GetTimeSpent("OWNER/REPO", int64(42)).
+			Return(&gitlab.TimeStats{TimeEstimate: 8 * 3600, TotalTimeSpent: 9000, HumanTimeEstimate: "1d", HumanTotalTimeSpent: "2h 30m"}, nil, nil)
+		cmdtest.ExpectGraphQL(tc, timelogsResponse, func(query gitlab.GraphQLQuery) {
+			assert.Contains(t, query.Query, "issue(iid: $iid)")
+			assert.Equal(t, "42", query.Variables["iid"])
+		})
+
+		return cmdtest.SetupCmdForTest(t, issueTimeCmd, false, cmdtest.WithGitLabClient(tc.Client))
+	}
+
+	t.Run("text", func(t *testing.T) {
+		out, err := setup(t)("stats 42")
+		require.NoError(t, err)
+
+		assert.Contains(t, out.String(), "#42 2h 30m spent of 1d estimated, 5h 30m remaining\n")
+		assert.Contains(t, out.String(), "Time logs (2)\n")
+		assert.Contains(t, out.String(), "alice")
+		assert.Contains(t, out.String(), "Load testing")
+		assert.Contains(t, out.String(), "30m")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		out, err := setup(t)("stats 42 -F json")
+		require.NoError(t, err)
+
+		var stats map[string]any
+		require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &stats))
+		assert.Equal(t, float64(9000), stats["total_time_spent"])
+		assert.Equal(t, "1d", stats["human_time_estimate"])
+		require.Len(t, stats["timelogs"], 2)
+		assert.Equal(t, "OWNER/REPO#42", stats["timelogs"].([]any)[0].(map[string]any)["reference"])
+	})
+}
@@ -0,0 +1,90 @@
+package done
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+func NewCmdDone(f cmdutils.Factory) *cobra.Command {
+	var all, yes bool
+
+	todoDoneCmd := &cobra.Command{
+		Use:   "done [<id>...] [flags]",
+		Short: `Mark to-do items as done.`,
+		Long: heredoc.Docf(`
+			Mark to-do items as done, by the IDs shown by %[1]sglab todo list%[1]s, or all your
+			pending to-do items with %[1]s--all%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab todo done 123
+			$ glab todo done 123 124
+			$ glab todo done --all --yes
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if all == (len(args) > 0) {
+				return &cmdutils.FlagError{Err: errors.New("specify the IDs of to-do items, or --all.")}
+			}
+
+			ids := make([]int64, 0, len(args))
+			for _, arg := range args {
+				id, err := strconv.ParseInt(arg, 10, 64)
+				if err != nil || id <= 0 {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid to-do item ID %q.", arg)}
+				}
+				ids = append(ids, id)
+			}
+
+			if all && !yes {
+				if !f.IO().PromptEnabled() {
+					return &cmdutils.FlagError{Err: errors.New("--yes is required when not running interactively.")}
+				}
+				confirmed := false
+				err := f.IO().Confirm(cmd.Context(), &confirmed, "Mark all your pending to-do items as done?")
+				if err != nil {
+					return cmdutils.WrapError(err, "could not prompt")
+				}
+				if !confirmed {
+					return cmdutils.CancelError()
+				}
+			}
+
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+			c := f.IO().Color()
+
+			if all {
+				if _, err := client.Todos.MarkAllTodosAsDone(); err != nil {
+					return fmt.Errorf("error marking to-do items as done: %w", err)
+				}
+				fmt.Fprintf(f.IO().StdOut, "%s Marked all to-do items as done\n", c.GreenCheck())
+				return nil
+			}
+
+			for _, id := range ids {
+				if _, err := client.Todos.MarkTodoAsDone(id); err != nil {
+					return fmt.Errorf("error marking to-do item %d as done: %w", id, err)
+				}
+			}
+			fmt.Fprintf(f.IO().StdOut, "%s Marked %s as done\n", c.GreenCheck(), utils.Pluralize(len(ids), "to-do item"))
+			return nil
+		},
+	}
+
+	todoDoneCmd.Flags().BoolVar(&all, "all", false, "Mark all your pending to-do items as done.")
+	todoDoneCmd.Flags().BoolVarP(&yes, "yes", "y", false, "Skip the confirmation prompt of --all.")
+
+	return todoDoneCmd
+}
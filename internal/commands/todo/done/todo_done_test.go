@@ -0,0 +1,57 @@
+//go:build !integration
+
+package done
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestTodoDone(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockTodos.EXPECT().MarkTodoAsDone(int64(12)).Return(nil, nil)
+	tc.MockTodos.EXPECT().MarkTodoAsDone(int64(13)).Return(nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdDone, false, cmdtest.WithGitLabClient(tc.Client))
+	out, err := exec("12 13")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Marked 2 to-do items as done\n", out.String())
+}
+
+func TestTodoDone_all(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockTodos.EXPECT().MarkAllTodosAsDone().Return(nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdDone, false, cmdtest.WithGitLabClient(tc.Client))
+	out, err := exec("--all --yes")
+	require.NoError(t, err)
+
+	assert.Equal(t, "✓ Marked all to-do items as done\n", out.String())
+}
+
+func TestTodoDone_invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		cli  string
+		want string
+	}{
+		{name: "no ID", cli: "", want: "specify the IDs of to-do items, or --all."},
+		{name: "IDs and --all", cli: "12 --all", want: "specify the IDs of to-do items, or --all."},
+		{name: "invalid ID", cli: "abc", want: `invalid to-do item ID "abc".`},
+		{name: "--all without --yes", cli: "--all", want: "--yes is required when not running interactively."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := cmdtest.SetupCmdForTest(t, NewCmdDone, false)
+			_, err := exec(tt.cli)
+			assert.EqualError(t, err, tt.want)
+		})
+	}
+}
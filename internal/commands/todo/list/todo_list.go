@@ -0,0 +1,222 @@
+package list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/todo/todoutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// minInterval keeps --watch from polling the API too often.
+const minInterval = 5 * time.Second
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+
+	action       string
+	targetType   string
+	project      string
+	state        string
+	page         int
+	perPage      int
+	outputFormat string
+	watch        bool
+	interval     time.Duration
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+	}
+
+	todoListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List your to-do items.`,
+		Aliases: []string{"ls"},
+		Long: heredoc.Docf(`
+			List your pending to-do items, newest first.
+
+			With %[1]s--watch%[1]s, the command keeps running and polls for new to-do items. Each
+			new to-do item is printed on one line, which suits status lines like the ones of tmux.
+			Stop watching with %[1]sCtrl+C%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab todo list
+			$ glab todo list --type mr --action review_requested
+			$ glab todo list --project gitlab-org/cli --state done
+			$ glab todo list --watch --interval 30s
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.watch {
+				if opts.outputFormat == "json" {
+					return &cmdutils.FlagError{Err: errors.New("--watch can't be used with --output json.")}
+				}
+				if opts.interval < minInterval {
+					return &cmdutils.FlagError{Err: fmt.Errorf("--interval must be at least %s.", minInterval)}
+				}
+				return opts.runWatch(cmd.Context())
+			}
+			return opts.run()
+		},
+	}
+
+	fl := todoListCmd.Flags()
+	fl.VarP(cmdutils.NewEnumValue(todoutils.Actions, "", &opts.action), "action", "a", fmt.Sprintf("Filter by action: %s.", strings.Join(todoutils.Actions, ", ")))
+	fl.VarP(cmdutils.NewEnumValue(todoutils.TypeNames, "", &opts.targetType), "type", "t", fmt.Sprintf("Filter by type: %s.", strings.Join(todoutils.TypeNames, ", ")))
+	fl.StringVar(&opts.project, "project", "", "Filter by project, as a path like group/project or an ID.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"pending", "done"}, "pending", &opts.state), "state", "s", "Filter by state: pending, done.")
+	fl.IntVarP(&opts.page, "page", "p", 1, "Page number.")
+	fl.IntVarP(&opts.perPage, "per-page", "P", 30, "Number of items to list per page.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+	fl.BoolVar(&opts.watch, "watch", false, "Keep running and print new to-do items as they arrive.")
+	fl.DurationVar(&opts.interval, "interval", time.Minute, "How often to poll for new to-do items with --watch.")
+
+	return todoListCmd
+}
+
+// listOptions returns the filters of the to-do items, which need the client to look up the
+// project.
+func (o *options) listOptions(client *gitlab.Client) (*gitlab.ListTodosOptions, error) {
+	listOpts := &gitlab.ListTodosOptions{
+		ListOptions: gitlab.ListOptions{
+			Page:    int64(o.page),
+			PerPage: int64(o.perPage),
+		},
+		State: gitlab.Ptr(o.state),
+	}
+	if o.action != "" {
+		listOpts.Action = gitlab.Ptr(gitlab.TodoAction(o.action))
+	}
+	if o.targetType != "" {
+		listOpts.Type = gitlab.Ptr(string(todoutils.Types[o.targetType]))
+	}
+	if o.project != "" {
+		project, _, err := client.Projects.GetProject(o.project, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error getting project %s: %w", o.project, err)
+		}
+		listOpts.ProjectID = gitlab.Ptr(project.ID)
+	}
+	return listOpts, nil
+}
+
+func (o *options) run() error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	listOpts, err := o.listOptions(client)
+	if err != nil {
+		return err
+	}
+
+	todos, _, err := client.Todos.ListTodos(listOpts)
+	if err != nil {
+		return fmt.Errorf("error listing to-do items: %w", err)
+	}
+
+	if o.outputFormat == "json" {
+		return o.io.PrintJSON(todos)
+	}
+
+	if len(todos) == 0 {
+		fmt.Fprintf(o.io.StdErr, "No %s to-do items match your search.\n", o.state)
+		return nil
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "Showing %s.\n\n", utils.Pluralize(len(todos), o.state+" to-do item"))
+
+	table := tableprinter.NewTablePrinter()
+	for _, t := range todos {
+		created := ""
+		if t.CreatedAt != nil {
+			created = c.Gray(utils.TimeToPrettyTimeAgo(*t.CreatedAt))
+		}
+		table.AddRow(c.Gray(fmt.Sprint(t.ID)), todoutils.Reference(t), todoutils.Title(t), todoutils.Action(c, t), created)
+	}
+	fmt.Fprint(o.io.StdOut, table.Render())
+	return nil
+}
+
+// runWatch polls the to-do items until the context is cancelled. The to-do items pending when
+// it starts are only counted, and the ones that arrive later are printed, oldest first.
+func (o *options) runWatch(ctx context.Context) error {
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	listOpts, err := o.listOptions(client)
+	if err != nil {
+		return err
+	}
+	listOpts.Page = 1
+	listOpts.PerPage = api.MaxPerPage
+
+	todos, _, err := client.Todos.ListTodos(listOpts)
+	if err != nil {
+		return fmt.Errorf("error listing to-do items: %w", err)
+	}
+	seen := map[int64]bool{}
+	for _, t := range todos {
+		seen[t.ID] = true
+	}
+	if o.io.IsErrTTY {
+		fmt.Fprintf(o.io.StdErr, "Watching for new to-do items every %s. You have %s.\n", o.interval, utils.Pluralize(len(todos), o.state+" to-do item"))
+	}
+
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		todos, _, err := client.Todos.ListTodos(listOpts)
+		if err != nil {
+			// A failed poll, like a network error, shouldn't stop watching.
+			fmt.Fprintf(o.io.StdErr, "error listing to-do items: %s\n", err)
+			continue
+		}
+		for _, t := range slices.Backward(todos) {
+			if seen[t.ID] {
+				continue
+			}
+			seen[t.ID] = true
+			o.notify(t)
+		}
+	}
+}
+
+// notify prints a new to-do item on one line, like "[15:04] alice assigned you group/project#12 Title URL".
+func (o *options) notify(t *gitlab.Todo) {
+	c := o.io.Color()
+	at := time.Now()
+	if t.CreatedAt != nil {
+		at = *t.CreatedAt
+	}
+	fmt.Fprintf(o.io.StdOut, "%s %s %s %s %s\n", c.Gray(at.Local().Format("[15:04]")), todoutils.Action(c, t), todoutils.Reference(t), todoutils.Title(t), t.TargetURL)
+}
@@ -0,0 +1,149 @@
+//go:build !integration
+
+package list
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+var createdAt = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+
+func testTodo(id int64, iid int64, action gitlab.TodoAction, title string) *gitlab.Todo {
+	return &gitlab.Todo{
+		ID:         id,
+		Project:    &gitlab.BasicProject{PathWithNamespace: "OWNER/REPO"},
+		Author:     &gitlab.BasicUser{Username: "alice"},
+		ActionName: action,
+		TargetType: gitlab.TodoTargetMergeRequest,
+		Target:     &gitlab.TodoTarget{IID: iid, Title: title},
+		TargetURL:  fmt.Sprintf("https://gitlab.com/OWNER/REPO/-/merge_requests/%d", iid),
+		State:      "pending",
+		CreatedAt:  gitlab.Ptr(createdAt),
+	}
+}
+
+func TestTodoList(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockTodos.EXPECT().
+		ListTodos(gomock.Any()).
+		DoAndReturn(func(opts *gitlab.ListTodosOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Todo, *gitlab.Response, error) {
+			assert.Equal(t, "pending", *opts.State)
+			assert.Nil(t, opts.Action)
+			assert.Nil(t, opts.Type)
+			return []*gitlab.Todo{
+				testTodo(12, 2, "review_requested", "Add login"),
+				testTodo(11, 1, gitlab.TodoMarked, "Fix logout"),
+			}, nil, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(tc.Client))
+	out, err := exec("")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "Showing 2 pending to-do items.\n")
+	assert.Regexp(t, `12\s+OWNER/REPO!2\s+Add login\s+alice review requested you`, out.String())
+	assert.Regexp(t, `11\s+OWNER/REPO!1\s+Fix logout\s+alice added a to-do`, out.String())
+}
+
+func TestTodoList_filters(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockProjects.EXPECT().
+		GetProject("OWNER/REPO", gomock.Any()).
+		Return(&gitlab.Project{ID: 7}, nil, nil)
+	tc.MockTodos.EXPECT().
+		ListTodos(gomock.Any()).
+		DoAndReturn(func(opts *gitlab.ListTodosOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Todo, *gitlab.Response, error) {
+			assert.Equal(t, "done", *opts.State)
+			assert.Equal(t, gitlab.TodoAssigned, *opts.Action)
+			assert.Equal(t, "MergeRequest", *opts.Type)
+			assert.Equal(t, int64(7), *opts.ProjectID)
+			return []*gitlab.Todo{testTodo(12, 2, gitlab.TodoAssigned, "Add login")}, nil, nil
+		})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(tc.Client))
+	out, err := exec("--action assigned --type mr --project OWNER/REPO --state done -F json")
+	require.NoError(t, err)
+
+	var todos []map[string]any
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &todos))
+	require.Len(t, todos, 1)
+	assert.Equal(t, float64(12), todos[0]["id"])
+}
+
+func TestTodoList_empty(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockTodos.EXPECT().ListTodos(gomock.Any()).Return([]*gitlab.Todo{}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(tc.Client))
+	out, err := exec("")
+	require.NoError(t, err)
+
+	assert.Empty(t, out.String())
+	assert.Equal(t, "No pending to-do items match your search.\n", out.Stderr())
+}
+
+func TestTodoList_invalidWatchFlags(t *testing.T) {
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false)
+
+	_, err := exec("--watch --interval 1s")
+	assert.EqualError(t, err, "--interval must be at least 5s.")
+
+	_, err = exec("--watch -F json")
+	assert.EqualError(t, err, "--watch can't be used with --output json.")
+}
+
+func TestTodoList_watch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	tc := gitlabtesting.NewTestClient(t)
+	first := tc.MockTodos.EXPECT().
+		ListTodos(gomock.Any()).
+		Return([]*gitlab.Todo{testTodo(11, 1, gitlab.TodoMarked, "Fix logout")}, nil, nil)
+	failed := tc.MockTodos.EXPECT().
+		ListTodos(gomock.Any()).
+		Return(nil, nil, assert.AnError).
+		After(first.Call)
+	// Later polls return the same to-do items, so only the new ones of the first of them are
+	// printed before the context is cancelled.
+	tc.MockTodos.EXPECT().
+		ListTodos(gomock.Any()).
+		DoAndReturn(func(opts *gitlab.ListTodosOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Todo, *gitlab.Response, error) {
+			cancel()
+			return []*gitlab.Todo{
+				testTodo(13, 3, gitlab.TodoMentioned, "Add logo"),
+				testTodo(12, 2, gitlab.TodoAssigned, "Add login"),
+				testTodo(11, 1, gitlab.TodoMarked, "Fix logout"),
+			}, nil, nil
+		}).
+		After(failed).
+		MinTimes(1)
+
+	ios, _, stdout, stderr := cmdtest.TestIOStreams()
+	opts := &options{
+		io:           ios,
+		gitlabClient: func() (*gitlab.Client, error) { return tc.Client, nil },
+		state:        "pending",
+		interval:     time.Millisecond,
+	}
+	require.NoError(t, opts.runWatch(ctx))
+
+	// The time is printed in the local time zone.
+	at := createdAt.Local().Format("[15:04]")
+	assert.Equal(t, at+" alice assigned you OWNER/REPO!2 Add login https://gitlab.com/OWNER/REPO/-/merge_requests/2\n"+
+		at+" alice mentioned you OWNER/REPO!3 Add logo https://gitlab.com/OWNER/REPO/-/merge_requests/3\n", stdout.String())
+	assert.Contains(t, stderr.String(), "error listing to-do items: "+assert.AnError.Error()+"\n")
+}
@@ -0,0 +1,80 @@
+package open
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/todo/todoutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+func NewCmdOpen(f cmdutils.Factory) *cobra.Command {
+	todoOpenCmd := &cobra.Command{
+		Use:   "open [<id>] [flags]",
+		Short: `Open the target of a to-do item in a browser.`,
+		Long: heredoc.Doc(`
+			Open the issue, merge request, or other target of a pending to-do item in a browser.
+			Without an ID, opens the target of your newest pending to-do item.
+		`),
+		Example: heredoc.Doc(`
+			$ glab todo open
+			$ glab todo open 123
+		`),
+		Args: cobra.MaximumNArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var id int64
+			if len(args) > 0 {
+				var err error
+				id, err = strconv.ParseInt(args[0], 10, 64)
+				if err != nil || id <= 0 {
+					return &cmdutils.FlagError{Err: fmt.Errorf("invalid to-do item ID %q.", args[0])}
+				}
+			}
+
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+
+			// The API can't get a single to-do item, so it's looked up in the pending ones.
+			todos, err := todoutils.ListPending(client)
+			if err != nil {
+				return err
+			}
+			if len(todos) == 0 {
+				return errors.New("you have no pending to-do items.")
+			}
+
+			todo := todos[0]
+			if id != 0 {
+				todo = nil
+				for _, t := range todos {
+					if t.ID == id {
+						todo = t
+					}
+				}
+				if todo == nil {
+					return fmt.Errorf("pending to-do item %d not found.", id)
+				}
+			}
+
+			if f.IO().IsaTTY && f.IO().IsErrTTY {
+				fmt.Fprintf(f.IO().StdErr, "Opening %s in your browser.\n", utils.DisplayURL(todo.TargetURL))
+			}
+			cfg := f.Config()
+			browser, _ := cfg.Get("", "browser")
+			return utils.OpenInBrowser(todo.TargetURL, browser)
+		},
+	}
+
+	return todoOpenCmd
+}
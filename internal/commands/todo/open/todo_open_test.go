@@ -0,0 +1,68 @@
+//go:build !integration
+
+package open
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/run"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+	"gitlab.com/gitlab-org/cli/test"
+)
+
+func setup(t *testing.T) (cmdtest.CmdExecFunc, *[]string) {
+	t.Helper()
+
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockTodos.EXPECT().
+		ListTodos(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(opts *gitlab.ListTodosOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Todo, *gitlab.Response, error) {
+			assert.Equal(t, "pending", *opts.State)
+			return []*gitlab.Todo{
+				{ID: 12, TargetURL: "https://gitlab.com/OWNER/REPO/-/merge_requests/2"},
+				{ID: 11, TargetURL: "https://gitlab.com/OWNER/REPO/-/issues/1"},
+			}, &gitlab.Response{}, nil
+		})
+
+	var opened []string
+	restoreCmd := run.SetPrepareCmd(func(cmd *exec.Cmd) run.Runnable {
+		opened = append(opened, cmd.Args[len(cmd.Args)-1])
+		return &test.OutputStub{}
+	})
+	t.Cleanup(restoreCmd)
+
+	return cmdtest.SetupCmdForTest(t, NewCmdOpen, true, cmdtest.WithGitLabClient(tc.Client)), &opened
+}
+
+func TestTodoOpen(t *testing.T) {
+	exec, opened := setup(t)
+	out, err := exec("")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Opening gitlab.com/OWNER/REPO/-/merge_requests/2 in your browser.\n", out.Stderr())
+	assert.Equal(t, []string{"https://gitlab.com/OWNER/REPO/-/merge_requests/2"}, *opened)
+}
+
+func TestTodoOpen_id(t *testing.T) {
+	exec, opened := setup(t)
+	_, err := exec("11")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"https://gitlab.com/OWNER/REPO/-/issues/1"}, *opened)
+}
+
+func TestTodoOpen_notFound(t *testing.T) {
+	exec, opened := setup(t)
+	_, err := exec("10")
+
+	assert.EqualError(t, err, "pending to-do item 10 not found.")
+	assert.Empty(t, *opened)
+}
@@ -0,0 +1,29 @@
+package todo
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	todoDoneCmd "gitlab.com/gitlab-org/cli/internal/commands/todo/done"
+	todoListCmd "gitlab.com/gitlab-org/cli/internal/commands/todo/list"
+	todoOpenCmd "gitlab.com/gitlab-org/cli/internal/commands/todo/open"
+)
+
+func NewCmdTodo(f cmdutils.Factory) *cobra.Command {
+	todoCmd := &cobra.Command{
+		Use:   "todo <command> [flags]",
+		Short: `Work with your GitLab to-do items.`,
+		Long:  ``,
+		Example: heredoc.Doc(`
+			$ glab todo list
+			$ glab todo open
+			$ glab todo done 123
+		`),
+	}
+
+	todoCmd.AddCommand(todoListCmd.NewCmdList(f))
+	todoCmd.AddCommand(todoDoneCmd.NewCmdDone(f))
+	todoCmd.AddCommand(todoOpenCmd.NewCmdOpen(f))
+	return todoCmd
+}
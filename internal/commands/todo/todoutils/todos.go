@@ -0,0 +1,99 @@
+package todoutils
+
+import (
+	"fmt"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+// Actions are the actions that create to-do items, which they can be filtered by.
+var Actions = []string{
+	"assigned",
+	"mentioned",
+	"build_failed",
+	"marked",
+	"approval_required",
+	"unmergeable",
+	"directly_addressed",
+	"merge_train_removed",
+	"review_requested",
+	"member_access_requested",
+}
+
+// Types are the types of the targets of to-do items, as given on the command line, with the
+// type of the API.
+var Types = map[string]gitlab.TodoTargetType{
+	"issue":  gitlab.TodoTargetIssue,
+	"mr":     gitlab.TodoTargetMergeRequest,
+	"commit": "Commit",
+	"epic":   "Epic",
+	"design": gitlab.TodoTargetDesignManagement,
+	"alert":  gitlab.TodoTargetAlertManagement,
+}
+
+// TypeNames are the keys of Types, in the order they are documented.
+var TypeNames = []string{"issue", "mr", "commit", "epic", "design", "alert"}
+
+// Reference returns the reference of the target of the to-do item, like group/project#12.
+func Reference(t *gitlab.Todo) string {
+	var prefix string
+	switch t.TargetType {
+	case gitlab.TodoTargetIssue:
+		prefix = "#"
+	case gitlab.TodoTargetMergeRequest:
+		prefix = "!"
+	case "Epic":
+		prefix = "&"
+	}
+
+	var path string
+	if t.Project != nil {
+		path = t.Project.PathWithNamespace
+	}
+	if t.Target == nil || prefix == "" {
+		return path
+	}
+	return fmt.Sprintf("%s%s%d", path, prefix, t.Target.IID)
+}
+
+// Title returns the title of the target of the to-do item, or its body when it has none.
+func Title(t *gitlab.Todo) string {
+	if t.Target != nil && t.Target.Title != "" {
+		return t.Target.Title
+	}
+	return t.Body
+}
+
+// Action describes what the author of the to-do item did, like "alice assigned you".
+func Action(c *iostreams.ColorPalette, t *gitlab.Todo) string {
+	action := strings.ReplaceAll(string(t.ActionName), "_", " ")
+	switch t.ActionName {
+	case gitlab.TodoAssigned, gitlab.TodoMentioned, gitlab.TodoDirectlyAddressed, "review_requested":
+		action += " you"
+	case gitlab.TodoMarked:
+		action = "added a to-do"
+	}
+	if t.Author == nil {
+		return action
+	}
+	return c.Bold(t.Author.Username) + " " + action
+}
+
+// ListPending returns all the pending to-do items of the current user, newest first.
+func ListPending(client *gitlab.Client) ([]*gitlab.Todo, error) {
+	opts := &gitlab.ListTodosOptions{
+		ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		State:       gitlab.Ptr("pending"),
+	}
+	todos, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Todo, *gitlab.Response, error) {
+		return client.Todos.ListTodos(opts, p)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing to-do items: %w", err)
+	}
+	return todos, nil
+}
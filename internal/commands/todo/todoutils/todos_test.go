@@ -0,0 +1,47 @@
+//go:build !integration
+
+package todoutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+)
+
+func TestReference(t *testing.T) {
+	project := &gitlab.BasicProject{PathWithNamespace: "OWNER/REPO"}
+
+	tests := []struct {
+		name string
+		todo *gitlab.Todo
+		want string
+	}{
+		{
+			name: "issue",
+			todo: &gitlab.Todo{Project: project, TargetType: gitlab.TodoTargetIssue, Target: &gitlab.TodoTarget{IID: 12}},
+			want: "OWNER/REPO#12",
+		},
+		{
+			name: "merge request",
+			todo: &gitlab.Todo{Project: project, TargetType: gitlab.TodoTargetMergeRequest, Target: &gitlab.TodoTarget{IID: 3}},
+			want: "OWNER/REPO!3",
+		},
+		{
+			name: "epic",
+			todo: &gitlab.Todo{TargetType: "Epic", Target: &gitlab.TodoTarget{IID: 5}},
+			want: "&5",
+		},
+		{
+			name: "commit",
+			todo: &gitlab.Todo{Project: project, TargetType: "Commit", Target: &gitlab.TodoTarget{}},
+			want: "OWNER/REPO",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Reference(tt.todo))
+		})
+	}
+}
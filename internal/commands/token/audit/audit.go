@@ -0,0 +1,426 @@
+package audit
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+)
+
+// Severity levels of findings, ordered from most to least severe. They match the SARIF result levels.
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+	severityNote    = "note"
+	severityNone    = "none"
+)
+
+// Rules that a token can violate.
+const (
+	ruleExpired       = "expired"
+	ruleExpiring      = "expiring"
+	ruleNeverUsed     = "never-used"
+	ruleOverScoped    = "over-scoped"
+	ruleInactiveOwner = "inactive-owner"
+)
+
+// broadScopes grant write access to the whole API or elevated privileges.
+// Most automation only needs narrower scopes such as read_api or read_repository.
+var broadScopes = []string{"api", "sudo", "admin_mode"}
+
+type options struct {
+	io              *iostreams.IOStreams
+	config          func() config.Config
+	apiClient       func(repoHost string) (*api.Client, error)
+	defaultHostname string
+
+	hostnames    []string
+	groups       []string
+	projects     []string
+	days         int
+	outputFormat string
+	failOn       string
+}
+
+// Finding is a problem found with a token.
+type Finding struct {
+	Host      string   `json:"host"`
+	Kind      string   `json:"kind"`
+	Owner     string   `json:"owner"`
+	TokenID   int64    `json:"token_id"`
+	TokenName string   `json:"token_name"`
+	Scopes    []string `json:"scopes"`
+	ExpiresAt string   `json:"expires_at,omitempty"`
+	Rule      string   `json:"rule"`
+	Severity  string   `json:"severity"`
+	Message   string   `json:"message"`
+}
+
+// token is a personal, group, or project access token with the resource that owns it.
+type token struct {
+	host  string
+	kind  string
+	owner string
+	*gitlab.PersonalAccessToken
+}
+
+func NewCmdAudit(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:              f.IO(),
+		config:          f.Config,
+		apiClient:       f.ApiClient,
+		defaultHostname: f.DefaultHostname(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "Audit access tokens across GitLab instances, groups, and projects.",
+		Args:  cobra.ExactArgs(0),
+		Long: heredoc.Docf(`
+			Audit the access tokens that aren't revoked, of every GitLab instance in the glab
+			configuration, and of the given groups and projects.
+
+			The audit reports tokens that:
+
+			- Have expired, or expire, within the number of days set with %[1]s--days%[1]s. (error)
+			- Have expired before that. They can't be used anymore, so they only need cleaning up. (note)
+			- Belong to a user that is blocked, deactivated, or otherwise not active. (warning)
+			- Have never been used. (warning)
+			- Have broad scopes such as %[1]sapi%[1]s, %[1]ssudo%[1]s, or %[1]sadmin_mode%[1]s, when a narrower scope such as %[1]sread_api%[1]s might do. (note)
+
+			On each instance, the personal access tokens visible to you are audited.
+			Administrators see the personal access tokens of all users.
+
+			Groups and projects are audited on the default instance, unless you pass their full URL.
+
+			The command exits with status 1 if any finding is at least as severe as %[1]s--fail-on%[1]s,
+			or if an instance, group, or project can't be audited. Use it to gate CI/CD pipelines.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Audit the personal access tokens on all configured instances
+			$ glab token audit
+
+			# Also audit group and project access tokens, and warn 60 days before expiry
+			$ glab token audit --group my-group --project my-group/my-project --days 60
+
+			# Audit a project on another instance
+			$ glab token audit --project https://gitlab.example.com/my-group/my-project
+
+			# Write a SARIF report, and fail only on errors
+			$ glab token audit --output sarif --fail-on error > tokens.sarif
+		`),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.validate(); err != nil {
+				return err
+			}
+
+			return opts.run()
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&opts.hostnames, "hostname", nil, "Audit only these GitLab instances. Defaults to all instances in the configuration.")
+	cmd.Flags().StringSliceVarP(&opts.groups, "group", "g", nil, "Audit the access tokens of these groups. Can be repeated.")
+	cmd.Flags().StringSliceVarP(&opts.projects, "project", "p", nil, "Audit the access tokens of these projects. Can be repeated.")
+	cmd.Flags().IntVarP(&opts.days, "days", "d", 30, "Report tokens that expire, or have expired, within this number of days as errors.")
+	cmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json", "sarif"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json, sarif.")
+	cmd.Flags().Var(cmdutils.NewEnumValue([]string{severityError, severityWarning, severityNote, severityNone}, severityError, &opts.failOn), "fail-on", "Exit with status 1 if a finding has this severity or higher: error, warning, note, none.")
+
+	return cmd
+}
+
+func (o *options) validate() error {
+	if o.days <= 0 {
+		return cmdutils.FlagError{Err: fmt.Errorf("--days must be a positive number.")}
+	}
+
+	return nil
+}
+
+func (o *options) run() error {
+	hosts := o.hostnames
+	if len(hosts) == 0 {
+		hosts, _ = o.config().Hosts()
+		if len(hosts) == 0 {
+			hosts = []string{o.defaultHostname}
+		}
+	}
+
+	var tokens []token
+	failed := false
+	fail := func(err error) {
+		failed = true
+		fmt.Fprintf(o.io.StdErr, "%s %s\n", o.io.Color().FailedIcon(), err)
+	}
+
+	clients := map[string]*gitlab.Client{}
+	client := func(host string) (*gitlab.Client, error) {
+		if c, ok := clients[host]; ok {
+			return c, nil
+		}
+		apiClient, err := o.apiClient(host)
+		if err != nil {
+			return nil, err
+		}
+		clients[host] = apiClient.Lab()
+		return clients[host], nil
+	}
+
+	for _, host := range hosts {
+		c, err := client(host)
+		if err != nil {
+			fail(fmt.Errorf("%s: %w", host, err))
+			continue
+		}
+		found, err := personalTokens(c, host)
+		if err != nil {
+			fail(fmt.Errorf("%s: failed to list personal access tokens: %w", host, err))
+			continue
+		}
+		tokens = append(tokens, found...)
+	}
+
+	for _, group := range o.groups {
+		host, path := o.splitResource(group)
+		c, err := client(host)
+		if err != nil {
+			fail(fmt.Errorf("%s: %w", host, err))
+			continue
+		}
+		found, err := groupTokens(c, host, path)
+		if err != nil {
+			fail(fmt.Errorf("%s: failed to list access tokens of group %s: %w", host, path, err))
+			continue
+		}
+		tokens = append(tokens, found...)
+	}
+
+	for _, project := range o.projects {
+		host, path := o.splitResource(project)
+		c, err := client(host)
+		if err != nil {
+			fail(fmt.Errorf("%s: %w", host, err))
+			continue
+		}
+		found, err := projectTokens(c, host, path)
+		if err != nil {
+			fail(fmt.Errorf("%s: failed to list access tokens of project %s: %w", host, path, err))
+			continue
+		}
+		tokens = append(tokens, found...)
+	}
+
+	users := map[string]*gitlab.User{}
+	lookupUser := func(t token) *gitlab.User {
+		key := fmt.Sprintf("%s/%d", t.host, t.UserID)
+		user, ok := users[key]
+		if !ok {
+			// Users that can't be looked up are not reported, because the audit can't tell their state.
+			user, _, _ = clients[t.host].Users.GetUser(t.UserID, gitlab.GetUsersOptions{})
+			users[key] = user
+		}
+		return user
+	}
+
+	findings := []Finding{}
+	now := time.Now()
+	for _, t := range tokens {
+		user := lookupUser(t)
+		if t.kind == "user" && user != nil {
+			t.owner = user.Username
+		}
+		findings = append(findings, o.check(t, now, user)...)
+	}
+
+	var err error
+	switch o.outputFormat {
+	case "json":
+		err = o.io.PrintJSON(findings)
+	case "sarif":
+		err = o.io.PrintJSON(newSARIFReport(findings))
+	default:
+		o.printTable(findings, len(tokens))
+	}
+	if err != nil {
+		return err
+	}
+
+	if failed || exceedsThreshold(findings, o.failOn) {
+		return cmdutils.SilentError
+	}
+	return nil
+}
+
+// splitResource returns the host and path of a group or project given as a path or a full URL.
+func (o *options) splitResource(resource string) (string, string) {
+	if u, err := url.Parse(resource); err == nil && u.Host != "" {
+		return u.Host, strings.Trim(u.Path, "/")
+	}
+	return o.defaultHostname, resource
+}
+
+func (o *options) check(t token, now time.Time, user *gitlab.User) []Finding {
+	var findings []Finding
+	add := func(rule, severity, message string) {
+		finding := Finding{
+			Host:      t.host,
+			Kind:      t.kind,
+			Owner:     t.owner,
+			TokenID:   t.ID,
+			TokenName: t.Name,
+			Scopes:    t.Scopes,
+			Rule:      rule,
+			Severity:  severity,
+			Message:   message,
+		}
+		if t.ExpiresAt != nil {
+			finding.ExpiresAt = t.ExpiresAt.String()
+		}
+		findings = append(findings, finding)
+	}
+
+	if t.ExpiresAt != nil {
+		expiresAt := time.Time(*t.ExpiresAt)
+		days := int(expiresAt.Sub(now).Hours() / 24)
+		switch {
+		case !expiresAt.After(now) && -days <= o.days:
+			// A token that expired recently was likely still in use, so whatever used it is broken now.
+			add(ruleExpired, severityError, fmt.Sprintf("Expired on %s.", t.ExpiresAt))
+		case !expiresAt.After(now):
+			add(ruleExpired, severityNote, fmt.Sprintf("Expired on %s, %d days ago. Revoke it to clean up.", t.ExpiresAt, -days))
+		case days <= o.days:
+			add(ruleExpiring, severityError, fmt.Sprintf("Expires on %s, in %d days.", t.ExpiresAt, days))
+		}
+	}
+
+	if user != nil && user.State != "" && user.State != "active" {
+		add(ruleInactiveOwner, severityWarning, fmt.Sprintf("Belongs to a user that is %s.", user.State))
+	}
+
+	if t.LastUsedAt == nil {
+		add(ruleNeverUsed, severityWarning, "Has never been used.")
+	}
+
+	var broad []string
+	for _, scope := range t.Scopes {
+		if slices.Contains(broadScopes, scope) {
+			broad = append(broad, scope)
+		}
+	}
+	if len(broad) > 0 {
+		add(ruleOverScoped, severityNote, fmt.Sprintf("Has the broad scopes %s. Consider narrower scopes such as read_api.", strings.Join(broad, ", ")))
+	}
+
+	return findings
+}
+
+func (o *options) printTable(findings []Finding, audited int) {
+	c := o.io.Color()
+
+	if len(findings) == 0 {
+		fmt.Fprintf(o.io.StdErr, "%s Audited %d tokens. No problems found.\n", c.GreenCheck(), audited)
+		return
+	}
+
+	table := tableprinter.NewTablePrinter()
+	table.AddRow("HOST", "OWNER", "TOKEN", "SEVERITY", "FINDING")
+	for _, f := range findings {
+		severity := f.Severity
+		switch severity {
+		case severityError:
+			severity = c.Red(severity)
+		case severityWarning:
+			severity = c.Yellow(severity)
+		}
+		table.AddRow(f.Host, fmt.Sprintf("%s %s", f.Kind, f.Owner), fmt.Sprintf("%s (%d)", f.TokenName, f.TokenID), severity, f.Message)
+	}
+	o.io.LogInfo(table.String())
+}
+
+// exceedsThreshold reports whether any finding is at least as severe as the threshold.
+func exceedsThreshold(findings []Finding, threshold string) bool {
+	order := []string{severityError, severityWarning, severityNote}
+	limit := slices.Index(order, threshold)
+	if limit < 0 {
+		return false
+	}
+
+	for _, f := range findings {
+		if i := slices.Index(order, f.Severity); i >= 0 && i <= limit {
+			return true
+		}
+	}
+	return false
+}
+
+// personalTokens returns the personal access tokens visible on the host. Like for group and
+// project tokens, tokens of all states are listed, because GitLab marks expired tokens as
+// inactive and the audit reports them. Only revoked tokens are skipped.
+func personalTokens(client *gitlab.Client, host string) ([]token, error) {
+	options := &gitlab.ListPersonalAccessTokensOptions{}
+	found, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+		return client.PersonalAccessTokens.ListPersonalAccessTokens(options, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]token, 0, len(found))
+	for _, t := range found {
+		if !t.Revoked {
+			tokens = append(tokens, token{host: host, kind: "user", owner: fmt.Sprintf("#%d", t.UserID), PersonalAccessToken: t})
+		}
+	}
+	return tokens, nil
+}
+
+func groupTokens(client *gitlab.Client, host, group string) ([]token, error) {
+	options := &gitlab.ListGroupAccessTokensOptions{}
+	found, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.GroupAccessToken, *gitlab.Response, error) {
+		return client.GroupAccessTokens.ListGroupAccessTokens(group, options, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]token, 0, len(found))
+	for _, t := range found {
+		if !t.Revoked {
+			tokens = append(tokens, token{host: host, kind: "group", owner: group, PersonalAccessToken: &t.PersonalAccessToken})
+		}
+	}
+	return tokens, nil
+}
+
+func projectTokens(client *gitlab.Client, host, project string) ([]token, error) {
+	options := &gitlab.ListProjectAccessTokensOptions{}
+	found, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+		return client.ProjectAccessTokens.ListProjectAccessTokens(project, options, p)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make([]token, 0, len(found))
+	for _, t := range found {
+		if !t.Revoked {
+			tokens = append(tokens, token{host: host, kind: "project", owner: project, PersonalAccessToken: &t.PersonalAccessToken})
+		}
+	}
+	return tokens, nil
+}
@@ -0,0 +1,218 @@
+//go:build !integration
+
+package audit
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func noMorePages() *gitlab.Response {
+	return &gitlab.Response{NextPage: 0}
+}
+
+func inDays(days int) *gitlab.ISOTime {
+	return gitlab.Ptr(gitlab.ISOTime(time.Now().AddDate(0, 0, days)))
+}
+
+func setupAudit(t *testing.T, setupMock func(tc *gitlabtesting.TestClient)) cmdtest.CmdExecFunc {
+	t.Helper()
+
+	testClient := gitlabtesting.NewTestClient(t)
+	setupMock(testClient)
+
+	return cmdtest.SetupCmdForTest(
+		t,
+		NewCmdAudit,
+		false,
+		cmdtest.WithConfig(config.NewFromString("hosts:\n  gitlab.com:\n    token: glpat-xxx\n")),
+		cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+	)
+}
+
+func TestAudit(t *testing.T) {
+	lastUsed := time.Now().AddDate(0, 0, -1)
+
+	healthy := &gitlab.PersonalAccessToken{
+		ID: 1, Name: "healthy", UserID: 10, Active: true,
+		Scopes: []string{"read_api"}, ExpiresAt: inDays(200), LastUsedAt: &lastUsed,
+	}
+	expiring := &gitlab.PersonalAccessToken{
+		ID: 2, Name: "expiring", UserID: 10, Active: true,
+		Scopes: []string{"read_repository"}, ExpiresAt: inDays(10), LastUsedAt: &lastUsed,
+	}
+	unused := &gitlab.PersonalAccessToken{
+		ID: 3, Name: "unused", UserID: 10, Active: true,
+		Scopes: []string{"api"}, ExpiresAt: inDays(200),
+	}
+
+	t.Run("reports findings and fails on errors", func(t *testing.T) {
+		exec := setupAudit(t, func(tc *gitlabtesting.TestClient) {
+			tc.MockPersonalAccessTokens.EXPECT().
+				ListPersonalAccessTokens(gomock.Any(), gomock.Any()).
+				Return([]*gitlab.PersonalAccessToken{healthy, expiring, unused}, noMorePages(), nil)
+			tc.MockUsers.EXPECT().
+				GetUser(int64(10), gomock.Any()).
+				Return(&gitlab.User{ID: 10, Username: "alice", State: "active"}, nil, nil)
+		})
+
+		out, err := exec("")
+		require.ErrorIs(t, err, cmdutils.SilentError)
+
+		output := out.OutBuf.String()
+		assert.Contains(t, output, "user alice")
+		assert.Contains(t, output, "expiring (2)")
+		assert.Contains(t, output, "unused (3)")
+		assert.Contains(t, output, "Has never been used.")
+		assert.Contains(t, output, "Has the broad scopes api.")
+		assert.NotContains(t, output, "healthy")
+	})
+
+	t.Run("reports expired tokens and skips revoked ones", func(t *testing.T) {
+		expired := &gitlab.PersonalAccessToken{
+			ID: 4, Name: "expired", UserID: 10, Active: false,
+			Scopes: []string{"read_api"}, ExpiresAt: inDays(-3), LastUsedAt: &lastUsed,
+		}
+		revoked := &gitlab.PersonalAccessToken{
+			ID: 5, Name: "revoked", UserID: 10, Active: false, Revoked: true,
+			Scopes: []string{"api"}, ExpiresAt: inDays(-3),
+		}
+
+		exec := setupAudit(t, func(tc *gitlabtesting.TestClient) {
+			tc.MockPersonalAccessTokens.EXPECT().
+				ListPersonalAccessTokens(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(opts *gitlab.ListPersonalAccessTokensOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.PersonalAccessToken, *gitlab.Response, error) {
+					assert.Nil(t, opts.State)
+					return []*gitlab.PersonalAccessToken{expired, revoked}, noMorePages(), nil
+				})
+			tc.MockUsers.EXPECT().
+				GetUser(int64(10), gomock.Any()).
+				Return(&gitlab.User{ID: 10, Username: "alice", State: "active"}, nil, nil)
+		})
+
+		out, err := exec("--output json --fail-on none")
+		require.NoError(t, err)
+
+		var findings []Finding
+		require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &findings))
+		require.Len(t, findings, 1)
+		assert.Equal(t, ruleExpired, findings[0].Rule)
+		assert.Equal(t, severityError, findings[0].Severity)
+		assert.Equal(t, int64(4), findings[0].TokenID)
+	})
+
+	t.Run("does not fail on tokens that expired long ago", func(t *testing.T) {
+		old := &gitlab.PersonalAccessToken{
+			ID: 6, Name: "old", UserID: 10, Active: false,
+			Scopes: []string{"read_api"}, ExpiresAt: inDays(-400), LastUsedAt: &lastUsed,
+		}
+
+		exec := setupAudit(t, func(tc *gitlabtesting.TestClient) {
+			tc.MockPersonalAccessTokens.EXPECT().
+				ListPersonalAccessTokens(gomock.Any(), gomock.Any()).
+				Return([]*gitlab.PersonalAccessToken{old}, noMorePages(), nil)
+			tc.MockUsers.EXPECT().
+				GetUser(int64(10), gomock.Any()).
+				Return(&gitlab.User{ID: 10, Username: "alice", State: "active"}, nil, nil)
+		})
+
+		out, err := exec("")
+		require.NoError(t, err)
+
+		output := out.OutBuf.String()
+		assert.Contains(t, output, "old (6)")
+		assert.Contains(t, output, "Revoke it to clean up.")
+	})
+
+	t.Run("does not fail below the threshold", func(t *testing.T) {
+		exec := setupAudit(t, func(tc *gitlabtesting.TestClient) {
+			tc.MockPersonalAccessTokens.EXPECT().
+				ListPersonalAccessTokens(gomock.Any(), gomock.Any()).
+				Return([]*gitlab.PersonalAccessToken{unused}, noMorePages(), nil)
+			tc.MockUsers.EXPECT().
+				GetUser(int64(10), gomock.Any()).
+				Return(&gitlab.User{ID: 10, Username: "alice", State: "active"}, nil, nil)
+		})
+
+		_, err := exec("--fail-on error")
+		require.NoError(t, err)
+	})
+
+	t.Run("reports tokens of inactive users as JSON", func(t *testing.T) {
+		exec := setupAudit(t, func(tc *gitlabtesting.TestClient) {
+			tc.MockPersonalAccessTokens.EXPECT().
+				ListPersonalAccessTokens(gomock.Any(), gomock.Any()).
+				Return(nil, noMorePages(), nil)
+			tc.MockProjectAccessTokens.EXPECT().
+				ListProjectAccessTokens("group/project", gomock.Any(), gomock.Any()).
+				Return([]*gitlab.ProjectAccessToken{{PersonalAccessToken: *healthy}}, noMorePages(), nil)
+			tc.MockUsers.EXPECT().
+				GetUser(int64(10), gomock.Any()).
+				Return(&gitlab.User{ID: 10, Username: "project_bot", State: "blocked"}, nil, nil)
+		})
+
+		out, err := exec("--project group/project --output json --fail-on none")
+		require.NoError(t, err)
+
+		var findings []Finding
+		require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &findings))
+		require.Len(t, findings, 1)
+		assert.Equal(t, ruleInactiveOwner, findings[0].Rule)
+		assert.Equal(t, "project", findings[0].Kind)
+		assert.Equal(t, "group/project", findings[0].Owner)
+	})
+
+	t.Run("outputs SARIF", func(t *testing.T) {
+		exec := setupAudit(t, func(tc *gitlabtesting.TestClient) {
+			tc.MockPersonalAccessTokens.EXPECT().
+				ListPersonalAccessTokens(gomock.Any(), gomock.Any()).
+				Return([]*gitlab.PersonalAccessToken{expiring}, noMorePages(), nil)
+			tc.MockUsers.EXPECT().
+				GetUser(int64(10), gomock.Any()).
+				Return(&gitlab.User{ID: 10, Username: "alice", State: "active"}, nil, nil)
+		})
+
+		out, err := exec("--output sarif")
+		require.ErrorIs(t, err, cmdutils.SilentError)
+
+		var report sarifReport
+		require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &report))
+		assert.Equal(t, "2.1.0", report.Version)
+		require.Len(t, report.Runs, 1)
+		require.Len(t, report.Runs[0].Results, 1)
+		assert.Equal(t, ruleExpiring, report.Runs[0].Results[0].RuleID)
+		assert.Equal(t, "error", report.Runs[0].Results[0].Level)
+		assert.Equal(t, "https://gitlab.com/-/user_settings/personal_access_tokens", report.Runs[0].Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	})
+}
+
+func TestAudit_invalidDays(t *testing.T) {
+	exec := setupAudit(t, func(tc *gitlabtesting.TestClient) {})
+
+	for _, days := range []string{"0", "-1"} {
+		_, err := exec("--days " + days)
+		require.EqualError(t, err, "--days must be a positive number.", days)
+	}
+}
+
+func Test_exceedsThreshold(t *testing.T) {
+	findings := []Finding{{Severity: severityWarning}}
+
+	assert.False(t, exceedsThreshold(findings, severityError))
+	assert.True(t, exceedsThreshold(findings, severityWarning))
+	assert.True(t, exceedsThreshold(findings, severityNote))
+	assert.False(t, exceedsThreshold(findings, severityNone))
+}
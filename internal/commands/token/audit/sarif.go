@@ -0,0 +1,121 @@
+package audit
+
+import (
+	"fmt"
+	"slices"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+)
+
+// ruleDescriptions describes the rules in SARIF reports.
+var ruleDescriptions = map[string]string{
+	ruleExpired:       "The access token has expired.",
+	ruleExpiring:      "The access token expires soon.",
+	ruleNeverUsed:     "The access token has never been used.",
+	ruleOverScoped:    "The access token has broader scopes than most automation needs.",
+	ruleInactiveOwner: "The access token belongs to a user that is not active.",
+}
+
+// The types below implement the subset of the SARIF 2.1.0 format that the audit needs.
+// See https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+type sarifReport struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID     string          `json:"ruleId"`
+	Level      string          `json:"level"`
+	Message    sarifMessage    `json:"message"`
+	Locations  []sarifLocation `json:"locations"`
+	Properties Finding         `json:"properties"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+func newSARIFReport(findings []Finding) sarifReport {
+	rules := []sarifRule{}
+	results := []sarifResult{}
+
+	for _, f := range findings {
+		if !slices.ContainsFunc(rules, func(r sarifRule) bool { return r.ID == f.Rule }) {
+			rules = append(rules, sarifRule{ID: f.Rule, ShortDescription: sarifMessage{Text: ruleDescriptions[f.Rule]}})
+		}
+
+		results = append(results, sarifResult{
+			RuleID:  f.Rule,
+			Level:   f.Severity,
+			Message: sarifMessage{Text: fmt.Sprintf("Token %q (%d) of %s %s: %s", f.TokenName, f.TokenID, f.Kind, f.Owner, f.Message)},
+			Locations: []sarifLocation{{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: tokenSettingsURL(f)},
+				},
+			}},
+			Properties: f,
+		})
+	}
+
+	return sarifReport{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool: sarifTool{Driver: sarifDriver{
+				Name:           "glab token audit",
+				InformationURI: "https://gitlab.com/gitlab-org/cli",
+				Rules:          rules,
+			}},
+			Results: results,
+		}},
+	}
+}
+
+// tokenSettingsURL returns the URL of the settings page where the token can be managed.
+func tokenSettingsURL(f Finding) string {
+	switch f.Kind {
+	case "group":
+		return fmt.Sprintf("https://%s/groups/%s/-/settings/access_tokens", f.Host, f.Owner)
+	case "project":
+		return fmt.Sprintf("https://%s/%s/-/settings/access_tokens", f.Host, f.Owner)
+	default:
+		return fmt.Sprintf("https://%s/-/user_settings/personal_access_tokens", f.Host)
+	}
+}
@@ -0,0 +1,306 @@
+package rotate
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+)
+
+// destination is a place that stores the value of a token.
+type destination interface {
+	fmt.Stringer
+	// update stores the new token value, and remembers the previous value.
+	update(value string) error
+	// restore puts back the value that the destination had before update.
+	restore() error
+}
+
+// parseDestination parses a --propagate value. The formats are:
+//
+//	config
+//	file=<path>
+//	project-var=[<project>:]<KEY>
+//	group-var=<group>:<KEY>
+func (o *options) parseDestination(spec string, client *gitlab.Client, host string) (destination, error) {
+	kind, value, _ := strings.Cut(spec, "=")
+
+	switch kind {
+	case "config":
+		if value != "" {
+			break
+		}
+		return &configDestination{cfg: o.config(), host: host}, nil
+	case "file":
+		if value == "" {
+			break
+		}
+		return &fileDestination{path: value}, nil
+	case "project-var":
+		project, key := "", value
+		if i := strings.LastIndex(value, ":"); i >= 0 {
+			project, key = value[:i], value[i+1:]
+		}
+		if key == "" {
+			break
+		}
+		if project == "" {
+			repo, err := o.baseRepo()
+			if err != nil {
+				return nil, err
+			}
+			project = repo.FullName()
+		}
+		return &projectVariableDestination{client: client, project: project, key: key}, nil
+	case "group-var":
+		group, key, found := strings.Cut(value, ":")
+		if !found || group == "" || key == "" {
+			break
+		}
+		return &groupVariableDestination{client: client, group: group, key: key}, nil
+	}
+
+	return nil, cmdutils.FlagError{Err: fmt.Errorf("invalid --propagate value %q. Use config, file=<path>, project-var=[<project>:]<KEY>, or group-var=<group>:<KEY>.", spec)}
+}
+
+// propagateToken stores the new token value in all destinations and then revokes the old token.
+// If a destination can't be updated, the destinations that were already updated are restored
+// and the new token is revoked, so the old token stays in use everywhere.
+func (o *options) propagateToken(destinations []destination, value string, revokeNew, revokeOld func() error) error {
+	c := o.io.Color()
+
+	for i, d := range destinations {
+		if err := d.update(value); err != nil {
+			fmt.Fprintf(o.io.StdErr, "%s Failed to update %s. Rolling back.\n", c.FailedIcon(), d)
+			o.rollback(destinations[:i], revokeNew)
+			return fmt.Errorf("failed to update %s: %w", d, err)
+		}
+		fmt.Fprintf(o.io.StdErr, "%s Updated %s.\n", c.GreenCheck(), d)
+	}
+
+	if err := revokeOld(); err != nil {
+		return fmt.Errorf("all destinations use the new token, but the old token could not be revoked: %w", err)
+	}
+	fmt.Fprintf(o.io.StdErr, "%s Revoked the old token.\n", c.GreenCheck())
+
+	return nil
+}
+
+// warnNewBotUser warns that propagating a group or project access token replaces its bot user.
+// Rotating the token in place would keep the bot user, but revokes the old token immediately.
+func (o *options) warnNewBotUser() {
+	fmt.Fprintf(o.io.StdErr, "%s The new token gets a new bot user. Memberships of the old bot user in other groups and projects are not transferred.\n", o.io.Color().WarnIcon())
+}
+
+func (o *options) rollback(updated []destination, revokeNew func() error) {
+	c := o.io.Color()
+
+	for i := len(updated) - 1; i >= 0; i-- {
+		if err := updated[i].restore(); err != nil {
+			fmt.Fprintf(o.io.StdErr, "%s Failed to restore %s: %s\n", c.WarnIcon(), updated[i], err)
+			continue
+		}
+		fmt.Fprintf(o.io.StdErr, "%s Restored %s.\n", c.GreenCheck(), updated[i])
+	}
+
+	if err := revokeNew(); err != nil {
+		fmt.Fprintf(o.io.StdErr, "%s Failed to revoke the new token: %s\n", c.WarnIcon(), err)
+		return
+	}
+	fmt.Fprintf(o.io.StdErr, "%s Revoked the new token. The old token is still valid.\n", c.GreenCheck())
+}
+
+// configDestination is the token of a host in the glab configuration or the keyring.
+type configDestination struct {
+	cfg  config.Config
+	host string
+
+	previous  string
+	inKeyring bool
+}
+
+func (d *configDestination) String() string {
+	return fmt.Sprintf("the glab configuration for %s", d.host)
+}
+
+func (d *configDestination) update(value string) error {
+	previous, source, err := d.cfg.GetWithSource(d.host, "token", false)
+	if err != nil {
+		return err
+	}
+	d.previous = previous
+	d.inKeyring = source == "keyring"
+
+	return d.set(value)
+}
+
+func (d *configDestination) restore() error {
+	return d.set(d.previous)
+}
+
+func (d *configDestination) set(value string) error {
+	if d.inKeyring {
+		profile, _ := config.ActiveProfile(d.cfg, d.host)
+		return keyring.Set(config.KeyringService(d.host, profile), "", value)
+	}
+
+	if err := d.cfg.Set(d.host, "token", value); err != nil {
+		return err
+	}
+	return d.cfg.Write()
+}
+
+// fileDestination is a file that contains only the token.
+type fileDestination struct {
+	path string
+
+	previous []byte
+	existed  bool
+}
+
+func (d *fileDestination) String() string {
+	return fmt.Sprintf("file %s", d.path)
+}
+
+func (d *fileDestination) update(value string) error {
+	previous, err := os.ReadFile(d.path)
+	switch {
+	case err == nil:
+		d.previous, d.existed = previous, true
+	case !errors.Is(err, os.ErrNotExist):
+		return err
+	}
+
+	return writeFileAtomic(d.path, []byte(value+"\n"))
+}
+
+func (d *fileDestination) restore() error {
+	if !d.existed {
+		return os.Remove(d.path)
+	}
+	return writeFileAtomic(d.path, d.previous)
+}
+
+// writeFileAtomic replaces the file with a file that only the current user can read,
+// so readers never see a partially written token.
+func writeFileAtomic(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(f.Name(), 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+// projectVariableDestination is a CI/CD variable of a project.
+// Variables that don't exist yet are created as masked variables.
+type projectVariableDestination struct {
+	client  *gitlab.Client
+	project string
+	key     string
+
+	previous *gitlab.ProjectVariable
+}
+
+func (d *projectVariableDestination) String() string {
+	return fmt.Sprintf("CI/CD variable %s of project %s", d.key, d.project)
+}
+
+func (d *projectVariableDestination) update(value string) error {
+	previous, resp, err := d.client.ProjectVariables.GetVariable(d.project, d.key, nil)
+	switch {
+	case err == nil:
+		if previous.Hidden {
+			return fmt.Errorf("the variable is hidden, so its value can't be restored if the rotation fails")
+		}
+		d.previous = previous
+		_, _, err = d.client.ProjectVariables.UpdateVariable(d.project, d.key, &gitlab.UpdateProjectVariableOptions{Value: gitlab.Ptr(value)})
+		return err
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		_, _, err = d.client.ProjectVariables.CreateVariable(d.project, &gitlab.CreateProjectVariableOptions{
+			Key:    gitlab.Ptr(d.key),
+			Value:  gitlab.Ptr(value),
+			Masked: gitlab.Ptr(true),
+			Raw:    gitlab.Ptr(true),
+		})
+		return err
+	default:
+		return err
+	}
+}
+
+func (d *projectVariableDestination) restore() error {
+	if d.previous == nil {
+		_, err := d.client.ProjectVariables.RemoveVariable(d.project, d.key, nil)
+		return err
+	}
+	_, _, err := d.client.ProjectVariables.UpdateVariable(d.project, d.key, &gitlab.UpdateProjectVariableOptions{Value: gitlab.Ptr(d.previous.Value)})
+	return err
+}
+
+// groupVariableDestination is a CI/CD variable of a group.
+// Variables that don't exist yet are created as masked variables.
+type groupVariableDestination struct {
+	client *gitlab.Client
+	group  string
+	key    string
+
+	previous *gitlab.GroupVariable
+}
+
+func (d *groupVariableDestination) String() string {
+	return fmt.Sprintf("CI/CD variable %s of group %s", d.key, d.group)
+}
+
+func (d *groupVariableDestination) update(value string) error {
+	previous, resp, err := d.client.GroupVariables.GetVariable(d.group, d.key, nil)
+	switch {
+	case err == nil:
+		if previous.Hidden {
+			return fmt.Errorf("the variable is hidden, so its value can't be restored if the rotation fails")
+		}
+		d.previous = previous
+		_, _, err = d.client.GroupVariables.UpdateVariable(d.group, d.key, &gitlab.UpdateGroupVariableOptions{Value: gitlab.Ptr(value)})
+		return err
+	case resp != nil && resp.StatusCode == http.StatusNotFound:
+		_, _, err = d.client.GroupVariables.CreateVariable(d.group, &gitlab.CreateGroupVariableOptions{
+			Key:    gitlab.Ptr(d.key),
+			Value:  gitlab.Ptr(value),
+			Masked: gitlab.Ptr(true),
+			Raw:    gitlab.Ptr(true),
+		})
+		return err
+	default:
+		return err
+	}
+}
+
+func (d *groupVariableDestination) restore() error {
+	if d.previous == nil {
+		_, err := d.client.GroupVariables.RemoveVariable(d.group, d.key, nil)
+		return err
+	}
+	_, _, err := d.client.GroupVariables.UpdateVariable(d.group, d.key, &gitlab.UpdateGroupVariableOptions{Value: gitlab.Ptr(d.previous.Value)})
+	return err
+}
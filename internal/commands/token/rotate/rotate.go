@@ -17,6 +17,7 @@ import (
 	"gitlab.com/gitlab-org/cli/internal/commands/token/expirationdate"
 	"gitlab.com/gitlab-org/cli/internal/commands/token/filter"
 	"gitlab.com/gitlab-org/cli/internal/commands/token/tokenduration"
+	"gitlab.com/gitlab-org/cli/internal/config"
 	"gitlab.com/gitlab-org/cli/internal/glrepo"
 	"gitlab.com/gitlab-org/cli/internal/iostreams"
 	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
@@ -26,6 +27,7 @@ type options struct {
 	apiClient func(repoHost string) (*api.Client, error)
 	io        *iostreams.IOStreams
 	baseRepo  func() (glrepo.Interface, error)
+	config    func() config.Config
 
 	user         string
 	group        string
@@ -33,6 +35,7 @@ type options struct {
 	duration     tokenduration.TokenDuration
 	expireAt     expirationdate.ExpirationDate
 	outputFormat string
+	propagate    []string
 }
 
 func NewCmdRotate(f cmdutils.Factory) *cobra.Command {
@@ -40,6 +43,7 @@ func NewCmdRotate(f cmdutils.Factory) *cobra.Command {
 		io:        f.IO(),
 		apiClient: f.ApiClient,
 		baseRepo:  f.BaseRepo,
+		config:    f.Config,
 		duration:  tokenduration.TokenDuration(30 * 24 * time.Hour), // Default: 30 days
 	}
 
@@ -60,6 +64,28 @@ func NewCmdRotate(f cmdutils.Factory) *cobra.Command {
 			rotated token.
 
 			Administrators can rotate personal access tokens belonging to other users.
+
+			Use --propagate to store the new token wherever the old one is used, instead of printing it. Destinations are:
+
+			- config: the token of the GitLab instance in the glab configuration, or in the keyring if it is stored there.
+			- file=<path>: a file that contains only the token. It is replaced atomically and readable only by you.
+			- project-var=[<project>:]<KEY>: a CI/CD variable of the current or given project.
+			- group-var=<group>:<KEY>: a CI/CD variable of a group.
+
+			CI/CD variables that don't exist are created as masked variables. Hidden variables are not supported.
+
+			With --propagate, glab creates a new token with the same name, description, scopes, and access level,
+			updates every destination, and revokes the old token only after all destinations are updated.
+			If a destination fails, the destinations that were already updated are restored and the new token is
+			revoked, so the old token keeps working everywhere.
+
+			Group and project access tokens belong to a bot user, and GitLab creates a new bot user for every new
+			token. With --propagate, the new token therefore has a new bot user, with the access level of the old
+			token in the same group or project. Memberships of the old bot user in other groups and projects, and
+			anything else that refers to the old bot user, are not transferred. glab prints a warning before it
+			creates the token. Rotate without --propagate to keep the bot user.
+
+			With --propagate and --output json, the token value is left out of the output.
 		`),
 		Example: heredoc.Doc(`
 		# Rotate project access token of current project (default 30 days)
@@ -76,6 +102,12 @@ func NewCmdRotate(f cmdutils.Factory) *cobra.Command {
 
 		# Rotate a personal access token of another user (administrator only)
 		$ glab token rotate --user johndoe johns-personal-token --duration 90d
+
+		# Rotate a group bot token and store it in CI/CD variables of a group and a project
+		$ glab token rotate --group my-group bot-token --propagate group-var=my-group:BOT_TOKEN --propagate project-var=my-group/deployer:BOT_TOKEN
+
+		# Rotate my personal access token and update the glab configuration and a file
+		$ glab token rotate --user @me my-pat --propagate config --propagate file=$HOME/.gitlab-token
 		`),
 		Annotations: map[string]string{
 			mcpannotations.Destructive: "true",
@@ -99,6 +131,7 @@ func NewCmdRotate(f cmdutils.Factory) *cobra.Command {
 	cmd.Flags().VarP(&opts.duration, "duration", "D", "Sets the token lifetime in days. Accepts: days (30d), weeks (4w), or hours in multiples of 24 (24h, 168h, 720h). Maximum: 365d. The token expires at midnight UTC on the calculated date.")
 	cmd.Flags().VarP(&opts.expireAt, "expires-at", "E", "Sets the token's expiration date and time, in YYYY-MM-DD format. If not specified, --duration is used.")
 	cmd.Flags().StringVarP(&opts.outputFormat, "output", "F", "text", "Format output as: text, json. 'text' provides the new token value; 'json' outputs the token with metadata.")
+	cmd.Flags().StringArrayVar(&opts.propagate, "propagate", nil, "Store the new token in a destination, and revoke the old token only after all destinations are updated: config, file=<path>, project-var=[<project>:]<KEY>, group-var=<group>:<KEY>. Can be repeated.")
 	cmd.MarkFlagsMutuallyExclusive("duration", "expires-at")
 	return cmd
 }
@@ -145,6 +178,18 @@ func (o *options) run() error {
 	}
 	client := apiClient.Lab()
 
+	if repoHost == "" {
+		repoHost = client.BaseURL().Hostname()
+	}
+	destinations := make([]destination, 0, len(o.propagate))
+	for _, spec := range o.propagate {
+		d, err := o.parseDestination(spec, client, repoHost)
+		if err != nil {
+			return err
+		}
+		destinations = append(destinations, d)
+	}
+
 	expirationDate := gitlab.ISOTime(o.expireAt)
 
 	var outputToken any
@@ -178,11 +223,43 @@ func (o *options) run() error {
 		default:
 			return cmdutils.FlagError{Err: fmt.Errorf("multiple tokens found with the name '%v'. Use the ID instead.", o.name)}
 		}
-		rotateOptions := &gitlab.RotatePersonalAccessTokenOptions{
-			ExpiresAt: &expirationDate,
-		}
-		if token, _, err = client.PersonalAccessTokens.RotatePersonalAccessToken(token.ID, rotateOptions); err != nil {
-			return err
+		if len(destinations) == 0 {
+			rotateOptions := &gitlab.RotatePersonalAccessTokenOptions{
+				ExpiresAt: &expirationDate,
+			}
+			if token, _, err = client.PersonalAccessTokens.RotatePersonalAccessToken(token.ID, rotateOptions); err != nil {
+				return err
+			}
+		} else {
+			oldToken := token
+			if o.user == "@me" {
+				token, _, err = client.Users.CreatePersonalAccessTokenForCurrentUser(&gitlab.CreatePersonalAccessTokenForCurrentUserOptions{
+					Name:        &oldToken.Name,
+					Description: &oldToken.Description,
+					Scopes:      &oldToken.Scopes,
+					ExpiresAt:   &expirationDate,
+				})
+			} else {
+				token, _, err = client.Users.CreatePersonalAccessToken(user.ID, &gitlab.CreatePersonalAccessTokenOptions{
+					Name:        &oldToken.Name,
+					Description: &oldToken.Description,
+					Scopes:      &oldToken.Scopes,
+					ExpiresAt:   &expirationDate,
+				})
+			}
+			if err != nil {
+				return err
+			}
+			err = o.propagateToken(destinations, token.Token, func() error {
+				_, err := client.PersonalAccessTokens.RevokePersonalAccessTokenByID(token.ID)
+				return err
+			}, func() error {
+				_, err := client.PersonalAccessTokens.RevokePersonalAccessTokenByID(oldToken.ID)
+				return err
+			})
+			if err != nil {
+				return err
+			}
 		}
 		outputToken = token
 		outputTokenValue = token.Token
@@ -208,11 +285,36 @@ func (o *options) run() error {
 				return cmdutils.FlagError{Err: fmt.Errorf("multiple tokens found with the name '%v', use the ID instead", o.name)}
 			}
 
-			rotateOptions := &gitlab.RotateGroupAccessTokenOptions{
-				ExpiresAt: &expirationDate,
-			}
-			if token, _, err = client.GroupAccessTokens.RotateGroupAccessToken(o.group, token.ID, rotateOptions); err != nil {
-				return err
+			if len(destinations) == 0 {
+				rotateOptions := &gitlab.RotateGroupAccessTokenOptions{
+					ExpiresAt: &expirationDate,
+				}
+				if token, _, err = client.GroupAccessTokens.RotateGroupAccessToken(o.group, token.ID, rotateOptions); err != nil {
+					return err
+				}
+			} else {
+				oldToken := token
+				o.warnNewBotUser()
+				token, _, err = client.GroupAccessTokens.CreateGroupAccessToken(o.group, &gitlab.CreateGroupAccessTokenOptions{
+					Name:        &oldToken.Name,
+					Description: &oldToken.Description,
+					Scopes:      &oldToken.Scopes,
+					AccessLevel: &oldToken.AccessLevel,
+					ExpiresAt:   &expirationDate,
+				})
+				if err != nil {
+					return err
+				}
+				err = o.propagateToken(destinations, token.Token, func() error {
+					_, err := client.GroupAccessTokens.RevokeGroupAccessToken(o.group, token.ID)
+					return err
+				}, func() error {
+					_, err := client.GroupAccessTokens.RevokeGroupAccessToken(o.group, oldToken.ID)
+					return err
+				})
+				if err != nil {
+					return err
+				}
 			}
 			outputToken = token
 			outputTokenValue = token.Token
@@ -241,11 +343,36 @@ func (o *options) run() error {
 				return cmdutils.FlagError{Err: fmt.Errorf("multiple tokens found with the name '%v', use the ID instead", o.name)}
 			}
 
-			rotateOptions := &gitlab.RotateProjectAccessTokenOptions{
-				ExpiresAt: &expirationDate,
-			}
-			if token, _, err = client.ProjectAccessTokens.RotateProjectAccessToken(repo.FullName(), token.ID, rotateOptions); err != nil {
-				return err
+			if len(destinations) == 0 {
+				rotateOptions := &gitlab.RotateProjectAccessTokenOptions{
+					ExpiresAt: &expirationDate,
+				}
+				if token, _, err = client.ProjectAccessTokens.RotateProjectAccessToken(repo.FullName(), token.ID, rotateOptions); err != nil {
+					return err
+				}
+			} else {
+				oldToken := token
+				o.warnNewBotUser()
+				token, _, err = client.ProjectAccessTokens.CreateProjectAccessToken(repo.FullName(), &gitlab.CreateProjectAccessTokenOptions{
+					Name:        &oldToken.Name,
+					Description: &oldToken.Description,
+					Scopes:      &oldToken.Scopes,
+					AccessLevel: &oldToken.AccessLevel,
+					ExpiresAt:   &expirationDate,
+				})
+				if err != nil {
+					return err
+				}
+				err = o.propagateToken(destinations, token.Token, func() error {
+					_, err := client.ProjectAccessTokens.RevokeProjectAccessToken(repo.FullName(), token.ID)
+					return err
+				}, func() error {
+					_, err := client.ProjectAccessTokens.RevokeProjectAccessToken(repo.FullName(), oldToken.ID)
+					return err
+				})
+				if err != nil {
+					return err
+				}
 			}
 			outputToken = token
 			outputTokenValue = token.Token
@@ -253,11 +380,23 @@ func (o *options) run() error {
 	}
 
 	if o.outputFormat == "json" {
+		if len(destinations) > 0 {
+			// the new token is only stored in the destinations
+			switch t := outputToken.(type) {
+			case *gitlab.PersonalAccessToken:
+				t.Token = ""
+			case *gitlab.GroupAccessToken:
+				t.Token = ""
+			case *gitlab.ProjectAccessToken:
+				t.Token = ""
+			}
+		}
+
 		encoder := json.NewEncoder(o.io.StdOut)
 		if err := encoder.Encode(outputToken); err != nil {
 			return err
 		}
-	} else {
+	} else if len(destinations) == 0 {
 		if _, err := fmt.Fprintf(o.io.StdOut, "%s\n", outputTokenValue); err != nil {
 			return err
 		}
@@ -4,6 +4,10 @@ package rotate
 
 import (
 	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -307,3 +311,155 @@ func TestRotateProjectAccessToken(t *testing.T) {
 		})
 	}
 }
+
+func TestRotateProjectAccessTokenWithPropagate(t *testing.T) {
+	oldToken := &gitlab.ProjectAccessToken{
+		PersonalAccessToken: gitlab.PersonalAccessToken{
+			ID:          1,
+			Name:        "bot-token",
+			Description: "deploy bot",
+			Scopes:      []string{"read_api"},
+			Active:      true,
+		},
+		AccessLevel: gitlab.DeveloperPermissions,
+	}
+	newToken := &gitlab.ProjectAccessToken{
+		PersonalAccessToken: gitlab.PersonalAccessToken{
+			ID:     2,
+			Name:   "bot-token",
+			Scopes: []string{"read_api"},
+			Active: true,
+			Token:  "glpat-new",
+		},
+		AccessLevel: gitlab.DeveloperPermissions,
+	}
+
+	setup := func(t *testing.T, setupMock func(tc *gitlabtesting.TestClient)) cmdtest.CmdExecFunc {
+		testClient := gitlabtesting.NewTestClient(t)
+		testClient.MockProjectAccessTokens.EXPECT().
+			ListProjectAccessTokens("OWNER/REPO", gomock.Any(), gomock.Any()).
+			Return([]*gitlab.ProjectAccessToken{oldToken}, noMorePages(), nil)
+		testClient.MockProjectAccessTokens.EXPECT().
+			CreateProjectAccessToken("OWNER/REPO", gomock.Any()).
+			DoAndReturn(func(_ any, opts *gitlab.CreateProjectAccessTokenOptions, _ ...gitlab.RequestOptionFunc) (*gitlab.ProjectAccessToken, *gitlab.Response, error) {
+				assert.Equal(t, "bot-token", *opts.Name)
+				assert.Equal(t, "deploy bot", *opts.Description)
+				assert.Equal(t, []string{"read_api"}, *opts.Scopes)
+				assert.Equal(t, gitlab.DeveloperPermissions, *opts.AccessLevel)
+				token := *newToken
+				return &token, nil, nil
+			})
+		setupMock(testClient)
+
+		return cmdtest.SetupCmdForTest(
+			t,
+			NewCmdRotate,
+			true,
+			cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+		)
+	}
+
+	t.Run("updates all destinations before revoking the old token", func(t *testing.T) {
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(tokenFile, []byte("glpat-old\n"), 0o600))
+
+		exec := setup(t, func(tc *gitlabtesting.TestClient) {
+			gomock.InOrder(
+				tc.MockProjectVariables.EXPECT().
+					GetVariable("OWNER/REPO", "BOT_TOKEN", gomock.Any()).
+					Return(&gitlab.ProjectVariable{Key: "BOT_TOKEN", Value: "glpat-old"}, nil, nil),
+				tc.MockProjectVariables.EXPECT().
+					UpdateVariable("OWNER/REPO", "BOT_TOKEN", &gitlab.UpdateProjectVariableOptions{Value: gitlab.Ptr("glpat-new")}).
+					Return(&gitlab.ProjectVariable{}, nil, nil),
+				tc.MockProjectAccessTokens.EXPECT().
+					RevokeProjectAccessToken("OWNER/REPO", int64(1)).
+					Return(nil, nil),
+			)
+		})
+
+		out, err := exec("bot-token --propagate project-var=BOT_TOKEN --propagate file=" + tokenFile)
+		require.NoError(t, err)
+
+		assert.Empty(t, out.OutBuf.String())
+		assert.Contains(t, out.ErrBuf.String(), "The new token gets a new bot user.")
+		assert.Contains(t, out.ErrBuf.String(), "Revoked the old token.")
+
+		content, err := os.ReadFile(tokenFile)
+		require.NoError(t, err)
+		assert.Equal(t, "glpat-new\n", string(content))
+	})
+
+	t.Run("omits the token value from JSON output", func(t *testing.T) {
+		tokenFile := filepath.Join(t.TempDir(), "token")
+
+		exec := setup(t, func(tc *gitlabtesting.TestClient) {
+			tc.MockProjectAccessTokens.EXPECT().
+				RevokeProjectAccessToken("OWNER/REPO", int64(1)).
+				Return(nil, nil)
+		})
+
+		out, err := exec("bot-token --output json --propagate file=" + tokenFile)
+		require.NoError(t, err)
+
+		var result map[string]any
+		require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &result))
+		assert.Equal(t, float64(2), result["id"])
+		assert.NotContains(t, result, "token")
+		assert.NotContains(t, out.OutBuf.String(), "glpat-new")
+	})
+
+	t.Run("rolls back when a destination fails", func(t *testing.T) {
+		tokenFile := filepath.Join(t.TempDir(), "token")
+		require.NoError(t, os.WriteFile(tokenFile, []byte("glpat-old\n"), 0o600))
+
+		exec := setup(t, func(tc *gitlabtesting.TestClient) {
+			tc.MockProjectAccessTokens.EXPECT().
+				RevokeProjectAccessToken("OWNER/REPO", int64(1)).
+				Times(0)
+			gomock.InOrder(
+				tc.MockProjectVariables.EXPECT().
+					GetVariable("OWNER/REPO", "BOT_TOKEN", gomock.Any()).
+					Return(&gitlab.ProjectVariable{Key: "BOT_TOKEN", Value: "glpat-old"}, nil, nil),
+				tc.MockProjectVariables.EXPECT().
+					UpdateVariable("OWNER/REPO", "BOT_TOKEN", &gitlab.UpdateProjectVariableOptions{Value: gitlab.Ptr("glpat-new")}).
+					Return(&gitlab.ProjectVariable{}, nil, nil),
+				tc.MockGroupVariables.EXPECT().
+					GetVariable("my-group", "BOT_TOKEN", gomock.Any()).
+					Return(nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}, errors.New("403 Forbidden")),
+				tc.MockProjectVariables.EXPECT().
+					UpdateVariable("OWNER/REPO", "BOT_TOKEN", &gitlab.UpdateProjectVariableOptions{Value: gitlab.Ptr("glpat-old")}).
+					Return(&gitlab.ProjectVariable{}, nil, nil),
+				tc.MockProjectAccessTokens.EXPECT().
+					RevokeProjectAccessToken("OWNER/REPO", int64(2)).
+					Return(nil, nil),
+			)
+		})
+
+		out, err := exec("bot-token --propagate project-var=BOT_TOKEN --propagate file=" + tokenFile + " --propagate group-var=my-group:BOT_TOKEN")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to update CI/CD variable BOT_TOKEN of group my-group")
+
+		assert.Empty(t, out.OutBuf.String())
+		assert.Contains(t, out.ErrBuf.String(), "Restored CI/CD variable BOT_TOKEN of project OWNER/REPO.")
+		assert.Contains(t, out.ErrBuf.String(), "Revoked the new token.")
+		assert.NotContains(t, out.ErrBuf.String(), "Revoked the old token.")
+
+		content, err := os.ReadFile(tokenFile)
+		require.NoError(t, err)
+		assert.Equal(t, "glpat-old\n", string(content))
+	})
+
+	t.Run("rejects invalid destinations", func(t *testing.T) {
+		testClient := gitlabtesting.NewTestClient(t)
+		exec := cmdtest.SetupCmdForTest(
+			t,
+			NewCmdRotate,
+			true,
+			cmdtest.WithApiClient(cmdtest.NewTestApiClient(t, nil, "", "", api.WithGitLabClient(testClient.Client))),
+		)
+
+		_, err := exec("bot-token --propagate group-var=BOT_TOKEN")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `invalid --propagate value "group-var=BOT_TOKEN"`)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/token/audit"
 	"gitlab.com/gitlab-org/cli/internal/commands/token/create"
 	"gitlab.com/gitlab-org/cli/internal/commands/token/list"
 	"gitlab.com/gitlab-org/cli/internal/commands/token/revoke"
@@ -22,5 +23,6 @@ func NewTokenCmd(f cmdutils.Factory) *cobra.Command {
 	cmd.AddCommand(revoke.NewCmdRevoke(f))
 	cmd.AddCommand(rotate.NewCmdRotate(f))
 	cmd.AddCommand(list.NewCmdList(f))
+	cmd.AddCommand(audit.NewCmdAudit(f))
 	return cmd
 }
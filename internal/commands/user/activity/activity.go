@@ -0,0 +1,400 @@
+package activity
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+// Report is the activity of users in a period, as printed in JSON.
+type Report struct {
+	Since string  `json:"since"`
+	Until string  `json:"until"`
+	Group string  `json:"group,omitempty"`
+	Users []*User `json:"users"`
+}
+
+// User is the activity of a user, in total and by project.
+type User struct {
+	Username string     `json:"username"`
+	Total    Counts     `json:"total"`
+	Projects []*Project `json:"projects"`
+}
+
+// Project is the activity of a user in a project.
+type Project struct {
+	Project string `json:"project"`
+	Counts
+}
+
+// Counts are the numbers of contributions of each kind.
+type Counts struct {
+	MergeRequestsOpened int `json:"merge_requests_opened"`
+	MergeRequestsMerged int `json:"merge_requests_merged"`
+	// MergeRequestsReviewed is the number of merge requests of others that the user approved
+	// or commented on. Each merge request is counted once.
+	MergeRequestsReviewed int   `json:"merge_requests_reviewed"`
+	IssuesOpened          int   `json:"issues_opened"`
+	IssuesClosed          int   `json:"issues_closed"`
+	Comments              int   `json:"comments"`
+	Pushes                int   `json:"pushes"`
+	Commits               int64 `json:"commits"`
+}
+
+// add counts the event, and returns false when it isn't a kind of contribution of the report.
+// Reviews aren't counted by event, but by merge request in summarize.
+func (c *Counts) add(e *gitlab.ContributionEvent) bool {
+	switch {
+	case e.TargetType == "MergeRequest" && e.ActionName == "opened":
+		c.MergeRequestsOpened++
+	case e.TargetType == "MergeRequest" && e.ActionName == "accepted":
+		c.MergeRequestsMerged++
+	case e.TargetType == "MergeRequest" && e.ActionName == "approved":
+		// Counted as a review by summarize.
+	case e.TargetType == "Issue" && e.ActionName == "opened":
+		c.IssuesOpened++
+	case e.TargetType == "Issue" && e.ActionName == "closed":
+		c.IssuesClosed++
+	case e.ActionName == "commented on":
+		c.Comments++
+	case e.ActionName == "pushed to" || e.ActionName == "pushed new":
+		c.Pushes++
+		c.Commits += e.PushData.CommitCount
+	default:
+		return false
+	}
+	return true
+}
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+
+	user         string
+	group        string
+	since        string
+	until        string
+	outputFormat string
+
+	// projects caches the paths of projects by ID, because events only have the ID.
+	projects map[int64]string
+	// authors caches the usernames of the authors of merge requests, because comments only
+	// have the IID of the merge request.
+	authors map[mergeRequest]string
+}
+
+// mergeRequest identifies a merge request in the events.
+type mergeRequest struct {
+	projectID int64
+	iid       int64
+}
+
+func NewCmdActivity(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		projects:     map[int64]string{},
+		authors:      map[mergeRequest]string{},
+	}
+
+	cmd := &cobra.Command{
+		Use:   "activity [flags]",
+		Short: "Summarize the activity of a user or a team.",
+		Long: heredoc.Docf(`
+			Summarize the activity of a user by project: merge requests opened, merged, and
+			reviewed, issues opened and closed, comments, and pushes.
+
+			A merge request is reviewed when the user approved it, or commented on it without
+			being its author. Each merge request is counted once, however many times the user
+			reviewed it.
+
+			With %[1]s--group%[1]s, the report covers each direct member of the group, and only the
+			activity in the projects of the group and its subgroups. Use %[1]s--user%[1]s to only
+			report one of them.
+
+			The report covers the last 7 days, unless you give %[1]s--since%[1]s or %[1]s--until%[1]s.
+			Both days are included. The report is in Markdown, to paste in issues or wikis, or JSON.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab user activity
+			$ glab user activity --user alice --since 2026-10-01 --until 2026-10-31
+			$ glab user activity --group acme/backend > weekly.md
+			$ glab user activity --group acme/backend -F json
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringVarP(&opts.user, "user", "u", "", "Username of the user. Defaults to you, or to all the members of the group with --group.")
+	fl.StringVarP(&opts.group, "group", "g", "", "Report the activity of the members of a group in its projects.")
+	fl.StringVar(&opts.since, "since", "", "First day of the report, in YYYY-MM-DD format. Defaults to 6 days ago.")
+	fl.StringVar(&opts.until, "until", "", "Last day of the report, in YYYY-MM-DD format. Defaults to today.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"markdown", "json"}, "markdown", &opts.outputFormat), "output", "F", "Format output as: markdown, json.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	now := time.Now()
+	if o.since == "" {
+		o.since = now.AddDate(0, 0, -6).Format(time.DateOnly)
+	}
+	if o.until == "" {
+		o.until = now.Format(time.DateOnly)
+	}
+	since, err := time.Parse(time.DateOnly, o.since)
+	if err != nil {
+		return &cmdutils.FlagError{Err: fmt.Errorf("--since: invalid date %q. Use the YYYY-MM-DD format.", o.since)}
+	}
+	until, err := time.Parse(time.DateOnly, o.until)
+	if err != nil {
+		return &cmdutils.FlagError{Err: fmt.Errorf("--until: invalid date %q. Use the YYYY-MM-DD format.", o.until)}
+	}
+	if until.Before(since) {
+		return &cmdutils.FlagError{Err: errors.New("--until must not be before --since.")}
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	var usernames []string
+	// inGroup is nil without --group, and then all projects are reported.
+	var inGroup map[int64]bool
+	switch {
+	case o.group != "":
+		inGroup, err = o.groupProjects(client)
+		if err != nil {
+			return err
+		}
+		if o.user != "" {
+			usernames = []string{o.user}
+		} else if usernames, err = o.groupMembers(client); err != nil {
+			return err
+		}
+	case o.user != "":
+		usernames = []string{o.user}
+	default:
+		usernames = []string{"@me"}
+	}
+
+	report := &Report{Since: o.since, Until: o.until, Group: o.group, Users: []*User{}}
+	for _, username := range usernames {
+		if username == "@me" {
+			u, _, err := client.Users.CurrentUser()
+			if err != nil {
+				return err
+			}
+			username = u.Username
+		}
+
+		// The API filters events strictly after and before the dates.
+		events, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.ContributionEvent, *gitlab.Response, error) {
+			return client.Users.ListUserContributionEvents(username, &gitlab.ListContributionEventsOptions{
+				ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+				After:       gitlab.Ptr(gitlab.ISOTime(since.AddDate(0, 0, -1))),
+				Before:      gitlab.Ptr(gitlab.ISOTime(until.AddDate(0, 0, 1))),
+				Sort:        gitlab.Ptr("asc"),
+			}, p)
+		})
+		if err != nil {
+			return fmt.Errorf("error listing the events of %s: %w", username, err)
+		}
+
+		user, err := o.summarize(client, username, events, inGroup)
+		if err != nil {
+			return err
+		}
+		report.Users = append(report.Users, user)
+	}
+
+	if o.outputFormat == "json" {
+		return o.io.PrintJSON(report)
+	}
+	writeMarkdown(o.io.StdOut, report)
+	return nil
+}
+
+// groupProjects returns the IDs of the projects of the group and its subgroups, and caches
+// their paths.
+func (o *options) groupProjects(client *gitlab.Client) (map[int64]bool, error) {
+	projects, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+		return client.Groups.ListGroupProjects(o.group, &gitlab.ListGroupProjectsOptions{
+			ListOptions:      gitlab.ListOptions{PerPage: api.MaxPerPage},
+			IncludeSubGroups: gitlab.Ptr(true),
+			Simple:           gitlab.Ptr(true),
+		}, p)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing the projects of group %s: %w", o.group, err)
+	}
+
+	ids := map[int64]bool{}
+	for _, p := range projects {
+		ids[p.ID] = true
+		o.projects[p.ID] = p.PathWithNamespace
+	}
+	return ids, nil
+}
+
+func (o *options) groupMembers(client *gitlab.Client) ([]string, error) {
+	members, err := gitlab.ScanAndCollect(func(p gitlab.PaginationOptionFunc) ([]*gitlab.GroupMember, *gitlab.Response, error) {
+		return client.Groups.ListGroupMembers(o.group, &gitlab.ListGroupMembersOptions{
+			ListOptions: gitlab.ListOptions{PerPage: api.MaxPerPage},
+		}, p)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing the members of group %s: %w", o.group, err)
+	}
+
+	usernames := make([]string, 0, len(members))
+	for _, m := range members {
+		usernames = append(usernames, m.Username)
+	}
+	return usernames, nil
+}
+
+// summarize counts the events of the user by project, in the order of the first contribution
+// to each. Events in projects outside inGroup are skipped, unless inGroup is nil.
+func (o *options) summarize(client *gitlab.Client, username string, events []*gitlab.ContributionEvent, inGroup map[int64]bool) (*User, error) {
+	user := &User{Username: username, Projects: []*Project{}}
+	byID := map[int64]*Project{}
+	reviewed := map[mergeRequest]bool{}
+	for _, e := range events {
+		if inGroup != nil && !inGroup[e.ProjectID] {
+			continue
+		}
+
+		counts := Counts{}
+		if !counts.add(e) {
+			continue
+		}
+		user.Total.add(e)
+
+		project, ok := byID[e.ProjectID]
+		if !ok {
+			path, err := o.projectPath(client, e.ProjectID)
+			if err != nil {
+				return nil, err
+			}
+			project = &Project{Project: path}
+			byID[e.ProjectID] = project
+			user.Projects = append(user.Projects, project)
+		}
+		project.add(e)
+
+		mr, ok, err := o.reviewOf(client, username, e)
+		if err != nil {
+			return nil, err
+		}
+		if ok && !reviewed[mr] {
+			reviewed[mr] = true
+			project.MergeRequestsReviewed++
+			user.Total.MergeRequestsReviewed++
+		}
+	}
+	return user, nil
+}
+
+// reviewOf returns the merge request that the event reviews, and false when the event isn't a
+// review: an approval, or a comment on a merge request of someone else.
+func (o *options) reviewOf(client *gitlab.Client, username string, e *gitlab.ContributionEvent) (mergeRequest, bool, error) {
+	switch {
+	case e.TargetType == "MergeRequest" && e.ActionName == "approved":
+		return mergeRequest{projectID: e.ProjectID, iid: e.TargetIID}, true, nil
+	case e.ActionName == "commented on" && e.Note != nil && e.Note.NoteableType == "MergeRequest":
+		mr := mergeRequest{projectID: e.ProjectID, iid: e.Note.NoteableIID}
+		author, err := o.author(client, mr)
+		if err != nil {
+			return mergeRequest{}, false, err
+		}
+		return mr, author != "" && author != username, nil
+	}
+	return mergeRequest{}, false, nil
+}
+
+// author returns the username of the author of the merge request, or "" when the merge request
+// was deleted or can't be read, so that comments on it aren't counted as reviews.
+func (o *options) author(client *gitlab.Client, mr mergeRequest) (string, error) {
+	if author, ok := o.authors[mr]; ok {
+		return author, nil
+	}
+	m, resp, err := client.MergeRequests.GetMergeRequest(mr.projectID, mr.iid, nil)
+	switch {
+	case err == nil:
+	case resp != nil && (resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusForbidden):
+		o.authors[mr] = ""
+		return "", nil
+	default:
+		return "", fmt.Errorf("error getting merge request !%d of project %d: %w", mr.iid, mr.projectID, err)
+	}
+	var author string
+	if m.Author != nil {
+		author = m.Author.Username
+	}
+	o.authors[mr] = author
+	return author, nil
+}
+
+func (o *options) projectPath(client *gitlab.Client, id int64) (string, error) {
+	if path, ok := o.projects[id]; ok {
+		return path, nil
+	}
+	project, _, err := client.Projects.GetProject(id, nil)
+	if err != nil {
+		return "", fmt.Errorf("error getting project %d: %w", id, err)
+	}
+	o.projects[id] = project.PathWithNamespace
+	return project.PathWithNamespace, nil
+}
+
+var columns = []string{"Project", "MRs opened", "MRs merged", "MRs reviewed", "Issues opened", "Issues closed", "Comments", "Pushes", "Commits"}
+
+func writeMarkdown(w io.Writer, report *Report) {
+	if report.Group != "" {
+		fmt.Fprintf(w, "# Activity in %s from %s to %s\n", report.Group, report.Since, report.Until)
+	} else {
+		fmt.Fprintf(w, "# Activity from %s to %s\n", report.Since, report.Until)
+	}
+
+	for _, u := range report.Users {
+		fmt.Fprintf(w, "\n## @%s\n\n", u.Username)
+		if len(u.Projects) == 0 {
+			fmt.Fprintln(w, "No activity.")
+			continue
+		}
+
+		fmt.Fprintf(w, "| %s |\n", strings.Join(columns, " | "))
+		fmt.Fprintf(w, "|%s\n", strings.Repeat(" --- |", len(columns)))
+		for _, p := range u.Projects {
+			writeRow(w, p.Project, p.Counts)
+		}
+		writeRow(w, "**Total**", u.Total)
+	}
+}
+
+func writeRow(w io.Writer, name string, c Counts) {
+	fmt.Fprintf(w, "| %s | %d | %d | %d | %d | %d | %d | %d | %d |\n", name,
+		c.MergeRequestsOpened, c.MergeRequestsMerged, c.MergeRequestsReviewed,
+		c.IssuesOpened, c.IssuesClosed, c.Comments, c.Pushes, c.Commits)
+}
@@ -0,0 +1,163 @@
+//go:build !integration
+
+package activity
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func event(projectID int64, targetType, action string) *gitlab.ContributionEvent {
+	return &gitlab.ContributionEvent{ProjectID: projectID, TargetType: targetType, ActionName: action}
+}
+
+// comment returns a comment on the issue or merge request with the IID.
+func comment(projectID int64, noteableType string, iid int64) *gitlab.ContributionEvent {
+	e := event(projectID, "DiffNote", "commented on")
+	e.Note = &gitlab.Note{NoteableType: noteableType, NoteableIID: iid}
+	return e
+}
+
+// aliceEvents have comments on !5 of bob, twice, and on !6 of alice.
+var aliceEvents = []*gitlab.ContributionEvent{
+	event(1, "MergeRequest", "opened"),
+	event(1, "MergeRequest", "accepted"),
+	event(2, "Issue", "opened"),
+	event(1, "DiffNote", "commented on"),
+	comment(1, "MergeRequest", 5),
+	comment(1, "MergeRequest", 5),
+	comment(1, "MergeRequest", 6),
+	comment(1, "Issue", 5),
+	{ProjectID: 1, ActionName: "pushed to", PushData: gitlab.ContributionEventPushData{CommitCount: 3}},
+	event(2, "MergeRequest", "approved"),
+	event(2, "Issue", "closed"),
+	event(1, "", "joined"),
+}
+
+// expectEvents mocks the events of the user in the period of 2026-10-12 to 2026-10-18.
+func expectEvents(t *testing.T, tc *gitlabtesting.TestClient, username string, events []*gitlab.ContributionEvent) {
+	tc.MockUsers.EXPECT().
+		ListUserContributionEvents(username, gomock.Any(), gomock.Any()).
+		DoAndReturn(func(uid any, opts *gitlab.ListContributionEventsOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.ContributionEvent, *gitlab.Response, error) {
+			assert.Equal(t, "2026-10-11", time.Time(*opts.After).Format(time.DateOnly))
+			assert.Equal(t, "2026-10-19", time.Time(*opts.Before).Format(time.DateOnly))
+			return events, &gitlab.Response{}, nil
+		})
+}
+
+func TestUserActivity(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockUsers.EXPECT().CurrentUser().Return(&gitlab.User{Username: "alice"}, nil, nil)
+	expectEvents(t, tc, "alice", aliceEvents)
+	tc.MockProjects.EXPECT().GetProject(int64(1), gomock.Any()).Return(&gitlab.Project{PathWithNamespace: "acme/web"}, nil, nil)
+	tc.MockProjects.EXPECT().GetProject(int64(2), gomock.Any()).Return(&gitlab.Project{PathWithNamespace: "acme/api"}, nil, nil)
+	tc.MockMergeRequests.EXPECT().
+		GetMergeRequest(int64(1), int64(5), gomock.Any()).
+		Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{Author: &gitlab.BasicUser{Username: "bob"}}}, nil, nil)
+	tc.MockMergeRequests.EXPECT().
+		GetMergeRequest(int64(1), int64(6), gomock.Any()).
+		Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{Author: &gitlab.BasicUser{Username: "alice"}}}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdActivity, false, cmdtest.WithGitLabClient(tc.Client))
+	out, err := exec("--since 2026-10-12 --until 2026-10-18")
+	require.NoError(t, err)
+
+	assert.Equal(t, `# Activity from 2026-10-12 to 2026-10-18
+
+## @alice
+
+| Project | MRs opened | MRs merged | MRs reviewed | Issues opened | Issues closed | Comments | Pushes | Commits |
+| --- | --- | --- | --- | --- | --- | --- | --- | --- |
+| acme/web | 1 | 1 | 1 | 0 | 0 | 5 | 1 | 3 |
+| acme/api | 0 | 0 | 1 | 1 | 1 | 0 | 0 | 0 |
+| **Total** | 1 | 1 | 2 | 1 | 1 | 5 | 1 | 3 |
+`, out.String())
+}
+
+func TestUserActivity_inaccessibleMergeRequests(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockUsers.EXPECT().CurrentUser().Return(&gitlab.User{Username: "alice"}, nil, nil)
+	expectEvents(t, tc, "alice", []*gitlab.ContributionEvent{
+		comment(1, "MergeRequest", 5),
+		comment(1, "MergeRequest", 5),
+		comment(1, "MergeRequest", 6),
+		comment(1, "MergeRequest", 7),
+	})
+	tc.MockProjects.EXPECT().GetProject(int64(1), gomock.Any()).Return(&gitlab.Project{PathWithNamespace: "acme/web"}, nil, nil)
+	tc.MockMergeRequests.EXPECT().
+		GetMergeRequest(int64(1), int64(5), gomock.Any()).
+		Return(nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusNotFound}}, errors.New("404 Not Found"))
+	tc.MockMergeRequests.EXPECT().
+		GetMergeRequest(int64(1), int64(6), gomock.Any()).
+		Return(nil, &gitlab.Response{Response: &http.Response{StatusCode: http.StatusForbidden}}, errors.New("403 Forbidden"))
+	tc.MockMergeRequests.EXPECT().
+		GetMergeRequest(int64(1), int64(7), gomock.Any()).
+		Return(&gitlab.MergeRequest{BasicMergeRequest: gitlab.BasicMergeRequest{Author: &gitlab.BasicUser{Username: "bob"}}}, nil, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdActivity, false, cmdtest.WithGitLabClient(tc.Client))
+	out, err := exec("--since 2026-10-12 --until 2026-10-18 -F json")
+	require.NoError(t, err)
+
+	var report Report
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &report))
+	require.Len(t, report.Users, 1)
+	assert.Equal(t, Counts{MergeRequestsReviewed: 1, Comments: 4}, report.Users[0].Total)
+}
+
+func TestUserActivity_group(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	tc.MockGroups.EXPECT().
+		ListGroupProjects("acme", gomock.Any(), gomock.Any()).
+		DoAndReturn(func(gid any, opts *gitlab.ListGroupProjectsOptions, _ ...gitlab.RequestOptionFunc) ([]*gitlab.Project, *gitlab.Response, error) {
+			assert.True(t, *opts.IncludeSubGroups)
+			return []*gitlab.Project{{ID: 2, PathWithNamespace: "acme/api"}}, &gitlab.Response{}, nil
+		})
+	tc.MockGroups.EXPECT().
+		ListGroupMembers("acme", gomock.Any(), gomock.Any()).
+		Return([]*gitlab.GroupMember{{Username: "alice"}, {Username: "bob"}}, &gitlab.Response{}, nil)
+	expectEvents(t, tc, "alice", aliceEvents)
+	expectEvents(t, tc, "bob", []*gitlab.ContributionEvent{event(3, "Issue", "opened")})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdActivity, false, cmdtest.WithGitLabClient(tc.Client))
+	out, err := exec("--group acme --since 2026-10-12 --until 2026-10-18 -F json")
+	require.NoError(t, err)
+
+	var report Report
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &report))
+	assert.Equal(t, "acme", report.Group)
+	require.Len(t, report.Users, 2)
+
+	// Only the events in the projects of the group are counted.
+	alice := report.Users[0]
+	assert.Equal(t, "alice", alice.Username)
+	require.Len(t, alice.Projects, 1)
+	assert.Equal(t, "acme/api", alice.Projects[0].Project)
+	assert.Equal(t, Counts{MergeRequestsReviewed: 1, IssuesOpened: 1, IssuesClosed: 1}, alice.Total)
+
+	bob := report.Users[1]
+	assert.Equal(t, "bob", bob.Username)
+	assert.Empty(t, bob.Projects)
+	assert.Equal(t, Counts{}, bob.Total)
+}
+
+func TestUserActivity_invalidDates(t *testing.T) {
+	exec := cmdtest.SetupCmdForTest(t, NewCmdActivity, false)
+
+	_, err := exec("--since 12/10/2026")
+	assert.EqualError(t, err, `--since: invalid date "12/10/2026". Use the YYYY-MM-DD format.`)
+
+	_, err = exec("--since 2026-10-18 --until 2026-10-12")
+	assert.EqualError(t, err, "--until must not be before --since.")
+}
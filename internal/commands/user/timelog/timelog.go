@@ -0,0 +1,241 @@
+package timelog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/timetracking"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// Report is the time logged by a user in a period, as printed in JSON.
+type Report struct {
+	User      string `json:"user"`
+	Since     string `json:"since"`
+	Until     string `json:"until"`
+	TimeSpent int64  `json:"time_spent"`
+	Rows      []*Row `json:"rows"`
+}
+
+// Row is the time logged on a project, an issue or merge request, or a day. Only the fields of
+// the grouping are set.
+type Row struct {
+	Project   string `json:"project,omitempty"`
+	Reference string `json:"reference,omitempty"`
+	Title     string `json:"title,omitempty"`
+	Date      string `json:"date,omitempty"`
+	TimeSpent int64  `json:"time_spent"`
+}
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+
+	user         string
+	group        string
+	since        string
+	until        string
+	by           string
+	outputFormat string
+}
+
+func NewCmdTimelog(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "timelog [flags]",
+		Short: "Report the time logged by a user.",
+		Long: heredoc.Docf(`
+			Report the time logged by a user on issues and merge requests, summed by project,
+			by issue or merge request, or by day.
+
+			The report covers the current month, unless you give %[1]s--since%[1]s or %[1]s--until%[1]s.
+			Both days are included. Each row has the time in seconds, in hours, and in the format of
+			GitLab, where a day is 8 hours.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab user timelog
+			$ glab user timelog --since 2026-09-01 --until 2026-09-30 --by issue > september.csv
+			$ glab user timelog --user alice --group acme-client -F json
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := cmd.Flags()
+	fl.StringVarP(&opts.user, "user", "u", "@me", "Username of the user. Defaults to you.")
+	fl.StringVarP(&opts.group, "group", "g", "", "Only report the time logged in the projects of a group.")
+	fl.StringVar(&opts.since, "since", "", "First day of the report, in YYYY-MM-DD format. Defaults to the first day of the month.")
+	fl.StringVar(&opts.until, "until", "", "Last day of the report, in YYYY-MM-DD format. Defaults to today.")
+	fl.Var(cmdutils.NewEnumValue([]string{"project", "issue", "day"}, "project", &opts.by), "by", "Sum the time by: project, issue, day.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"csv", "json"}, "csv", &opts.outputFormat), "output", "F", "Format output as: csv, json.")
+
+	return cmd
+}
+
+func (o *options) run() error {
+	now := time.Now()
+	if o.since == "" {
+		o.since = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local).Format(time.DateOnly)
+	}
+	if o.until == "" {
+		o.until = now.Format(time.DateOnly)
+	}
+	since, err := time.Parse(time.DateOnly, o.since)
+	if err != nil {
+		return &cmdutils.FlagError{Err: fmt.Errorf("--since: invalid date %q. Use the YYYY-MM-DD format.", o.since)}
+	}
+	until, err := time.Parse(time.DateOnly, o.until)
+	if err != nil {
+		return &cmdutils.FlagError{Err: fmt.Errorf("--until: invalid date %q. Use the YYYY-MM-DD format.", o.until)}
+	}
+	if until.Before(since) {
+		return &cmdutils.FlagError{Err: errors.New("--until must not be before --since.")}
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+
+	listOpts := &timetracking.ListOptions{Username: o.user, Since: o.since, Until: o.until}
+	if o.user == "@me" {
+		u, _, err := client.Users.CurrentUser()
+		if err != nil {
+			return err
+		}
+		listOpts.Username = u.Username
+	}
+	if o.group != "" {
+		g, _, err := client.Groups.GetGroup(o.group, &gitlab.GetGroupOptions{WithProjects: gitlab.Ptr(false)})
+		if err != nil {
+			return fmt.Errorf("error getting group %s: %w", o.group, err)
+		}
+		listOpts.GroupID = g.ID
+	}
+
+	timelogs, err := timetracking.ListTimelogs(client, listOpts)
+	if err != nil {
+		return err
+	}
+
+	report := &Report{
+		User:  listOpts.Username,
+		Since: o.since,
+		Until: o.until,
+		Rows:  o.sum(timelogs),
+	}
+	for _, t := range timelogs {
+		report.TimeSpent += t.TimeSpent
+	}
+
+	if o.outputFormat == "json" {
+		data, err := json.Marshal(report)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(o.io.StdOut, string(data))
+		return nil
+	}
+
+	if err := o.writeCSV(o.io.StdOut, report.Rows); err != nil {
+		return err
+	}
+	if o.io.IsErrTTY {
+		fmt.Fprintf(o.io.StdErr, "%s logged %s from %s to %s.\n", report.User, utils.FmtTimeTracking(report.TimeSpent), o.since, o.until)
+	}
+	return nil
+}
+
+// sum returns the time logged by project, issue or merge request, or day, in the order of the
+// first time logged on each. Time logs are sorted by day, so days are in order.
+func (o *options) sum(timelogs []*timetracking.Timelog) []*Row {
+	rows := []*Row{}
+	byKey := map[string]*Row{}
+	for _, t := range timelogs {
+		var key string
+		row := &Row{}
+		switch o.by {
+		case "project":
+			key = t.Project
+			row.Project = t.Project
+		case "issue":
+			key = t.Reference
+			row.Project = t.Project
+			row.Reference = t.Reference
+			row.Title = t.Title
+		case "day":
+			key = t.SpentAt.In(time.Local).Format(time.DateOnly)
+			row.Date = key
+		}
+
+		if existing, ok := byKey[key]; ok {
+			row = existing
+		} else {
+			byKey[key] = row
+			rows = append(rows, row)
+		}
+		row.TimeSpent += t.TimeSpent
+	}
+	return rows
+}
+
+func (o *options) writeCSV(w io.Writer, rows []*Row) error {
+	var header []string
+	switch o.by {
+	case "project":
+		header = []string{"project"}
+	case "issue":
+		header = []string{"project", "reference", "title"}
+	case "day":
+		header = []string{"date"}
+	}
+	header = append(header, "seconds", "hours", "time_spent")
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, r := range rows {
+		var record []string
+		switch o.by {
+		case "project":
+			record = []string{r.Project}
+		case "issue":
+			record = []string{r.Project, r.Reference, r.Title}
+		case "day":
+			record = []string{r.Date}
+		}
+		record = append(record,
+			strconv.FormatInt(r.TimeSpent, 10),
+			strconv.FormatFloat(float64(r.TimeSpent)/3600, 'f', 2, 64),
+			utils.FmtTimeTracking(r.TimeSpent),
+		)
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
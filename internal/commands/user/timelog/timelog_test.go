@@ -0,0 +1,113 @@
+//go:build !integration
+
+package timelog
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const page1 = `{"data": {"timelogs": {
+	"nodes": [
+		{"spentAt": "2026-09-01T11:00:00Z", "timeSpent": 7200, "project": {"fullPath": "acme/web"},
+		 "issue": {"title": "Fix login", "reference": "acme/web#12"}},
+		{"spentAt": "2026-09-01T13:00:00Z", "timeSpent": 1800, "project": {"fullPath": "acme/api"},
+		 "mergeRequest": {"title": "Add rate limits", "reference": "acme/api!7"}}
+	],
+	"pageInfo": {"hasNextPage": true, "endCursor": "c1"}
+}}}`
+
+const page2 = `{"data": {"timelogs": {
+	"nodes": [
+		{"spentAt": "2026-09-02T12:00:00Z", "timeSpent": 3600, "project": {"fullPath": "acme/web"},
+		 "issue": {"title": "Fix login, again", "reference": "acme/web#13"}}
+	],
+	"pageInfo": {"hasNextPage": false}
+}}}`
+
+func setup(t *testing.T, wantVariables map[string]any) cmdtest.CmdExecFunc {
+	t.Helper()
+
+	tc := gitlabtesting.NewTestClient(t)
+	for i, body := range []string{page1, page2} {
+		cmdtest.ExpectGraphQL(tc, body, func(query gitlab.GraphQLQuery) {
+			for k, v := range wantVariables {
+				assert.Equal(t, v, query.Variables[k], k)
+			}
+			if i == 1 {
+				assert.Equal(t, "c1", query.Variables["after"])
+			}
+		})
+	}
+	if _, ok := wantVariables["groupId"]; ok {
+		tc.MockGroups.EXPECT().
+			GetGroup("acme", gomock.Any()).
+			Return(&gitlab.Group{ID: 99}, nil, nil)
+	}
+	if wantVariables["username"] == "monalisa" {
+		tc.MockUsers.EXPECT().CurrentUser().Return(&gitlab.User{Username: "monalisa"}, nil, nil)
+	}
+
+	return cmdtest.SetupCmdForTest(t, NewCmdTimelog, false, cmdtest.WithGitLabClient(tc.Client))
+}
+
+func TestTimelog_byProject(t *testing.T) {
+	exec := setup(t, map[string]any{
+		"username":  "monalisa",
+		"startDate": "2026-09-01",
+		"endDate":   "2026-09-30",
+	})
+
+	out, err := exec("--since 2026-09-01 --until 2026-09-30")
+	require.NoError(t, err)
+
+	assert.Equal(t, "project,seconds,hours,time_spent\n"+
+		"acme/web,10800,3.00,3h\n"+
+		"acme/api,1800,0.50,30m\n", out.String())
+}
+
+func TestTimelog_byIssue(t *testing.T) {
+	exec := setup(t, map[string]any{"username": "alice", "groupId": "gid://gitlab/Group/99"})
+
+	out, err := exec("--user alice --group acme --since 2026-09-01 --until 2026-09-30 --by issue")
+	require.NoError(t, err)
+
+	assert.Equal(t, "project,reference,title,seconds,hours,time_spent\n"+
+		"acme/web,acme/web#12,Fix login,7200,2.00,2h\n"+
+		"acme/api,acme/api!7,Add rate limits,1800,0.50,30m\n"+
+		`acme/web,acme/web#13,"Fix login, again",3600,1.00,1h`+"\n", out.String())
+}
+
+func TestTimelog_json(t *testing.T) {
+	exec := setup(t, map[string]any{"username": "alice"})
+
+	out, err := exec("-u alice --since 2026-09-01 --until 2026-09-30 --by day -F json")
+	require.NoError(t, err)
+
+	var report Report
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &report))
+	assert.Equal(t, "alice", report.User)
+	assert.Equal(t, int64(12600), report.TimeSpent)
+	require.Len(t, report.Rows, 2)
+	assert.Equal(t, int64(9000), report.Rows[0].TimeSpent)
+	assert.Equal(t, int64(3600), report.Rows[1].TimeSpent)
+}
+
+func TestTimelog_invalidDates(t *testing.T) {
+	exec := cmdtest.SetupCmdForTest(t, NewCmdTimelog, false)
+
+	_, err := exec("--since 2026-09-30 --until 2026-09-01")
+	require.EqualError(t, err, "--until must not be before --since.")
+
+	_, err = exec("--since September")
+	require.EqualError(t, err, `--since: invalid date "September". Use the YYYY-MM-DD format.`)
+}
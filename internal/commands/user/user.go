@@ -4,7 +4,9 @@ import (
 	"github.com/spf13/cobra"
 
 	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	userActivityCmd "gitlab.com/gitlab-org/cli/internal/commands/user/activity"
 	userEventsCmd "gitlab.com/gitlab-org/cli/internal/commands/user/events"
+	userTimelogCmd "gitlab.com/gitlab-org/cli/internal/commands/user/timelog"
 )
 
 func NewCmdUser(f cmdutils.Factory) *cobra.Command {
@@ -14,7 +16,9 @@ func NewCmdUser(f cmdutils.Factory) *cobra.Command {
 		Long:  "",
 	}
 
+	userCmd.AddCommand(userActivityCmd.NewCmdActivity(f))
 	userCmd.AddCommand(userEventsCmd.NewCmdEvents(f))
+	userCmd.AddCommand(userTimelogCmd.NewCmdTimelog(f))
 
 	return userCmd
 }
@@ -0,0 +1,193 @@
+package create
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/work-item/workitemutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	workItemType string
+	title        string
+	description  string
+	assignees    []string
+	labels       []string
+	weight       int64
+	startDate    string
+	dueDate      string
+	parent       string
+	confidential bool
+
+	hasWeight bool
+}
+
+func NewCmdCreate(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	workItemCreateCmd := &cobra.Command{
+		Use:     "create [flags]",
+		Short:   `Create a work item.`,
+		Aliases: []string{"new"},
+		Long: heredoc.Docf(`
+			Create a work item in the project. Types are: %[2]s.
+
+			Use %[1]s--parent%[1]s to create the work item as a child of another, like a task in an issue,
+			or a key result under an objective. When running interactively, you're prompted for the
+			title if it's not given.
+		`, "`", strings.Join(workitemutils.Types, ", ")),
+		Example: heredoc.Doc(`
+			$ glab work-item create --type task --parent 42 --title "Write the migration"
+			$ glab work-item create --type objective -t "Faster pipelines" --assignee @me --due-date 2026-12-31
+			$ glab work-item create --type key-result -t "p95 under 10 minutes" --parent 50 --weight 3
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.hasWeight = cmd.Flags().Changed("weight")
+			if err := opts.complete(cmd.Context()); err != nil {
+				return err
+			}
+			return opts.run()
+		},
+	}
+
+	fl := workItemCreateCmd.Flags()
+	fl.StringVar(&opts.workItemType, "type", "", "Type of the work item: "+strings.Join(workitemutils.Types, ", ")+".")
+	fl.StringVarP(&opts.title, "title", "t", "", "Work item title.")
+	fl.StringVarP(&opts.description, "description", "d", "", "Work item description.")
+	fl.StringSliceVarP(&opts.assignees, "assignee", "a", []string{}, "Assign the work item to users by username. Multiple users can be comma-separated or specified by repeating the flag.")
+	fl.StringSliceVarP(&opts.labels, "label", "l", []string{}, "Add labels by name. Multiple labels can be comma-separated or specified by repeating the flag.")
+	fl.Int64Var(&opts.weight, "weight", 0, "Weight of the work item.")
+	fl.StringVar(&opts.startDate, "start-date", "", "Start date in YYYY-MM-DD format.")
+	fl.StringVar(&opts.dueDate, "due-date", "", "Due date in YYYY-MM-DD format.")
+	fl.StringVar(&opts.parent, "parent", "", "Add the work item as a child of this work item.")
+	fl.BoolVarP(&opts.confidential, "confidential", "c", false, "Make the work item confidential.")
+
+	_ = workItemCreateCmd.MarkFlagRequired("type")
+
+	return workItemCreateCmd
+}
+
+func (o *options) complete(ctx context.Context) error {
+	if o.title != "" {
+		return nil
+	}
+	if !o.io.PromptEnabled() {
+		return &cmdutils.FlagError{Err: errors.New("--title is required when not running interactively.")}
+	}
+
+	err := o.io.Input(ctx, &o.title, "Title", "", func(s string) error {
+		if strings.TrimSpace(s) == "" {
+			return errors.New("the title can't be empty.")
+		}
+		return nil
+	})
+	if err != nil {
+		return cmdutils.WrapError(err, "could not prompt")
+	}
+	return nil
+}
+
+func (o *options) run() error {
+	typeName, err := workitemutils.ParseType(o.workItemType)
+	if err != nil {
+		return &cmdutils.FlagError{Err: err}
+	}
+
+	input := &workitemutils.Input{
+		Title:        gitlab.Ptr(o.title),
+		Confidential: gitlab.Ptr(o.confidential),
+	}
+	if o.description != "" {
+		input.Description = gitlab.Ptr(o.description)
+	}
+	if o.hasWeight {
+		input.Weight = gitlab.Ptr(o.weight)
+	}
+	if o.startDate != "" {
+		if _, err := gitlab.ParseISOTime(o.startDate); err != nil {
+			return &cmdutils.FlagError{Err: fmt.Errorf("--start-date: invalid date %q. Use the YYYY-MM-DD format.", o.startDate)}
+		}
+		input.StartDate = gitlab.Ptr(o.startDate)
+	}
+	if o.dueDate != "" {
+		if _, err := gitlab.ParseISOTime(o.dueDate); err != nil {
+			return &cmdutils.FlagError{Err: fmt.Errorf("--due-date: invalid date %q. Use the YYYY-MM-DD format.", o.dueDate)}
+		}
+		input.DueDate = gitlab.Ptr(o.dueDate)
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+	projectPath := repo.FullName()
+
+	typeID, err := workitemutils.TypeID(client, projectPath, typeName)
+	if err != nil {
+		return err
+	}
+	if len(o.assignees) > 0 {
+		users, err := api.UsersByNames(client, o.assignees)
+		if err != nil {
+			return err
+		}
+		input.AssigneeIDs = workitemutils.UserIDs(users)
+	}
+	if len(o.labels) > 0 {
+		input.AddLabelIDs, err = workitemutils.LabelIDs(client, projectPath, o.labels)
+		if err != nil {
+			return err
+		}
+	}
+	if o.parent != "" {
+		iid, err := workitemutils.ParseIID(o.parent)
+		if err != nil {
+			return &cmdutils.FlagError{Err: fmt.Errorf("--parent: %w", err)}
+		}
+		parent, err := workitemutils.Get(client, projectPath, iid)
+		if err != nil {
+			return err
+		}
+		input.ParentID = gitlab.Ptr(parent.GlobalID())
+	}
+
+	fmt.Fprintf(o.io.StdErr, "- Creating %s in %s\n", strings.ToLower(o.workItemType), projectPath)
+
+	w, err := workitemutils.Create(client, projectPath, typeID, input)
+	if err != nil {
+		return cmdutils.WrapError(err, "failed to create work item")
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "%s %s %s\n", workitemutils.State(c, w.IID, w.State), w.Type, w.Title)
+	fmt.Fprintln(o.io.StdOut, w.WebURL)
+	return nil
+}
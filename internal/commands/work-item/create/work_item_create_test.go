@@ -0,0 +1,98 @@
+//go:build !integration
+
+package create
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestWorkItemCreate(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockUsers.EXPECT().
+		ListUsers(gomock.Any()).
+		Return([]*gitlab.User{{ID: 7, Username: "alice"}}, nil, nil)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"project": {"workItemTypes": {"nodes": [{"id": "gid://gitlab/WorkItems::Type/5"}]}}}}`, func(query gitlab.GraphQLQuery) {
+		assert.Contains(t, query.Query, "workItemTypes")
+		assert.Equal(t, "TASK", query.Variables["name"])
+	})
+	cmdtest.ExpectGraphQL(tc, `{"data": {"project": {"labels": {"nodes": [
+		{"id": "gid://gitlab/GroupLabel/2", "title": "backend::db"},
+		{"id": "gid://gitlab/ProjectLabel/3", "title": "backend"}
+	]}}}}`, func(query gitlab.GraphQLQuery) {
+		assert.Contains(t, query.Query, "labels(searchTerm")
+	})
+	cmdtest.ExpectGraphQL(tc, `{"data": {"project": {"workItems": {"nodes": [{"id": "gid://gitlab/WorkItem/900", "iid": "42"}]}}}}`, func(query gitlab.GraphQLQuery) {
+		assert.Equal(t, "42", query.Variables["iid"])
+	})
+	cmdtest.ExpectGraphQL(tc, `{"data": {"workItemCreate": {"workItem": {
+		"iid": "43", "title": "Write the migration", "state": "OPEN",
+		"webUrl": "https://gitlab.com/OWNER/REPO/-/work_items/43", "workItemType": {"name": "Task"}
+	}, "errors": []}}}`, func(query gitlab.GraphQLQuery) {
+		assert.Equal(t, map[string]any{
+			"namespacePath":   "OWNER/REPO",
+			"workItemTypeId":  "gid://gitlab/WorkItems::Type/5",
+			"title":           "Write the migration",
+			"confidential":    false,
+			"assigneesWidget": map[string]any{"assigneeIds": []string{"gid://gitlab/User/7"}},
+			"labelsWidget":    map[string]any{"labelIds": []string{"gid://gitlab/ProjectLabel/3"}},
+			"weightWidget":    map[string]any{"weight": int64(2)},
+			"hierarchyWidget": map[string]any{"parentId": "gid://gitlab/WorkItem/900"},
+		}, query.Variables["input"])
+	})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec(`--type task -t "Write the migration" -a alice -l backend --weight 2 --parent 42`)
+	require.NoError(t, err)
+
+	assert.Equal(t, "#43 Task Write the migration\nhttps://gitlab.com/OWNER/REPO/-/work_items/43\n", out.String())
+	assert.Equal(t, "- Creating task in OWNER/REPO\n", out.Stderr())
+}
+
+func TestWorkItemCreate_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		wantErr string
+	}{
+		{
+			name:    "type is required",
+			args:    `-t "Write the migration"`,
+			wantErr: `required flag(s) "type" not set`,
+		},
+		{
+			name:    "invalid type",
+			args:    `--type story -t "Write the migration"`,
+			wantErr: `invalid work item type "story". Use one of: issue, task, objective, key-result, incident, test-case, requirement, ticket.`,
+		},
+		{
+			name:    "title is required",
+			args:    `--type task`,
+			wantErr: "--title is required when not running interactively.",
+		},
+		{
+			name:    "invalid date",
+			args:    `--type task -t "Write the migration" --due-date tomorrow`,
+			wantErr: `--due-date: invalid date "tomorrow". Use the YYYY-MM-DD format.`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := cmdtest.SetupCmdForTest(t, NewCmdCreate, false)
+
+			_, err := exec(tt.args)
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
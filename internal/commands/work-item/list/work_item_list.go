@@ -0,0 +1,150 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/work-item/workitemutils"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/tableprinter"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+
+	types        []string
+	state        string
+	search       string
+	assignee     string
+	author       string
+	labels       []string
+	perPage      int
+	outputFormat string
+}
+
+func NewCmdList(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+	}
+
+	workItemListCmd := &cobra.Command{
+		Use:     "list [flags]",
+		Short:   `List the work items of a project.`,
+		Aliases: []string{"ls"},
+		Long: heredoc.Docf(`
+			List the work items of a project, newest first. Work items of all types are listed,
+			unless you filter them with %[1]s--type%[1]s. Types are: %[2]s.
+		`, "`", strings.Join(workitemutils.Types, ", ")),
+		Example: heredoc.Doc(`
+			$ glab work-item list
+			$ glab work-item list --type task --assignee @me
+			$ glab work-item list --type objective,key-result --state all -F json
+		`),
+		Args: cobra.NoArgs,
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run()
+		},
+	}
+
+	fl := workItemListCmd.Flags()
+	fl.StringSliceVar(&opts.types, "type", []string{}, "Filter by type. Multiple types can be comma-separated or specified by repeating the flag.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"opened", "closed", "all"}, "opened", &opts.state), "state", "s", "Filter by state: opened, closed, all.")
+	fl.StringVar(&opts.search, "search", "", "Search in the title and description.")
+	fl.StringVarP(&opts.assignee, "assignee", "a", "", "Filter by assignee username. Use @me for yourself.")
+	fl.StringVar(&opts.author, "author", "", "Filter by author username.")
+	fl.StringSliceVarP(&opts.labels, "label", "l", []string{}, "Filter by labels. Multiple labels can be comma-separated or specified by repeating the flag.")
+	fl.IntVarP(&opts.perPage, "per-page", "P", 30, "Number of work items to list.")
+	fl.VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return workItemListCmd
+}
+
+func (o *options) run() error {
+	listOpts := &workitemutils.ListOptions{
+		State:  o.state,
+		Search: o.search,
+		Author: o.author,
+		Labels: o.labels,
+		Limit:  o.perPage,
+	}
+	for _, t := range o.types {
+		typeName, err := workitemutils.ParseType(t)
+		if err != nil {
+			return &cmdutils.FlagError{Err: err}
+		}
+		listOpts.Types = append(listOpts.Types, typeName)
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	// The GraphQL API doesn't know @me, so it's resolved like in glab issue list.
+	listOpts.Assignee = o.assignee
+	if o.assignee == "@me" {
+		user, _, err := client.Users.CurrentUser()
+		if err != nil {
+			return err
+		}
+		listOpts.Assignee = user.Username
+	}
+
+	items, err := workitemutils.List(client, repo.FullName(), listOpts)
+	if err != nil {
+		return err
+	}
+
+	if o.outputFormat == "json" {
+		data, err := json.Marshal(items)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(o.io.StdOut, string(data))
+		return nil
+	}
+
+	if len(items) == 0 {
+		fmt.Fprintf(o.io.StdErr, "No work items match your search in %s.\n", repo.FullName())
+		return nil
+	}
+
+	c := o.io.Color()
+	fmt.Fprintf(o.io.StdOut, "Showing %s in %s.\n\n", utils.Pluralize(len(items), "work item"), repo.FullName())
+
+	table := tableprinter.NewTablePrinter()
+	for _, w := range items {
+		labels := ""
+		if len(w.Labels) > 0 {
+			labels = c.Cyan("(" + strings.Join(w.Labels, ", ") + ")")
+		}
+		updated := ""
+		if w.UpdatedAt != nil {
+			updated = c.Gray(utils.TimeToPrettyTimeAgo(*w.UpdatedAt))
+		}
+		table.AddRow(workitemutils.State(c, w.IID, w.State), w.Type, w.Title, labels, updated)
+	}
+	fmt.Fprint(o.io.StdOut, table.Render())
+	return nil
+}
@@ -0,0 +1,73 @@
+//go:build !integration
+
+package list
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const listResponse = `{"data": {"project": {"workItems": {
+	"nodes": [
+		{"iid": "42", "title": "Write the migration", "state": "OPEN", "workItemType": {"name": "Task"},
+		 "widgets": [{"type": "LABELS", "labels": {"nodes": [{"title": "backend"}]}}]},
+		{"iid": "41", "title": "Faster pipelines", "state": "OPEN", "workItemType": {"name": "Objective"}, "widgets": []}
+	],
+	"pageInfo": {"hasNextPage": false}
+}}}}`
+
+func TestWorkItemList(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	tc.MockUsers.EXPECT().CurrentUser().Return(&gitlab.User{Username: "alice"}, nil, nil)
+	cmdtest.ExpectGraphQL(tc, listResponse, func(query gitlab.GraphQLQuery) {
+		assert.Equal(t, "OWNER/REPO", query.Variables["fullPath"])
+		assert.Equal(t, []string{"TASK", "OBJECTIVE"}, query.Variables["types"])
+		assert.Equal(t, []string{"alice"}, query.Variables["assignee"])
+		assert.Equal(t, "opened", query.Variables["state"])
+	})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("--type task,objective --assignee @me")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "Showing 2 work items in OWNER/REPO.")
+	assert.Contains(t, out.String(), "#42")
+	assert.Contains(t, out.String(), "Task")
+	assert.Contains(t, out.String(), "Write the migration")
+	assert.Contains(t, out.String(), "(backend)")
+	assert.Contains(t, out.String(), "Faster pipelines")
+}
+
+func TestWorkItemList_json(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	cmdtest.ExpectGraphQL(tc, listResponse, nil)
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec("-F json")
+	require.NoError(t, err)
+
+	var items []map[string]any
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &items))
+	require.Len(t, items, 2)
+	assert.Equal(t, "Task", items[0]["type"])
+	assert.Equal(t, []any{"backend"}, items[0]["labels"])
+}
+
+func TestWorkItemList_invalidType(t *testing.T) {
+	exec := cmdtest.SetupCmdForTest(t, NewCmdList, false)
+
+	_, err := exec("--type story")
+	require.ErrorContains(t, err, `invalid work item type "story".`)
+}
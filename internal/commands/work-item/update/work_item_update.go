@@ -0,0 +1,210 @@
+package update
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/work-item/workitemutils"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+)
+
+func NewCmdUpdate(f cmdutils.Factory) *cobra.Command {
+	workItemUpdateCmd := &cobra.Command{
+		Use:   "update <id> [flags]",
+		Short: `Update a work item.`,
+		Long: heredoc.Docf(`
+			Update the title, description, state, widgets, or parent of a work item.
+
+			Set %[1]s--start-date%[1]s, %[1]s--due-date%[1]s, or %[1]s--parent%[1]s to an empty string to remove them.
+			Widgets that the type of the work item doesn't have, like the progress of a task, can't be set.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ glab work-item update 42 --title "Write the migration and the rollback"
+			$ glab work-item update 42 --assignee alice,bob --label backend --unlabel triage
+			$ glab work-item update 51 --progress 60 --due-date 2026-12-15
+			$ glab work-item update 42 --parent ""
+			$ glab work-item update 42 --close
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Destructive: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			if flags.Changed("close") && flags.Changed("reopen") {
+				return &cmdutils.FlagError{Err: errors.New("--close and --reopen can't be used together.")}
+			}
+			if flags.Changed("assignee") && flags.Changed("unassign") {
+				return &cmdutils.FlagError{Err: errors.New("--assignee and --unassign can't be used together.")}
+			}
+
+			iid, err := workitemutils.ParseIID(args[0])
+			if err != nil {
+				return err
+			}
+
+			var actions []string
+			input := &workitemutils.Input{}
+
+			if m, _ := flags.GetString("title"); m != "" {
+				actions = append(actions, fmt.Sprintf("updated title to %q", m))
+				input.Title = gitlab.Ptr(m)
+			}
+			if flags.Changed("description") {
+				m, _ := flags.GetString("description")
+				actions = append(actions, "updated description")
+				input.Description = gitlab.Ptr(m)
+			}
+			if m, _ := flags.GetBool("close"); m {
+				actions = append(actions, "closed")
+				input.StateEvent = "close"
+			}
+			if m, _ := flags.GetBool("reopen"); m {
+				actions = append(actions, "reopened")
+				input.StateEvent = "reopen"
+			}
+			if m, _ := flags.GetBool("unassign"); m {
+				actions = append(actions, "unassigned all users")
+				input.AssigneeIDs = []string{}
+			}
+			if flags.Changed("weight") {
+				m, _ := flags.GetInt64("weight")
+				actions = append(actions, fmt.Sprintf("set weight to %d", m))
+				input.Weight = gitlab.Ptr(m)
+			}
+			if flags.Changed("progress") {
+				m, _ := flags.GetInt64("progress")
+				if m < 0 || m > 100 {
+					return &cmdutils.FlagError{Err: errors.New("--progress must be between 0 and 100.")}
+				}
+				actions = append(actions, fmt.Sprintf("set progress to %d%%", m))
+				input.Progress = gitlab.Ptr(m)
+			}
+			for _, date := range []struct {
+				flag, name string
+				value      **string
+			}{
+				{"start-date", "start date", &input.StartDate},
+				{"due-date", "due date", &input.DueDate},
+			} {
+				if !flags.Changed(date.flag) {
+					continue
+				}
+				m, _ := flags.GetString(date.flag)
+				if m == "" {
+					actions = append(actions, "removed "+date.name)
+				} else {
+					if _, err := gitlab.ParseISOTime(m); err != nil {
+						return &cmdutils.FlagError{Err: fmt.Errorf("--%s: invalid date %q. Use the YYYY-MM-DD format.", date.flag, m)}
+					}
+					actions = append(actions, fmt.Sprintf("set %s to %s", date.name, m))
+				}
+				*date.value = gitlab.Ptr(m)
+			}
+
+			assignees, _ := flags.GetStringSlice("assignee")
+			labels, _ := flags.GetStringSlice("label")
+			unlabels, _ := flags.GetStringSlice("unlabel")
+			if len(actions) == 0 && len(assignees) == 0 && len(labels) == 0 && len(unlabels) == 0 && !flags.Changed("parent") {
+				return &cmdutils.FlagError{Err: errors.New("specify at least one change, like --title or --label.")}
+			}
+
+			client, err := f.GitLabClient()
+			if err != nil {
+				return err
+			}
+			repo, err := f.BaseRepo()
+			if err != nil {
+				return err
+			}
+			projectPath := repo.FullName()
+
+			w, err := workitemutils.Get(client, projectPath, iid)
+			if err != nil {
+				return err
+			}
+
+			if len(assignees) > 0 {
+				users, err := api.UsersByNames(client, assignees)
+				if err != nil {
+					return err
+				}
+				actions = append(actions, fmt.Sprintf("assigned %s", strings.Join(assignees, " ")))
+				input.AssigneeIDs = workitemutils.UserIDs(users)
+			}
+			if len(labels) > 0 {
+				input.AddLabelIDs, err = workitemutils.LabelIDs(client, projectPath, labels)
+				if err != nil {
+					return err
+				}
+				actions = append(actions, fmt.Sprintf("added labels %s", strings.Join(labels, " ")))
+			}
+			if len(unlabels) > 0 {
+				input.RemoveLabelIDs, err = workitemutils.LabelIDs(client, projectPath, unlabels)
+				if err != nil {
+					return err
+				}
+				actions = append(actions, fmt.Sprintf("removed labels %s", strings.Join(unlabels, " ")))
+			}
+			if flags.Changed("parent") {
+				m, _ := flags.GetString("parent")
+				if m == "" {
+					actions = append(actions, "removed parent")
+					input.ParentID = gitlab.Ptr("")
+				} else {
+					parentIID, err := workitemutils.ParseIID(m)
+					if err != nil {
+						return &cmdutils.FlagError{Err: fmt.Errorf("--parent: %w", err)}
+					}
+					parent, err := workitemutils.Get(client, projectPath, parentIID)
+					if err != nil {
+						return err
+					}
+					actions = append(actions, fmt.Sprintf("set parent to #%d", parent.IID))
+					input.ParentID = gitlab.Ptr(parent.GlobalID())
+				}
+			}
+
+			out := f.IO().StdOut
+			c := f.IO().Color()
+			fmt.Fprintf(out, "- Updating work item #%d\n", w.IID)
+
+			w, err = workitemutils.Update(client, w, input)
+			if err != nil {
+				return err
+			}
+
+			for _, s := range actions {
+				fmt.Fprintln(out, c.GreenCheck(), s)
+			}
+			fmt.Fprintf(out, "%s %s %s\n", workitemutils.State(c, w.IID, w.State), w.Type, w.Title)
+			fmt.Fprintln(out, w.WebURL)
+			return nil
+		},
+	}
+
+	fl := workItemUpdateCmd.Flags()
+	fl.StringP("title", "t", "", "Title of the work item.")
+	fl.StringP("description", "d", "", "Description of the work item.")
+	fl.StringSliceP("assignee", "a", []string{}, "Replace the assignees with these usernames. Multiple users can be comma-separated or specified by repeating the flag.")
+	fl.Bool("unassign", false, "Unassign all users.")
+	fl.StringSliceP("label", "l", []string{}, "Add labels.")
+	fl.StringSliceP("unlabel", "u", []string{}, "Remove labels.")
+	fl.Int64("weight", 0, "Weight of the work item.")
+	fl.String("start-date", "", "Start date in YYYY-MM-DD format.")
+	fl.String("due-date", "", "Due date in YYYY-MM-DD format.")
+	fl.Int64("progress", 0, "Progress of an objective or key result, in percent.")
+	fl.String("parent", "", "Move the work item under this work item.")
+	fl.Bool("close", false, "Close the work item.")
+	fl.Bool("reopen", false, "Reopen the work item.")
+
+	return workItemUpdateCmd
+}
@@ -0,0 +1,79 @@
+//go:build !integration
+
+package update
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestWorkItemUpdate(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	cmdtest.ExpectGraphQL(tc, `{"data": {"project": {"workItems": {"nodes": [{"id": "gid://gitlab/WorkItem/910", "iid": "51", "state": "OPEN"}]}}}}`, nil)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"workItemUpdate": {"workItem": {
+		"iid": "51", "title": "p95 under 8 minutes", "state": "OPEN",
+		"webUrl": "https://gitlab.com/OWNER/REPO/-/work_items/51", "workItemType": {"name": "Key Result"}
+	}, "errors": []}}}`, func(query gitlab.GraphQLQuery) {
+		assert.Equal(t, map[string]any{
+			"id":                    "gid://gitlab/WorkItem/910",
+			"title":                 "p95 under 8 minutes",
+			"progressWidget":        map[string]any{"currentValue": int64(60)},
+			"startAndDueDateWidget": map[string]any{"startDate": nil, "dueDate": "2026-12-15"},
+			"hierarchyWidget":       map[string]any{"parentId": nil},
+		}, query.Variables["input"])
+	})
+
+	exec := cmdtest.SetupCmdForTest(t, NewCmdUpdate, false, cmdtest.WithGitLabClient(tc.Client))
+
+	out, err := exec(`51 -t "p95 under 8 minutes" --progress 60 --start-date "" --due-date 2026-12-15 --parent ""`)
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "- Updating work item #51\n")
+	assert.Contains(t, out.String(), `updated title to "p95 under 8 minutes"`)
+	assert.Contains(t, out.String(), "set progress to 60%\n")
+	assert.Contains(t, out.String(), "removed start date\n")
+	assert.Contains(t, out.String(), "set due date to 2026-12-15\n")
+	assert.Contains(t, out.String(), "removed parent\n")
+	assert.Contains(t, out.String(), "#51 Key Result p95 under 8 minutes\n")
+}
+
+func TestWorkItemUpdate_errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		wantErr string
+	}{
+		{
+			name:    "no changes",
+			args:    "51",
+			wantErr: "specify at least one change, like --title or --label.",
+		},
+		{
+			name:    "close and reopen",
+			args:    "51 --close --reopen",
+			wantErr: "--close and --reopen can't be used together.",
+		},
+		{
+			name:    "progress out of range",
+			args:    "51 --progress 120",
+			wantErr: "--progress must be between 0 and 100.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exec := cmdtest.SetupCmdForTest(t, NewCmdUpdate, false)
+
+			_, err := exec(tt.args)
+			require.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
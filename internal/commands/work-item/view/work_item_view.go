@@ -0,0 +1,163 @@
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	"gitlab.com/gitlab-org/cli/internal/commands/work-item/workitemutils"
+	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/glrepo"
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+	"gitlab.com/gitlab-org/cli/internal/mcpannotations"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+type options struct {
+	io           *iostreams.IOStreams
+	gitlabClient func() (*gitlab.Client, error)
+	baseRepo     func() (glrepo.Interface, error)
+	config       func() config.Config
+
+	web          bool
+	outputFormat string
+}
+
+func NewCmdView(f cmdutils.Factory) *cobra.Command {
+	opts := &options{
+		io:           f.IO(),
+		gitlabClient: f.GitLabClient,
+		baseRepo:     f.BaseRepo,
+		config:       f.Config,
+	}
+
+	workItemViewCmd := &cobra.Command{
+		Use:     "view <id> [flags]",
+		Short:   `Display the widgets, parent, and children of a work item.`,
+		Long:    ``,
+		Aliases: []string{"show"},
+		Example: heredoc.Doc(`
+			$ glab work-item view 42
+			$ glab work-item view 42 -F json
+		`),
+		Args: cobra.ExactArgs(1),
+		Annotations: map[string]string{
+			mcpannotations.Safe: "true",
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return opts.run(args[0])
+		},
+	}
+
+	workItemViewCmd.Flags().BoolVarP(&opts.web, "web", "w", false, "Open the work item in a browser. Uses the default browser, or the browser specified in the $BROWSER variable.")
+	workItemViewCmd.Flags().VarP(cmdutils.NewEnumValue([]string{"text", "json"}, "text", &opts.outputFormat), "output", "F", "Format output as: text, json.")
+
+	return workItemViewCmd
+}
+
+func (o *options) run(arg string) error {
+	iid, err := workitemutils.ParseIID(arg)
+	if err != nil {
+		return err
+	}
+
+	client, err := o.gitlabClient()
+	if err != nil {
+		return err
+	}
+	repo, err := o.baseRepo()
+	if err != nil {
+		return err
+	}
+
+	w, err := workitemutils.Get(client, repo.FullName(), iid)
+	if err != nil {
+		return err
+	}
+
+	if o.web {
+		if o.io.IsaTTY && o.io.IsErrTTY {
+			fmt.Fprintf(o.io.StdErr, "Opening %s in your browser.\n", utils.DisplayURL(w.WebURL))
+		}
+		browser, _ := o.config().Get("", "browser")
+		return utils.OpenInBrowser(w.WebURL, browser)
+	}
+
+	if o.outputFormat == "json" {
+		data, err := json.Marshal(w)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(o.io.StdOut, string(data))
+		return nil
+	}
+
+	o.print(w)
+	return nil
+}
+
+func (o *options) print(w *workitemutils.WorkItem) {
+	c := o.io.Color()
+	out := o.io.StdOut
+
+	state := c.Green("open")
+	if w.State == "closed" {
+		state = c.Red("closed")
+	}
+	fmt.Fprintf(out, "%s %s", state, w.Type)
+	if w.Author != "" && w.CreatedAt != nil {
+		fmt.Fprint(out, c.Gray(fmt.Sprintf(" • opened by %s %s", w.Author, utils.TimeToPrettyTimeAgo(*w.CreatedAt))))
+	}
+	fmt.Fprintln(out)
+	fmt.Fprintf(out, "%s %s\n", c.Bold(w.Title), c.Gray(fmt.Sprintf("#%d", w.IID)))
+
+	if w.Description != "" {
+		description := w.Description
+		if o.io.IsaTTY {
+			description, _ = utils.RenderMarkdown(description, o.io.BackgroundColor())
+		}
+		fmt.Fprintln(out, description)
+	}
+	fmt.Fprintln(out)
+
+	if len(w.Assignees) > 0 {
+		fmt.Fprintf(out, "%s %s\n", c.Bold("Assignees:"), strings.Join(w.Assignees, ", "))
+	}
+	if len(w.Labels) > 0 {
+		fmt.Fprintf(out, "%s %s\n", c.Bold("Labels:"), strings.Join(w.Labels, ", "))
+	}
+	if w.Weight != nil {
+		fmt.Fprintf(out, "%s %d\n", c.Bold("Weight:"), *w.Weight)
+	}
+	if w.StartDate != "" || w.DueDate != "" {
+		fmt.Fprintf(out, "%s %s → %s\n", c.Bold("Dates:"), dateOrUnknown(w.StartDate), dateOrUnknown(w.DueDate))
+	}
+	if w.Progress != nil {
+		fmt.Fprintf(out, "%s %d%%\n", c.Bold("Progress:"), *w.Progress)
+	}
+	if w.Parent != nil {
+		fmt.Fprintf(out, "%s %s %s %s\n", c.Bold("Parent:"), workitemutils.State(c, w.Parent.IID, w.Parent.State), w.Parent.Type, w.Parent.Title)
+	}
+
+	if len(w.Children) > 0 {
+		fmt.Fprintf(out, "\n%s\n", c.Bold(fmt.Sprintf("Children (%d)", len(w.Children))))
+		for _, child := range w.Children {
+			fmt.Fprintf(out, "  %s %s %s\n", workitemutils.State(c, child.IID, child.State), child.Type, child.Title)
+		}
+	}
+
+	fmt.Fprintf(out, "\n%s\n", c.Gray("View this work item on GitLab: "+w.WebURL))
+}
+
+func dateOrUnknown(date string) string {
+	if date == "" {
+		return "?"
+	}
+	return date
+}
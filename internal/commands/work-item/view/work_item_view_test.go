@@ -0,0 +1,75 @@
+//go:build !integration
+
+package view
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+const viewResponse = `{"data": {"project": {"workItems": {"nodes": [{
+	"id": "gid://gitlab/WorkItem/900",
+	"iid": "42",
+	"title": "Ship invoices",
+	"state": "OPEN",
+	"webUrl": "https://gitlab.com/OWNER/REPO/-/issues/42",
+	"workItemType": {"name": "Issue"},
+	"widgets": [
+		{"type": "DESCRIPTION", "description": "Send invoices by email."},
+		{"type": "ASSIGNEES", "assignees": {"nodes": [{"username": "alice"}, {"username": "bob"}]}},
+		{"type": "WEIGHT", "weight": 5},
+		{"type": "START_AND_DUE_DATE", "startDate": null, "dueDate": "2026-12-15"},
+		{"type": "HIERARCHY",
+		 "parent": {"iid": "40", "title": "Billing", "state": "OPEN", "workItemType": {"name": "Epic"}},
+		 "children": {"nodes": [
+			{"iid": "43", "title": "Write the template", "state": "CLOSED", "workItemType": {"name": "Task"}},
+			{"iid": "44", "title": "Send the email", "state": "OPEN", "workItemType": {"name": "Task"}}
+		 ]}}
+	]
+}]}}}}`
+
+func setup(t *testing.T) cmdtest.CmdExecFunc {
+	t.Helper()
+
+	tc := gitlabtesting.NewTestClient(t)
+	cmdtest.ExpectGraphQL(tc, viewResponse, func(query gitlab.GraphQLQuery) {
+		assert.Equal(t, "42", query.Variables["iid"])
+	})
+
+	return cmdtest.SetupCmdForTest(t, NewCmdView, false, cmdtest.WithGitLabClient(tc.Client))
+}
+
+func TestWorkItemView(t *testing.T) {
+	out, err := setup(t)("42")
+	require.NoError(t, err)
+
+	assert.Contains(t, out.String(), "open Issue\n")
+	assert.Contains(t, out.String(), "Ship invoices #42\n")
+	assert.Contains(t, out.String(), "Send invoices by email.\n")
+	assert.Contains(t, out.String(), "Assignees: alice, bob\n")
+	assert.Contains(t, out.String(), "Weight: 5\n")
+	assert.Contains(t, out.String(), "Dates: ? → 2026-12-15\n")
+	assert.Contains(t, out.String(), "Parent: #40 Epic Billing\n")
+	assert.Contains(t, out.String(), "Children (2)\n  #43 Task Write the template\n  #44 Task Send the email\n")
+	assert.Contains(t, out.String(), "View this work item on GitLab: https://gitlab.com/OWNER/REPO/-/issues/42\n")
+}
+
+func TestWorkItemView_json(t *testing.T) {
+	out, err := setup(t)("'#42' -F json")
+	require.NoError(t, err)
+
+	var w map[string]any
+	require.NoError(t, json.Unmarshal(out.OutBuf.Bytes(), &w))
+	assert.Equal(t, float64(900), w["id"])
+	assert.Equal(t, float64(5), w["weight"])
+	assert.Equal(t, "Billing", w["parent"].(map[string]any)["title"])
+	assert.Len(t, w["children"], 2)
+}
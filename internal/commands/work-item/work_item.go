@@ -0,0 +1,38 @@
+package workitem
+
+import (
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/spf13/cobra"
+
+	"gitlab.com/gitlab-org/cli/internal/cmdutils"
+	workItemCreateCmd "gitlab.com/gitlab-org/cli/internal/commands/work-item/create"
+	workItemListCmd "gitlab.com/gitlab-org/cli/internal/commands/work-item/list"
+	workItemUpdateCmd "gitlab.com/gitlab-org/cli/internal/commands/work-item/update"
+	workItemViewCmd "gitlab.com/gitlab-org/cli/internal/commands/work-item/view"
+)
+
+func NewCmdWorkItem(f cmdutils.Factory) *cobra.Command {
+	workItemCmd := &cobra.Command{
+		Use:     "work-item <command> [flags]",
+		Short:   `Work with GitLab work items, like tasks, objectives, and key results.`,
+		Long:    ``,
+		Aliases: []string{"wi"},
+		Example: heredoc.Doc(`
+			$ glab work-item list --type task
+			$ glab work-item view 42
+		`),
+		Annotations: map[string]string{
+			"help:arguments": heredoc.Doc(`
+				A work item can be supplied as argument by number, e.g. "42" or "#42".
+			`),
+		},
+	}
+
+	cmdutils.EnableRepoOverride(workItemCmd, f)
+
+	workItemCmd.AddCommand(workItemListCmd.NewCmdList(f))
+	workItemCmd.AddCommand(workItemViewCmd.NewCmdView(f))
+	workItemCmd.AddCommand(workItemCreateCmd.NewCmdCreate(f))
+	workItemCmd.AddCommand(workItemUpdateCmd.NewCmdUpdate(f))
+	return workItemCmd
+}
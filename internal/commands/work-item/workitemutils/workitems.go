@@ -0,0 +1,677 @@
+package workitemutils
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	"gitlab.com/gitlab-org/cli/internal/api"
+
+	"gitlab.com/gitlab-org/cli/internal/iostreams"
+)
+
+// Types are the work item types, as given on the command line.
+var Types = []string{"issue", "task", "objective", "key-result", "incident", "test-case", "requirement", "ticket"}
+
+const (
+	workItemGIDPrefix = "gid://gitlab/WorkItem/"
+	userGIDPrefix     = "gid://gitlab/User/"
+)
+
+// WorkItem is a work item with the values of its widgets, as printed in JSON.
+type WorkItem struct {
+	ID          int64          `json:"id"`
+	IID         int64          `json:"iid"`
+	Type        string         `json:"type"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	State       string         `json:"state"`
+	WebURL      string         `json:"web_url"`
+	Author      string         `json:"author"`
+	CreatedAt   *time.Time     `json:"created_at"`
+	UpdatedAt   *time.Time     `json:"updated_at"`
+	Assignees   []string       `json:"assignees"`
+	Labels      []string       `json:"labels"`
+	Weight      *int64         `json:"weight"`
+	StartDate   string         `json:"start_date,omitempty"`
+	DueDate     string         `json:"due_date,omitempty"`
+	Progress    *int64         `json:"progress"`
+	Parent      *WorkItemRef   `json:"parent"`
+	Children    []*WorkItemRef `json:"children"`
+
+	gid string
+}
+
+// WorkItemRef is a parent or a child of a work item.
+type WorkItemRef struct {
+	IID    int64  `json:"iid"`
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	State  string `json:"state"`
+	WebURL string `json:"web_url"`
+}
+
+// ParseType validates the work item type, case-insensitively, and returns its GraphQL name,
+// like KEY_RESULT.
+func ParseType(s string) (string, error) {
+	t := strings.ReplaceAll(strings.ToLower(s), "_", "-")
+	if !slices.Contains(Types, t) {
+		return "", fmt.Errorf("invalid work item type %q. Use one of: %s.", s, strings.Join(Types, ", "))
+	}
+	return strings.ToUpper(strings.ReplaceAll(t, "-", "_")), nil
+}
+
+// ParseIID returns the IID of the work item that arg refers to, like 12 or #12.
+func ParseIID(arg string) (int64, error) {
+	iid, err := strconv.ParseInt(strings.TrimPrefix(arg, "#"), 10, 64)
+	if err != nil || iid <= 0 {
+		return 0, fmt.Errorf("invalid work item %q. Use a work item ID, like 12 or #12.", arg)
+	}
+	return iid, nil
+}
+
+// State returns the reference of the work item, colored by state.
+func State(c *iostreams.ColorPalette, iid int64, state string) string {
+	ref := fmt.Sprintf("#%d", iid)
+	if state == "closed" {
+		return c.Red(ref)
+	}
+	return c.Green(ref)
+}
+
+const refFields = `
+  iid
+  title
+  state
+  webUrl
+  workItemType {
+    name
+  }`
+
+// workItemFields are the fields of work items. The children of the hierarchy widget are only
+// requested when viewing a single work item.
+const workItemFields = `
+  id
+  iid
+  title
+  state
+  webUrl
+  createdAt
+  updatedAt
+  author {
+    username
+  }
+  workItemType {
+    name
+  }
+  widgets {
+    type
+    ... on WorkItemWidgetDescription {
+      description
+    }
+    ... on WorkItemWidgetAssignees {
+      assignees {
+        nodes {
+          username
+        }
+      }
+    }
+    ... on WorkItemWidgetLabels {
+      labels {
+        nodes {
+          title
+        }
+      }
+    }
+    ... on WorkItemWidgetWeight {
+      weight
+    }
+    ... on WorkItemWidgetStartAndDueDate {
+      startDate
+      dueDate
+    }
+    ... on WorkItemWidgetProgress {
+      progress
+    }
+    ... on WorkItemWidgetHierarchy {
+      parent {` + refFields + `
+      }
+      children @include(if: $withChildren) {
+        nodes {` + refFields + `
+        }
+      }
+    }
+  }`
+
+type refNode struct {
+	IID          string `json:"iid"`
+	Title        string `json:"title"`
+	State        string `json:"state"`
+	WebURL       string `json:"webUrl"`
+	WorkItemType struct {
+		Name string `json:"name"`
+	} `json:"workItemType"`
+}
+
+func (n *refNode) ref() *WorkItemRef {
+	iid, _ := strconv.ParseInt(n.IID, 10, 64)
+	return &WorkItemRef{
+		IID:    iid,
+		Type:   n.WorkItemType.Name,
+		Title:  n.Title,
+		State:  strings.ToLower(n.State),
+		WebURL: n.WebURL,
+	}
+}
+
+type usernameNodes struct {
+	Nodes []struct {
+		Username string `json:"username"`
+	} `json:"nodes"`
+}
+
+// widgetNode has the fields of all the widgets that are requested. Each widget only sets the
+// fields of its own type.
+type widgetNode struct {
+	Type        string         `json:"type"`
+	Description *string        `json:"description"`
+	Assignees   *usernameNodes `json:"assignees"`
+	Labels      *struct {
+		Nodes []struct {
+			Title string `json:"title"`
+		} `json:"nodes"`
+	} `json:"labels"`
+	Weight    *int64   `json:"weight"`
+	StartDate *string  `json:"startDate"`
+	DueDate   *string  `json:"dueDate"`
+	Progress  *int64   `json:"progress"`
+	Parent    *refNode `json:"parent"`
+	Children  *struct {
+		Nodes []refNode `json:"nodes"`
+	} `json:"children"`
+}
+
+type workItemNode struct {
+	ID        string     `json:"id"`
+	IID       string     `json:"iid"`
+	Title     string     `json:"title"`
+	State     string     `json:"state"`
+	WebURL    string     `json:"webUrl"`
+	CreatedAt *time.Time `json:"createdAt"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+	Author    *struct {
+		Username string `json:"username"`
+	} `json:"author"`
+	WorkItemType struct {
+		Name string `json:"name"`
+	} `json:"workItemType"`
+	Widgets []widgetNode `json:"widgets"`
+}
+
+func (n *workItemNode) workItem() *WorkItem {
+	id, _ := strconv.ParseInt(strings.TrimPrefix(n.ID, workItemGIDPrefix), 10, 64)
+	iid, _ := strconv.ParseInt(n.IID, 10, 64)
+	w := &WorkItem{
+		ID:        id,
+		IID:       iid,
+		Type:      n.WorkItemType.Name,
+		Title:     n.Title,
+		State:     strings.ToLower(n.State),
+		WebURL:    n.WebURL,
+		CreatedAt: n.CreatedAt,
+		UpdatedAt: n.UpdatedAt,
+		Assignees: []string{},
+		Labels:    []string{},
+		Children:  []*WorkItemRef{},
+		gid:       n.ID,
+	}
+	if n.Author != nil {
+		w.Author = n.Author.Username
+	}
+
+	for _, widget := range n.Widgets {
+		switch widget.Type {
+		case "DESCRIPTION":
+			if widget.Description != nil {
+				w.Description = *widget.Description
+			}
+		case "ASSIGNEES":
+			if widget.Assignees != nil {
+				for _, a := range widget.Assignees.Nodes {
+					w.Assignees = append(w.Assignees, a.Username)
+				}
+			}
+		case "LABELS":
+			if widget.Labels != nil {
+				for _, l := range widget.Labels.Nodes {
+					w.Labels = append(w.Labels, l.Title)
+				}
+			}
+		case "WEIGHT":
+			w.Weight = widget.Weight
+		case "START_AND_DUE_DATE":
+			if widget.StartDate != nil {
+				w.StartDate = *widget.StartDate
+			}
+			if widget.DueDate != nil {
+				w.DueDate = *widget.DueDate
+			}
+		case "PROGRESS":
+			w.Progress = widget.Progress
+		case "HIERARCHY":
+			if widget.Parent != nil {
+				w.Parent = widget.Parent.ref()
+			}
+			if widget.Children != nil {
+				for _, c := range widget.Children.Nodes {
+					w.Children = append(w.Children, c.ref())
+				}
+			}
+		}
+	}
+	return w
+}
+
+// ListOptions are the filters of List.
+type ListOptions struct {
+	Types    []string
+	State    string
+	Search   string
+	Assignee string
+	Author   string
+	Labels   []string
+	Limit    int
+}
+
+const listQuery = `
+query($fullPath: ID!, $types: [IssueType!], $state: IssuableState, $search: String, $assignee: [String!], $author: String, $labels: [String!], $first: Int, $after: String, $withChildren: Boolean = false) {
+  project(fullPath: $fullPath) {
+    workItems(types: $types, state: $state, search: $search, assigneeUsernames: $assignee, authorUsername: $author, labelName: $labels, first: $first, after: $after, sort: CREATED_DESC) {
+      nodes {` + workItemFields + `
+      }
+      pageInfo {
+        hasNextPage
+        endCursor
+      }
+    }
+  }
+}`
+
+// List returns the work items of the project that match the filters, newest first, up to the
+// limit.
+func List(client *gitlab.Client, projectPath string, opts *ListOptions) ([]*WorkItem, error) {
+	variables := map[string]any{"fullPath": projectPath}
+	if len(opts.Types) > 0 {
+		variables["types"] = opts.Types
+	}
+	if opts.State != "" && opts.State != "all" {
+		variables["state"] = opts.State
+	}
+	if opts.Search != "" {
+		variables["search"] = opts.Search
+	}
+	if opts.Assignee != "" {
+		variables["assignee"] = []string{opts.Assignee}
+	}
+	if opts.Author != "" {
+		variables["author"] = opts.Author
+	}
+	if len(opts.Labels) > 0 {
+		variables["labels"] = opts.Labels
+	}
+
+	items := []*WorkItem{}
+	for len(items) < opts.Limit {
+		variables["first"] = min(opts.Limit-len(items), 100)
+
+		var resp struct {
+			Data struct {
+				Project *struct {
+					WorkItems struct {
+						Nodes    []workItemNode `json:"nodes"`
+						PageInfo struct {
+							HasNextPage bool   `json:"hasNextPage"`
+							EndCursor   string `json:"endCursor"`
+						} `json:"pageInfo"`
+					} `json:"workItems"`
+				} `json:"project"`
+			} `json:"data"`
+			api.GraphQLErrors
+		}
+		if _, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: listQuery, Variables: variables}, &resp); err != nil {
+			return nil, fmt.Errorf("error listing work items: %w", err)
+		}
+		if err := resp.Err(); err != nil {
+			return nil, fmt.Errorf("error listing work items: %w", err)
+		}
+		if resp.Data.Project == nil {
+			return nil, fmt.Errorf("project %s not found.", projectPath)
+		}
+
+		for _, n := range resp.Data.Project.WorkItems.Nodes {
+			items = append(items, n.workItem())
+		}
+		pageInfo := resp.Data.Project.WorkItems.PageInfo
+		if !pageInfo.HasNextPage {
+			break
+		}
+		variables["after"] = pageInfo.EndCursor
+	}
+	return items, nil
+}
+
+const getQuery = `
+query($fullPath: ID!, $iid: String!, $withChildren: Boolean = true) {
+  project(fullPath: $fullPath) {
+    workItems(iid: $iid) {
+      nodes {` + workItemFields + `
+      }
+    }
+  }
+}`
+
+// Get returns the work item of the project with the given IID, with its children.
+func Get(client *gitlab.Client, projectPath string, iid int64) (*WorkItem, error) {
+	variables := map[string]any{
+		"fullPath": projectPath,
+		"iid":      strconv.FormatInt(iid, 10),
+	}
+
+	var resp struct {
+		Data struct {
+			Project *struct {
+				WorkItems struct {
+					Nodes []workItemNode `json:"nodes"`
+				} `json:"workItems"`
+			} `json:"project"`
+		} `json:"data"`
+		api.GraphQLErrors
+	}
+	if _, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: getQuery, Variables: variables}, &resp); err != nil {
+		return nil, fmt.Errorf("error getting work item #%d: %w", iid, err)
+	}
+	if err := resp.Err(); err != nil {
+		return nil, fmt.Errorf("error getting work item #%d: %w", iid, err)
+	}
+	if resp.Data.Project == nil {
+		return nil, fmt.Errorf("project %s not found.", projectPath)
+	}
+	if len(resp.Data.Project.WorkItems.Nodes) == 0 {
+		return nil, fmt.Errorf("work item #%d not found in %s.", iid, projectPath)
+	}
+	return resp.Data.Project.WorkItems.Nodes[0].workItem(), nil
+}
+
+const typeIDQuery = `
+query($fullPath: ID!, $name: IssueType!) {
+  project(fullPath: $fullPath) {
+    workItemTypes(name: $name) {
+      nodes {
+        id
+      }
+    }
+  }
+}`
+
+// TypeID returns the global ID of the work item type in the project. The type is given by its
+// GraphQL name, as returned by ParseType.
+func TypeID(client *gitlab.Client, projectPath, typeName string) (string, error) {
+	variables := map[string]any{
+		"fullPath": projectPath,
+		"name":     typeName,
+	}
+
+	var resp struct {
+		Data struct {
+			Project *struct {
+				WorkItemTypes struct {
+					Nodes []struct {
+						ID string `json:"id"`
+					} `json:"nodes"`
+				} `json:"workItemTypes"`
+			} `json:"project"`
+		} `json:"data"`
+		api.GraphQLErrors
+	}
+	if _, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: typeIDQuery, Variables: variables}, &resp); err != nil {
+		return "", err
+	}
+	if err := resp.Err(); err != nil {
+		return "", err
+	}
+	if resp.Data.Project == nil {
+		return "", fmt.Errorf("project %s not found.", projectPath)
+	}
+	if len(resp.Data.Project.WorkItemTypes.Nodes) == 0 {
+		return "", fmt.Errorf("work items of type %s are not available in %s.", strings.ToLower(typeName), projectPath)
+	}
+	return resp.Data.Project.WorkItemTypes.Nodes[0].ID, nil
+}
+
+const labelsQuery = `
+query($fullPath: ID!, $search: String!) {
+  project(fullPath: $fullPath) {
+    labels(searchTerm: $search, includeAncestorGroups: true) {
+      nodes {
+        id
+        title
+      }
+    }
+  }
+}`
+
+type labelNode struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+}
+
+// LabelIDs returns the global IDs of the labels with the given titles, which are available in
+// the project or its groups.
+func LabelIDs(client *gitlab.Client, projectPath string, titles []string) ([]string, error) {
+	ids := make([]string, 0, len(titles))
+	for _, title := range titles {
+		var resp struct {
+			Data struct {
+				Project *struct {
+					Labels struct {
+						Nodes []labelNode `json:"nodes"`
+					} `json:"labels"`
+				} `json:"project"`
+			} `json:"data"`
+			api.GraphQLErrors
+		}
+		variables := map[string]any{"fullPath": projectPath, "search": title}
+		if _, err := client.GraphQL.Do(gitlab.GraphQLQuery{Query: labelsQuery, Variables: variables}, &resp); err != nil {
+			return nil, fmt.Errorf("error getting label %q: %w", title, err)
+		}
+		if err := resp.Err(); err != nil {
+			return nil, fmt.Errorf("error getting label %q: %w", title, err)
+		}
+		if resp.Data.Project == nil {
+			return nil, fmt.Errorf("project %s not found.", projectPath)
+		}
+
+		i := slices.IndexFunc(resp.Data.Project.Labels.Nodes, func(l labelNode) bool {
+			return l.Title == title
+		})
+		if i < 0 {
+			return nil, fmt.Errorf("label %q not found in %s.", title, projectPath)
+		}
+		ids = append(ids, resp.Data.Project.Labels.Nodes[i].ID)
+	}
+	return ids, nil
+}
+
+// UserIDs returns the global IDs of the users.
+func UserIDs(users []*gitlab.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, userGIDPrefix+strconv.FormatInt(u.ID, 10))
+	}
+	return ids
+}
+
+// Input are the values of a new work item, or the changes to a work item. Unset values are not
+// changed.
+type Input struct {
+	Title          *string
+	Description    *string
+	Confidential   *bool
+	StateEvent     string
+	AssigneeIDs    []string
+	AddLabelIDs    []string
+	RemoveLabelIDs []string
+	Weight         *int64
+	StartDate      *string
+	DueDate        *string
+	Progress       *int64
+	ParentID       *string
+}
+
+func (in *Input) fields() map[string]any {
+	fields := map[string]any{}
+	if in.Title != nil {
+		fields["title"] = *in.Title
+	}
+	if in.Confidential != nil {
+		fields["confidential"] = *in.Confidential
+	}
+	if in.Description != nil {
+		fields["descriptionWidget"] = map[string]any{"description": *in.Description}
+	}
+	if in.AssigneeIDs != nil {
+		fields["assigneesWidget"] = map[string]any{"assigneeIds": in.AssigneeIDs}
+	}
+	if in.Weight != nil {
+		fields["weightWidget"] = map[string]any{"weight": *in.Weight}
+	}
+	if in.StartDate != nil || in.DueDate != nil {
+		dates := map[string]any{}
+		if in.StartDate != nil {
+			dates["startDate"] = nullIfEmpty(*in.StartDate)
+		}
+		if in.DueDate != nil {
+			dates["dueDate"] = nullIfEmpty(*in.DueDate)
+		}
+		fields["startAndDueDateWidget"] = dates
+	}
+	if in.ParentID != nil {
+		fields["hierarchyWidget"] = map[string]any{"parentId": nullIfEmpty(*in.ParentID)}
+	}
+	return fields
+}
+
+func nullIfEmpty(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// GlobalID returns the GraphQL ID of the work item, like gid://gitlab/WorkItem/12.
+func (w *WorkItem) GlobalID() string {
+	return w.gid
+}
+
+const createMutation = `
+mutation($input: WorkItemCreateInput!, $withChildren: Boolean = false) {
+  workItemCreate(input: $input) {
+    workItem {` + workItemFields + `
+    }
+    errors
+  }
+}`
+
+// Create creates a work item of the type in the project.
+func Create(client *gitlab.Client, projectPath, typeID string, in *Input) (*WorkItem, error) {
+	input := in.fields()
+	input["namespacePath"] = projectPath
+	input["workItemTypeId"] = typeID
+	if len(in.AddLabelIDs) > 0 {
+		input["labelsWidget"] = map[string]any{"labelIds": in.AddLabelIDs}
+	}
+
+	var resp struct {
+		Data struct {
+			WorkItemCreate struct {
+				WorkItem *workItemNode `json:"workItem"`
+				Errors   []string      `json:"errors"`
+			} `json:"workItemCreate"`
+		} `json:"data"`
+		api.GraphQLErrors
+	}
+	query := gitlab.GraphQLQuery{Query: createMutation, Variables: map[string]any{"input": input}}
+	if _, err := client.GraphQL.Do(query, &resp); err != nil {
+		return nil, err
+	}
+	if err := resp.Err(); err != nil {
+		return nil, err
+	}
+	if err := api.MutationErr(resp.Data.WorkItemCreate.Errors); err != nil {
+		return nil, err
+	}
+	if resp.Data.WorkItemCreate.WorkItem == nil {
+		return nil, errors.New("the work item was not created.")
+	}
+	return resp.Data.WorkItemCreate.WorkItem.workItem(), nil
+}
+
+const updateMutation = `
+mutation($input: WorkItemUpdateInput!, $withChildren: Boolean = true) {
+  workItemUpdate(input: $input) {
+    workItem {` + workItemFields + `
+    }
+    errors
+  }
+}`
+
+// Update changes the work item.
+func Update(client *gitlab.Client, w *WorkItem, in *Input) (*WorkItem, error) {
+	input := in.fields()
+	input["id"] = w.gid
+	if in.StateEvent != "" {
+		input["stateEvent"] = strings.ToUpper(in.StateEvent)
+	}
+	if len(in.AddLabelIDs) > 0 || len(in.RemoveLabelIDs) > 0 {
+		labels := map[string]any{}
+		if len(in.AddLabelIDs) > 0 {
+			labels["addLabelIds"] = in.AddLabelIDs
+		}
+		if len(in.RemoveLabelIDs) > 0 {
+			labels["removeLabelIds"] = in.RemoveLabelIDs
+		}
+		input["labelsWidget"] = labels
+	}
+	if in.Progress != nil {
+		input["progressWidget"] = map[string]any{"currentValue": *in.Progress}
+	}
+
+	var resp struct {
+		Data struct {
+			WorkItemUpdate struct {
+				WorkItem *workItemNode `json:"workItem"`
+				Errors   []string      `json:"errors"`
+			} `json:"workItemUpdate"`
+		} `json:"data"`
+		api.GraphQLErrors
+	}
+	query := gitlab.GraphQLQuery{Query: updateMutation, Variables: map[string]any{"input": input}}
+	if _, err := client.GraphQL.Do(query, &resp); err != nil {
+		return nil, err
+	}
+	if err := resp.Err(); err != nil {
+		return nil, err
+	}
+	if err := api.MutationErr(resp.Data.WorkItemUpdate.Errors); err != nil {
+		return nil, err
+	}
+	if resp.Data.WorkItemUpdate.WorkItem == nil {
+		return nil, fmt.Errorf("work item #%d was not updated.", w.IID)
+	}
+	return resp.Data.WorkItemUpdate.WorkItem.workItem(), nil
+}
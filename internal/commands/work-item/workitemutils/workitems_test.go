@@ -0,0 +1,154 @@
+//go:build !integration
+
+package workitemutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+
+	"gitlab.com/gitlab-org/cli/internal/testing/cmdtest"
+)
+
+func TestParseType(t *testing.T) {
+	for arg, want := range map[string]string{
+		"task":       "TASK",
+		"Key-Result": "KEY_RESULT",
+		"key_result": "KEY_RESULT",
+		"TEST-CASE":  "TEST_CASE",
+	} {
+		got, err := ParseType(arg)
+		require.NoError(t, err, arg)
+		assert.Equal(t, want, got, arg)
+	}
+
+	_, err := ParseType("story")
+	require.EqualError(t, err, `invalid work item type "story". Use one of: issue, task, objective, key-result, incident, test-case, requirement, ticket.`)
+}
+
+func TestParseIID(t *testing.T) {
+	iid, err := ParseIID("#42")
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), iid)
+
+	_, err = ParseIID("abc")
+	require.EqualError(t, err, `invalid work item "abc". Use a work item ID, like 12 or #12.`)
+}
+
+func TestGet(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"project": {"workItems": {"nodes": [{
+		"id": "gid://gitlab/WorkItem/900",
+		"iid": "42",
+		"title": "Faster pipelines",
+		"state": "OPEN",
+		"webUrl": "https://gitlab.com/OWNER/REPO/-/work_items/42",
+		"author": {"username": "alice"},
+		"workItemType": {"name": "Objective"},
+		"widgets": [
+			{"type": "DESCRIPTION", "description": "Cut the pipeline time."},
+			{"type": "ASSIGNEES", "assignees": {"nodes": [{"username": "bob"}]}},
+			{"type": "LABELS", "labels": {"nodes": [{"title": "ci"}]}},
+			{"type": "WEIGHT", "weight": 3},
+			{"type": "START_AND_DUE_DATE", "startDate": null, "dueDate": "2026-12-31"},
+			{"type": "PROGRESS", "progress": 40},
+			{"type": "HIERARCHY", "parent": null, "children": {"nodes": [
+				{"iid": "43", "title": "p95 under 10 minutes", "state": "CLOSED", "webUrl": "u", "workItemType": {"name": "Key Result"}}
+			]}},
+			{"type": "NOTES"}
+		]
+	}]}}}}`, func(query gitlab.GraphQLQuery) {
+		assert.Equal(t, "OWNER/REPO", query.Variables["fullPath"])
+		assert.Equal(t, "42", query.Variables["iid"])
+	})
+
+	w, err := Get(tc.Client, "OWNER/REPO", 42)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(900), w.ID)
+	assert.Equal(t, "gid://gitlab/WorkItem/900", w.GlobalID())
+	assert.Equal(t, "Objective", w.Type)
+	assert.Equal(t, "open", w.State)
+	assert.Equal(t, "alice", w.Author)
+	assert.Equal(t, "Cut the pipeline time.", w.Description)
+	assert.Equal(t, []string{"bob"}, w.Assignees)
+	assert.Equal(t, []string{"ci"}, w.Labels)
+	assert.Equal(t, gitlab.Ptr(int64(3)), w.Weight)
+	assert.Empty(t, w.StartDate)
+	assert.Equal(t, "2026-12-31", w.DueDate)
+	assert.Equal(t, gitlab.Ptr(int64(40)), w.Progress)
+	assert.Nil(t, w.Parent)
+	assert.Equal(t, []*WorkItemRef{{IID: 43, Type: "Key Result", Title: "p95 under 10 minutes", State: "closed", WebURL: "u"}}, w.Children)
+}
+
+func TestGet_notFound(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"project": {"workItems": {"nodes": []}}}}`, nil)
+
+	_, err := Get(tc.Client, "OWNER/REPO", 42)
+	require.EqualError(t, err, "work item #42 not found in OWNER/REPO.")
+}
+
+func TestList_paginates(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+
+	var firsts []any
+	var afters []any
+	check := func(query gitlab.GraphQLQuery) {
+		firsts = append(firsts, query.Variables["first"])
+		afters = append(afters, query.Variables["after"])
+		assert.Equal(t, []string{"TASK"}, query.Variables["types"])
+		assert.NotContains(t, query.Variables, "state")
+	}
+	cmdtest.ExpectGraphQL(tc, `{"data": {"project": {"workItems": {"nodes": [{"iid": "1", "state": "OPEN"}, {"iid": "2", "state": "OPEN"}], "pageInfo": {"hasNextPage": true, "endCursor": "c1"}}}}}`, check)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"project": {"workItems": {"nodes": [{"iid": "3", "state": "CLOSED"}], "pageInfo": {"hasNextPage": false}}}}}`, check)
+
+	items, err := List(tc.Client, "OWNER/REPO", &ListOptions{Types: []string{"TASK"}, State: "all", Limit: 5})
+	require.NoError(t, err)
+
+	require.Len(t, items, 3)
+	assert.Equal(t, int64(3), items[2].IID)
+	assert.Equal(t, "closed", items[2].State)
+	assert.Equal(t, []any{5, 3}, firsts)
+	assert.Equal(t, []any{nil, "c1"}, afters)
+}
+
+func TestUpdate_input(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"workItemUpdate": {"workItem": {"iid": "42", "state": "CLOSED"}, "errors": []}}}`, func(query gitlab.GraphQLQuery) {
+		input := query.Variables["input"].(map[string]any)
+		assert.Equal(t, map[string]any{
+			"id":                    "gid://gitlab/WorkItem/900",
+			"stateEvent":            "CLOSE",
+			"assigneesWidget":       map[string]any{"assigneeIds": []string{}},
+			"startAndDueDateWidget": map[string]any{"dueDate": nil},
+			"hierarchyWidget":       map[string]any{"parentId": "gid://gitlab/WorkItem/1"},
+			"labelsWidget":          map[string]any{"removeLabelIds": []string{"gid://gitlab/ProjectLabel/7"}},
+			"progressWidget":        map[string]any{"currentValue": int64(60)},
+		}, input)
+	})
+
+	w := &WorkItem{IID: 42, gid: "gid://gitlab/WorkItem/900"}
+	updated, err := Update(tc.Client, w, &Input{
+		StateEvent:     "close",
+		AssigneeIDs:    []string{},
+		DueDate:        gitlab.Ptr(""),
+		ParentID:       gitlab.Ptr("gid://gitlab/WorkItem/1"),
+		RemoveLabelIDs: []string{"gid://gitlab/ProjectLabel/7"},
+		Progress:       gitlab.Ptr(int64(60)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "closed", updated.State)
+}
+
+func TestUpdate_mutationError(t *testing.T) {
+	tc := gitlabtesting.NewTestClient(t)
+	cmdtest.ExpectGraphQL(tc, `{"data": {"workItemUpdate": {"workItem": null, "errors": ["Progress widget not available"]}}}`, nil)
+
+	_, err := Update(tc.Client, &WorkItem{IID: 42}, &Input{Progress: gitlab.Ptr(int64(60))})
+	require.EqualError(t, err, "Progress widget not available")
+}
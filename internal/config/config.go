@@ -28,6 +28,8 @@ type Config interface {
 	GetWithSource(string, string, bool) (string, string, error)
 	Set(string, string, string) error
 	Hosts() ([]string, error)
+	// Profiles returns the account profiles configured for a host
+	Profiles(string) ([]string, error)
 	Aliases() (*AliasConfig, error)
 	Local() (*LocalConfig, error)
 	// Write writes to the config.yml file
@@ -165,6 +167,8 @@ func NewBlankRoot() *yaml.Node {
 type fileConfig struct {
 	ConfigMap
 	documentRoot *yaml.Node
+	// filename is the file the configuration was parsed from, empty if it was not read from disk.
+	filename string
 }
 
 func (c *fileConfig) Root() *yaml.Node {
@@ -188,6 +192,12 @@ func (c *fileConfig) GetWithSource(hostname, key string, searchENVVars bool) (st
 
 	var cfgError error
 
+	if hostname != "" && IsProfileScopedKey(key) {
+		if profile, _ := ActiveProfile(c, hostname); profile != "" {
+			return c.getProfileValue(hostname, profile, key)
+		}
+	}
+
 	if hostname != "" {
 		hostCfg, err := c.configForHost(hostname)
 		if err != nil && !isNotFoundError(err) {
@@ -202,7 +212,7 @@ func (c *fileConfig) GetWithSource(hostname, key string, searchENVVars bool) (st
 			}
 
 			if (err != nil || hostValue == "") && key == "token" {
-				token, err := keyring.Get("glab:"+hostname, "")
+				token, err := keyring.Get(KeyringService(hostname, ""), "")
 
 				if err == nil {
 					return token, "keyring", nil
@@ -249,9 +259,20 @@ func (c *fileConfig) Set(hostname, key, value string) error {
 		RemoveEntry(string)
 	}
 
-	switch hostname {
-	case "":
+	profile := ""
+	if hostname != "" && IsProfileScopedKey(key) {
+		profile, _ = ActiveProfile(c, hostname)
+	}
+
+	switch {
+	case hostname == "":
 		cfg = c
+	case profile != "":
+		var err error
+		cfg, err = c.configForProfile(hostname, profile, true)
+		if err != nil {
+			return err
+		}
 	default:
 		var err error
 		cfg, err = c.configForHost(hostname)
@@ -273,6 +294,33 @@ func (c *fileConfig) Set(hostname, key, value string) error {
 	}
 }
 
+// getProfileValue looks up a profile-scoped key in a named profile. It never falls back to the
+// host-level value so that a profile can't accidentally authenticate as another account.
+func (c *fileConfig) getProfileValue(hostname, profile, key string) (string, string, error) {
+	profileCfg, err := c.configForProfile(hostname, profile, false)
+	if err != nil && !isNotFoundError(err) {
+		return "", "", err
+	}
+
+	if profileCfg != nil {
+		value, err := profileCfg.GetStringValue(key)
+		if err != nil && !isNotFoundError(err) {
+			return "", "", err
+		}
+		if value != "" {
+			return value, ConfigFile(), nil
+		}
+	}
+
+	if key == "token" {
+		if token, err := keyring.Get(KeyringService(hostname, profile), ""); err == nil {
+			return token, "keyring", nil
+		}
+	}
+
+	return "", ConfigFile(), nil
+}
+
 func (c *fileConfig) Write() error {
 	mainData := yaml.Node{Kind: yaml.MappingNode}
 
@@ -297,7 +297,30 @@ func ParseConfig(filename string) (Config, error) {
 		return nil, err
 	}
 
-	return NewConfig(root), confError
+	cfg := NewConfig(root)
+	cfg.(*fileConfig).filename = filename
+
+	return cfg, confError
+}
+
+// Reload parses the configuration again from the file it was read from, so that changes
+// written by other glab processes become visible. A configuration that was not read from
+// a file is returned unchanged.
+func Reload(cfg Config) (Config, error) {
+	fc, ok := cfg.(*fileConfig)
+	if !ok || fc.filename == "" {
+		return cfg, nil
+	}
+
+	reloaded, err := ParseConfig(fc.filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return nil, err
+	}
+
+	return reloaded, nil
 }
 
 func pathError(err error) error {
@@ -280,6 +280,36 @@ func Test_ParseConfigFilePermissions(t *testing.T) {
 	}
 }
 
+func Test_Reload(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "")
+
+	configFile := filepath.Join(t.TempDir(), "config.yml")
+	require.NoError(t, os.WriteFile(configFile, []byte("hosts:\n  gitlab.com:\n    token: old\n"), 0o600))
+
+	cfg, err := ParseConfig(configFile)
+	require.NoError(t, err)
+
+	// Another process updates the file.
+	require.NoError(t, os.WriteFile(configFile, []byte("hosts:\n  gitlab.com:\n    token: new\n"), 0o600))
+
+	token, err := cfg.Get("gitlab.com", "token")
+	require.NoError(t, err)
+	assert.Equal(t, "old", token)
+
+	reloaded, err := Reload(cfg)
+	require.NoError(t, err)
+
+	token, err = reloaded.Get("gitlab.com", "token")
+	require.NoError(t, err)
+	assert.Equal(t, "new", token)
+
+	// Configurations that were not read from a file are returned as is.
+	inMemory := NewFromString("hosts:\n  gitlab.com:\n    token: memory\n")
+	reloaded, err = Reload(inMemory)
+	require.NoError(t, err)
+	assert.Same(t, inMemory, reloaded)
+}
+
 func Test_parseConfigHostEnv(t *testing.T) {
 	t.Setenv("GITLAB_URI", "https://gitlab.mycompany.env")
 
@@ -100,6 +100,8 @@ func EnvKeyEquivalence(key string) []string {
 		return []string{"GITLAB_CLIENT_ID"}
 	case "is_oauth2":
 		return []string{"GLAB_IS_OAUTH2"}
+	case "profile":
+		return []string{"GLAB_PROFILE"}
 	default:
 		return []string{strings.ToUpper(key)}
 	}
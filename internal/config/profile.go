@@ -0,0 +1,174 @@
+package config
+
+import (
+	"errors"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultProfile is the name of the implicit profile backed by the host-level
+// keys of a host entry. It exists for every configured host.
+const DefaultProfile = "default"
+
+// profileOverride holds the profile selected with the global --profile flag.
+var profileOverride string
+
+// SetProfileOverride selects the profile used for every host for the rest of the process.
+// It takes precedence over GLAB_PROFILE, the local configuration and the host's active profile.
+func SetProfileOverride(profile string) {
+	profileOverride = profile
+}
+
+// profileScopedKeys are the host keys that belong to an account rather than to an instance.
+// When a named profile is active, these keys are read from and written to the profile
+// instead of the host entry.
+var profileScopedKeys = []string{
+	"token",
+	"job_token",
+	"user",
+	"is_oauth2",
+	"oauth2_refresh_token",
+	"oauth2_expiry_date",
+}
+
+// IsProfileScopedKey reports whether the given config key is stored per profile.
+func IsProfileScopedKey(key string) bool {
+	return slices.Contains(profileScopedKeys, ConfigKeyEquivalence(key))
+}
+
+// ValidateProfileName checks that a profile name can be used in keyring service names.
+func ValidateProfileName(profile string) error {
+	if profile == "" {
+		return errors.New("profile name cannot be empty")
+	}
+	if strings.ContainsAny(profile, ": ") {
+		return errors.New("profile name cannot contain colons or spaces")
+	}
+	return nil
+}
+
+// KeyringService returns the keyring service name under which the token of the
+// given host and profile is stored.
+func KeyringService(hostname, profile string) string {
+	if profile == "" || profile == DefaultProfile {
+		return "glab:" + hostname
+	}
+	return "glab:" + hostname + ":" + profile
+}
+
+// ActiveProfile returns the profile used for the given host and where it was selected.
+// Profiles are resolved in this order: the --profile flag, the GLAB_PROFILE environment variable,
+// the `profile` key of the local configuration, and the `profile` key of the host set by `glab auth switch`.
+// An empty profile means the default profile.
+func ActiveProfile(cfg Config, hostname string) (string, string) {
+	if profileOverride != "" {
+		return normalizeProfile(profileOverride), "--profile"
+	}
+
+	if value, source := GetFromEnvWithSource("profile"); value != "" {
+		return normalizeProfile(value), source
+	}
+
+	if l, err := cfg.Local(); err == nil && l != nil {
+		if value, ok := l.Get("profile"); ok {
+			return normalizeProfile(value), LocalConfigFile()
+		}
+	}
+
+	if hostname != "" {
+		if value, _, _ := cfg.GetWithSource(hostname, "profile", false); value != "" {
+			return normalizeProfile(value), ConfigFile()
+		}
+	}
+
+	return "", ""
+}
+
+func normalizeProfile(profile string) string {
+	if profile == DefaultProfile {
+		return ""
+	}
+	return profile
+}
+
+// Profiles returns the names of the profiles configured for the given host,
+// always including the default profile.
+func (c *fileConfig) Profiles(hostname string) ([]string, error) {
+	profiles := []string{DefaultProfile}
+
+	hostCfg, err := c.configForHost(hostname)
+	if err != nil {
+		if isNotFoundError(err) {
+			return profiles, nil
+		}
+		return nil, err
+	}
+
+	profilesMap, err := childMap(&hostCfg.ConfigMap, "profiles", false)
+	if err != nil {
+		if isNotFoundError(err) {
+			return profiles, nil
+		}
+		return nil, err
+	}
+
+	var named []string
+	for i := 0; i < len(profilesMap.Root.Content)-1; i += 2 {
+		named = append(named, profilesMap.Root.Content[i].Value)
+	}
+	sort.Strings(named)
+
+	return append(profiles, named...), nil
+}
+
+// configForProfile returns the config map of a named profile of a host.
+// When create is true, the host and profile entries are created if they are missing.
+func (c *fileConfig) configForProfile(hostname, profile string, create bool) (*ConfigMap, error) {
+	hostCfg, err := c.configForHost(hostname)
+	if err != nil {
+		if !isNotFoundError(err) || !create {
+			return nil, err
+		}
+		hostCfg = c.makeConfigForHost(hostname)
+	}
+
+	profilesMap, err := childMap(&hostCfg.ConfigMap, "profiles", create)
+	if err != nil {
+		return nil, err
+	}
+
+	return childMap(profilesMap, profile, create)
+}
+
+func childMap(parent *ConfigMap, key string, create bool) (*ConfigMap, error) {
+	entry, err := parent.FindEntry(key)
+	if err == nil && entry.ValueNode == nil {
+		parent.RemoveEntry(key)
+		err = &NotFoundError{errors.New("not found")}
+	}
+	if err == nil {
+		if entry.ValueNode.Kind != yaml.MappingNode {
+			if !create {
+				return nil, &NotFoundError{errors.New("not found")}
+			}
+			entry.ValueNode.Kind = yaml.MappingNode
+			entry.ValueNode.Tag = ""
+			entry.ValueNode.Value = ""
+		}
+		return &ConfigMap{Root: entry.ValueNode}, nil
+	}
+	if !isNotFoundError(err) || !create {
+		return nil, err
+	}
+
+	valueNode := &yaml.Node{Kind: yaml.MappingNode}
+	parent.Root.Content = append(parent.Root.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Value: key},
+		valueNode,
+	)
+
+	return &ConfigMap{Root: valueNode}, nil
+}
@@ -0,0 +1,168 @@
+//go:build !integration
+
+package config
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+const profileConfig = `hosts:
+  gitlab.com:
+    token: personal-token
+    user: monalisa
+    git_protocol: ssh
+    profiles:
+      bot:
+        token: bot-token
+        user: monabot
+`
+
+func Test_fileConfig_Get_profile(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "")
+	t.Setenv("GLAB_PROFILE", "")
+
+	tests := []struct {
+		name        string
+		envProfile  string
+		config      string
+		wantToken   string
+		wantUser    string
+		wantProfile string
+	}{
+		{
+			name:      "default profile uses host keys",
+			config:    profileConfig,
+			wantToken: "personal-token",
+			wantUser:  "monalisa",
+		},
+		{
+			name:        "GLAB_PROFILE selects a named profile",
+			envProfile:  "bot",
+			config:      profileConfig,
+			wantToken:   "bot-token",
+			wantUser:    "monabot",
+			wantProfile: "bot",
+		},
+		{
+			name:        "host profile key selects a named profile",
+			config:      profileConfig + "    profile: bot\n",
+			wantToken:   "bot-token",
+			wantUser:    "monabot",
+			wantProfile: "bot",
+		},
+		{
+			name:       "GLAB_PROFILE=default overrides the host profile",
+			envProfile: "default",
+			config:     profileConfig + "    profile: bot\n",
+			wantToken:  "personal-token",
+			wantUser:   "monalisa",
+		},
+		{
+			name:        "unknown profile does not fall back to host token",
+			envProfile:  "other",
+			config:      profileConfig,
+			wantProfile: "other",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			keyring.MockInit()
+			t.Setenv("GLAB_PROFILE", tc.envProfile)
+
+			cfg := NewFromString(tc.config)
+
+			token, err := cfg.Get("gitlab.com", "token")
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantToken, token)
+
+			user, err := cfg.Get("gitlab.com", "user")
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantUser, user)
+
+			// Instance settings are shared by all profiles.
+			protocol, err := cfg.Get("gitlab.com", "git_protocol")
+			require.NoError(t, err)
+			assert.Equal(t, "ssh", protocol)
+
+			profile, _ := ActiveProfile(cfg, "gitlab.com")
+			assert.Equal(t, tc.wantProfile, profile)
+		})
+	}
+}
+
+func Test_fileConfig_Get_profileLocalConfig(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "")
+	t.Setenv("GLAB_PROFILE", "")
+
+	cfg := NewFromString(profileConfig + "    profile: default\nlocal:\n  profile: bot\n")
+
+	token, err := cfg.Get("gitlab.com", "token")
+	require.NoError(t, err)
+	assert.Equal(t, "bot-token", token)
+}
+
+func Test_fileConfig_Get_profileKeyring(t *testing.T) {
+	t.Setenv("GITLAB_TOKEN", "")
+	t.Setenv("GLAB_PROFILE", "ci")
+
+	keyring.MockInit()
+	require.NoError(t, keyring.Set("glab:gitlab.com:ci", "", "glpat-ci"))
+
+	cfg := NewFromString(profileConfig)
+
+	token, source, err := cfg.GetWithSource("gitlab.com", "token", false)
+	require.NoError(t, err)
+	assert.Equal(t, "glpat-ci", token)
+	assert.Equal(t, "keyring", source)
+}
+
+func Test_fileConfig_Set_profile(t *testing.T) {
+	t.Setenv("GLAB_PROFILE", "bot")
+
+	mainBuf := bytes.Buffer{}
+	defer StubWriteConfig(&mainBuf, &bytes.Buffer{})()
+
+	cfg := NewFromString(heredoc.Doc(`
+		hosts:
+		  gitlab.com:
+		    token: personal-token
+	`))
+
+	require.NoError(t, cfg.Set("gitlab.com", "token", "bot-token"))
+	require.NoError(t, cfg.Set("gitlab.com", "api_protocol", "https"))
+	require.NoError(t, cfg.Write())
+
+	assert.Equal(t, heredoc.Doc(`
+		hosts:
+		    gitlab.com:
+		        token: personal-token
+		        profiles:
+		            bot:
+		                token: bot-token
+		        api_protocol: https
+	`), mainBuf.String())
+
+	profiles, err := cfg.Profiles("gitlab.com")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"default", "bot"}, profiles)
+}
+
+func Test_KeyringService(t *testing.T) {
+	assert.Equal(t, "glab:gitlab.com", KeyringService("gitlab.com", ""))
+	assert.Equal(t, "glab:gitlab.com", KeyringService("gitlab.com", "default"))
+	assert.Equal(t, "glab:gitlab.com:bot", KeyringService("gitlab.com", "bot"))
+}
+
+func Test_ValidateProfileName(t *testing.T) {
+	require.NoError(t, ValidateProfileName("bot"))
+	require.EqualError(t, ValidateProfileName(""), "profile name cannot be empty")
+	require.EqualError(t, ValidateProfileName("ci:bot"), "profile name cannot contain colons or spaces")
+	require.EqualError(t, ValidateProfileName("ci bot"), "profile name cannot contain colons or spaces")
+}
@@ -1,4 +1,6 @@
-package get_token
+// Package filemutex provides a mutex across glab processes based on lock files
+// in the user's cache directory.
+package filemutex
 
 import (
 	"context"
@@ -21,7 +23,10 @@ type fileMutex struct {
 	f        *os.File
 }
 
-func withLock[T any](ctx context.Context, id string, fn func() (*T, error)) (t *T, retErr error) { //nolint:nonamedreturns
+// WithLock runs fn while holding the lock identified by id. It waits until the lock is
+// available or ctx is done. Lock files older than a minute are considered stale and are removed.
+// The id must be a valid file name.
+func WithLock[T any](ctx context.Context, id string, fn func() (*T, error)) (t *T, retErr error) { //nolint:nonamedreturns
 	root, err := lockFileBaseDir()
 	if err != nil {
 		return nil, err
@@ -89,7 +94,7 @@ func (m *fileMutex) lock(ctx context.Context) error {
 			m.f = f
 			return nil
 		case <-infoT.C:
-			fmt.Fprintln(os.Stderr, "Trying to acquire lock for token cache ...")
+			fmt.Fprintf(os.Stderr, "Trying to acquire lock %s ...\n", filepath.Join(m.root.Name(), m.filename))
 		}
 	}
 }
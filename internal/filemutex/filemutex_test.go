@@ -1,6 +1,6 @@
 //go:build !integration
 
-package get_token
+package filemutex
 
 import (
 	"context"
@@ -43,7 +43,7 @@ func TestFileMutex(t *testing.T) {
 		expected := "test-result"
 
 		// WHEN
-		actual, err := withLock(t.Context(), lockFileName(t), func() (*string, error) {
+		actual, err := WithLock(t.Context(), lockFileName(t), func() (*string, error) {
 			return &expected, nil
 		})
 		require.NoError(t, err)
@@ -58,7 +58,7 @@ func TestFileMutex(t *testing.T) {
 		expectedErr := errors.New("function error")
 
 		// WHEN
-		result, err := withLock(t.Context(), lockFileName(t), func() (*string, error) {
+		result, err := WithLock(t.Context(), lockFileName(t), func() (*string, error) {
 			return nil, expectedErr
 		})
 
@@ -74,7 +74,7 @@ func TestFileMutex(t *testing.T) {
 
 		// WHEN
 		cancel()
-		_, err := withLock(ctx, lockFileName(t), func() (*string, error) {
+		_, err := WithLock(ctx, lockFileName(t), func() (*string, error) {
 			return nil, assert.AnError
 		})
 
@@ -0,0 +1,291 @@
+package git
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const RestackStateFile = "RESTACK_STATE"
+
+// ErrRestackConflict is returned when a branch could not be rebased,
+// usually because of a merge conflict. The restack state is saved, so the
+// restack can be resumed with Continue or undone with Abort.
+var ErrRestackConflict = errors.New("restack stopped because of a conflict")
+
+// RestackStep rebases one branch of a stack onto its parent branch.
+type RestackStep struct {
+	// Branch is the branch to rebase.
+	Branch string `json:"branch"`
+	// Onto is the parent branch that Branch is rebased onto.
+	Onto string `json:"onto"`
+	// Upstream is the commit that Branch forked from before the restack.
+	// Only the commits after it are rebased.
+	Upstream string `json:"upstream"`
+	// OrigHead is the commit of Branch before the restack. Abort resets Branch to it.
+	OrigHead string `json:"orig_head"`
+	// Done is set only after Branch was rebased onto Onto successfully.
+	Done bool `json:"done"`
+}
+
+// Restack rebases the branches of a stack one by one. Its state is saved in
+// the stack directory, so a restack that stops on a conflict can be resumed
+// or undone later, like git rebase --continue and git rebase --abort.
+//
+// The stack files are never written by a restack. StackRef.SHA is the ID of
+// an entry of the stack, not a commit, so it stays the same when its branch is
+// rebased. The progress is tracked in the restack state instead, with
+// RestackStep.Done set only after a branch was rebased successfully.
+type Restack struct {
+	Title      string        `json:"title"`
+	OrigBranch string        `json:"orig_branch"`
+	Steps      []RestackStep `json:"steps"`
+	// After is the command that changed the stack before the restack, like fold or split.
+	// Abort only resets the rebased branches, so it doesn't undo that change.
+	After string `json:"after,omitempty"`
+}
+
+// NewRestack plans a restack of all branches after ref, so that each one is
+// based on the branch before it. Nothing is changed until Run is called.
+func NewRestack(stack *Stack, ref StackRef, gr GitRunner) (*Restack, error) {
+	current, err := gr.Git("branch", "--show-current")
+	if err != nil {
+		return nil, fmt.Errorf("error getting current branch: %w", err)
+	}
+
+	r := &Restack{Title: stack.Title, OrigBranch: strings.TrimSpace(current)}
+
+	parent := ref
+	for !parent.IsLast() {
+		child := stack.Refs[parent.Next]
+
+		upstream, err := forkPoint(parent.Branch, child.Branch, gr)
+		if err != nil {
+			return nil, err
+		}
+
+		head, err := gr.Git("rev-parse", child.Branch)
+		if err != nil {
+			return nil, fmt.Errorf("error getting commit of branch %s: %w", child.Branch, err)
+		}
+
+		r.Steps = append(r.Steps, RestackStep{
+			Branch:   child.Branch,
+			Onto:     parent.Branch,
+			Upstream: upstream,
+			OrigHead: strings.TrimSpace(head),
+		})
+
+		parent = child
+	}
+
+	return r, nil
+}
+
+// forkPoint returns the commit where branch forked from parent. It uses the
+// reflog of parent, so commits of parent that were amended are found too.
+func forkPoint(parent, branch string, gr GitRunner) (string, error) {
+	point, err := gr.Git("merge-base", "--fork-point", parent, branch)
+	if err == nil && strings.TrimSpace(point) != "" {
+		return strings.TrimSpace(point), nil
+	}
+
+	point, err = gr.Git("merge-base", parent, branch)
+	if err != nil {
+		return "", fmt.Errorf("error finding where %s forked from %s: %w", branch, parent, err)
+	}
+
+	return strings.TrimSpace(point), nil
+}
+
+// LoadRestack reads the saved state of a restack in progress.
+// It returns an error wrapping os.ErrNotExist if there is none.
+func LoadRestack(title string) (*Restack, error) {
+	filename, err := restackStatePath(title)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Restack{}
+	if err := json.Unmarshal(data, r); err != nil {
+		return nil, fmt.Errorf("error reading restack state: %w", err)
+	}
+
+	return r, nil
+}
+
+// RestackInProgress returns true if a restack of the stack has stopped and was not finished or aborted.
+func RestackInProgress(title string) bool {
+	filename, err := restackStatePath(title)
+	if err != nil {
+		return false
+	}
+
+	_, err = os.Stat(filename)
+	return err == nil
+}
+
+// Current returns the step that is not done yet, or nil if all steps are done.
+func (r *Restack) Current() *RestackStep {
+	for i := range r.Steps {
+		if !r.Steps[i].Done {
+			return &r.Steps[i]
+		}
+	}
+
+	return nil
+}
+
+// Run rebases the branches that are not done yet. If a rebase fails, the
+// state is saved and an error wrapping ErrRestackConflict is returned.
+func (r *Restack) Run(gr GitRunner) error {
+	for step := r.Current(); step != nil; step = r.Current() {
+		// save before rebasing, so the state is there if the rebase stops
+		if err := r.save(); err != nil {
+			return err
+		}
+
+		_, err := gr.Git("rebase", "--onto", step.Onto, step.Upstream, step.Branch)
+		if err != nil {
+			return fmt.Errorf("%w: could not rebase %s onto %s", ErrRestackConflict, step.Branch, step.Onto)
+		}
+
+		step.Done = true
+	}
+
+	return r.finish(gr)
+}
+
+// Continue finishes the rebase that stopped on a conflict, and then rebases the remaining branches.
+func (r *Restack) Continue(gr GitRunner) error {
+	step := r.Current()
+	if step == nil {
+		return r.finish(gr)
+	}
+
+	inProgress, err := rebaseInProgress(gr)
+	if err != nil {
+		return err
+	}
+
+	if inProgress {
+		// keep the commit messages instead of opening an editor
+		_, err := gr.Git("-c", "core.editor=true", "rebase", "--continue")
+		if err != nil {
+			return fmt.Errorf("%w: could not continue rebasing %s onto %s", ErrRestackConflict, step.Branch, step.Onto)
+		}
+	}
+
+	// the rebase might have been finished or aborted with Git directly,
+	// so only mark the step as done if the branch is now based on its parent
+	if _, err := gr.Git("merge-base", "--is-ancestor", step.Onto, step.Branch); err != nil {
+		return fmt.Errorf("branch %s is not based on %s. Rebase it, or undo the restack with abort", step.Branch, step.Onto)
+	}
+	step.Done = true
+
+	return r.Run(gr)
+}
+
+// Abort stops the rebase in progress and resets all branches to the commits they had before the restack.
+func (r *Restack) Abort(gr GitRunner) error {
+	inProgress, err := rebaseInProgress(gr)
+	if err != nil {
+		return err
+	}
+
+	if inProgress {
+		if _, err := gr.Git("rebase", "--abort"); err != nil {
+			return fmt.Errorf("error aborting rebase: %w", err)
+		}
+	}
+
+	// branches can't be reset while they are checked out
+	if _, err := gr.Git("checkout", "--detach"); err != nil {
+		return fmt.Errorf("error detaching HEAD: %w", err)
+	}
+
+	for _, step := range r.Steps {
+		if _, err := gr.Git("branch", "--force", step.Branch, step.OrigHead); err != nil {
+			return fmt.Errorf("error resetting branch %s: %w", step.Branch, err)
+		}
+	}
+
+	return r.finish(gr)
+}
+
+func (r *Restack) finish(gr GitRunner) error {
+	if r.OrigBranch != "" {
+		if err := CheckoutBranch(r.OrigBranch, gr); err != nil {
+			return err
+		}
+	}
+
+	filename, err := restackStatePath(r.Title)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filename)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error removing restack state: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Restack) save() error {
+	filename, err := restackStatePath(r.Title)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("error marshaling data: %v", err)
+	}
+
+	err = os.WriteFile(filename, data, 0o644)
+	if err != nil {
+		return fmt.Errorf("error writing restack state: %v", err)
+	}
+
+	return nil
+}
+
+func restackStatePath(title string) (string, error) {
+	root, err := StackRootDir(title)
+	if err != nil {
+		return "", fmt.Errorf("could not determine stack root: %w", err)
+	}
+
+	return filepath.Join(root, RestackStateFile), nil
+}
+
+// rebaseInProgress returns true if Git has stopped in the middle of a rebase.
+// Git resolves the paths of the rebase state, because .git is a file in worktrees.
+func rebaseInProgress(gr GitRunner) (bool, error) {
+	for _, dir := range []string{"rebase-merge", "rebase-apply"} {
+		path, err := gr.Git("rev-parse", "--git-path", dir)
+		if err != nil {
+			return false, fmt.Errorf("finding Git directory: %w", err)
+		}
+
+		_, err = os.Stat(strings.TrimSpace(path))
+		if err == nil {
+			return true, nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return false, err
+		}
+	}
+
+	return false, nil
+}
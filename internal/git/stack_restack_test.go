@@ -0,0 +1,199 @@
+//go:build !integration
+
+package git
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gitOutput(t *testing.T, args ...string) string {
+	t.Helper()
+
+	out, err := StandardGitCommand{}.Git(args...)
+	require.NoError(t, err)
+
+	return strings.TrimSpace(out)
+}
+
+func commitFile(t *testing.T, name, content string, amend bool) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(name, []byte(content), 0o644))
+	gitOutput(t, "add", name)
+
+	if amend {
+		gitOutput(t, "commit", "--amend", "--no-edit")
+		return
+	}
+	gitOutput(t, "commit", "-m", "Change "+name)
+}
+
+// setupRestackStack creates the stack Branch1 → Branch2, and then amends Branch1.
+// If conflict is true, the amended commit conflicts with the commit of Branch2.
+func setupRestackStack(t *testing.T, conflict bool) Stack {
+	t.Helper()
+
+	InitGitRepoWithCommit(t)
+	gitOutput(t, "checkout", "-b", "Branch1")
+	commitFile(t, "a.txt", "one\n", false)
+	gitOutput(t, "checkout", "-b", "Branch2")
+	commitFile(t, "a.txt", "two\n", false)
+
+	gitOutput(t, "checkout", "Branch1")
+	if conflict {
+		commitFile(t, "a.txt", "uno\n", true)
+	} else {
+		commitFile(t, "b.txt", "other\n", true)
+	}
+
+	refs := map[string]StackRef{
+		"1": {SHA: "1", Next: "2", Branch: "Branch1"},
+		"2": {SHA: "2", Prev: "1", Branch: "Branch2"},
+	}
+	require.NoError(t, CreateRefFiles(refs, "restack"))
+
+	stack, err := GatherStackRefs("restack")
+	require.NoError(t, err)
+
+	return stack
+}
+
+func TestRestack_Run(t *testing.T) {
+	stack := setupRestackStack(t, false)
+	gr := StandardGitCommand{}
+
+	before := readStackFiles(t, "restack")
+
+	r, err := NewRestack(&stack, stack.First(), gr)
+	require.NoError(t, err)
+	require.Len(t, r.Steps, 1)
+
+	require.NoError(t, r.Run(gr))
+
+	// the IDs of the entries and their links stay the same when the branches are rebased
+	assert.Equal(t, before, readStackFiles(t, "restack"))
+
+	_, err = gr.Git("merge-base", "--is-ancestor", "Branch1", "Branch2")
+	require.NoError(t, err)
+	assert.Equal(t, "Branch1", gitOutput(t, "branch", "--show-current"))
+	assert.False(t, RestackInProgress("restack"))
+}
+
+// readStackFiles returns the contents of the stack files, by name, without the restack state.
+func readStackFiles(t *testing.T, title string) map[string]string {
+	t.Helper()
+
+	root, err := StackRootDir(title)
+	require.NoError(t, err)
+	entries, err := os.ReadDir(root)
+	require.NoError(t, err)
+
+	files := map[string]string{}
+	for _, e := range entries {
+		if e.Name() == RestackStateFile {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(root, e.Name()))
+		require.NoError(t, err)
+		files[e.Name()] = string(data)
+	}
+	return files
+}
+
+func TestRestack_failedStepKeepsStackFiles(t *testing.T) {
+	stack := setupRestackStack(t, true)
+	gr := StandardGitCommand{}
+	before := readStackFiles(t, "restack")
+	require.Len(t, before, 2)
+
+	r, err := NewRestack(&stack, stack.First(), gr)
+	require.NoError(t, err)
+	require.ErrorIs(t, r.Run(gr), ErrRestackConflict)
+
+	assert.Equal(t, before, readStackFiles(t, "restack"))
+
+	saved, err := LoadRestack("restack")
+	require.NoError(t, err)
+	assert.False(t, saved.Steps[0].Done)
+}
+
+func TestRestack_conflict(t *testing.T) {
+	t.Run("continue", func(t *testing.T) {
+		stack := setupRestackStack(t, true)
+		gr := StandardGitCommand{}
+
+		r, err := NewRestack(&stack, stack.First(), gr)
+		require.NoError(t, err)
+
+		err = r.Run(gr)
+		require.ErrorIs(t, err, ErrRestackConflict)
+		require.True(t, RestackInProgress("restack"))
+
+		saved, err := LoadRestack("restack")
+		require.NoError(t, err)
+		assert.Equal(t, "Branch2", saved.Current().Branch)
+
+		// continuing without resolving the conflict fails, and keeps the state
+		require.ErrorIs(t, saved.Continue(gr), ErrRestackConflict)
+		require.True(t, RestackInProgress("restack"))
+
+		require.NoError(t, os.WriteFile("a.txt", []byte("uno dos\n"), 0o644))
+		gitOutput(t, "add", "a.txt")
+
+		require.NoError(t, saved.Continue(gr))
+		assert.False(t, RestackInProgress("restack"))
+
+		_, err = gr.Git("merge-base", "--is-ancestor", "Branch1", "Branch2")
+		require.NoError(t, err)
+		assert.Equal(t, "Branch1", gitOutput(t, "branch", "--show-current"))
+	})
+
+	t.Run("abort", func(t *testing.T) {
+		stack := setupRestackStack(t, true)
+		gr := StandardGitCommand{}
+		origHead := gitOutput(t, "rev-parse", "Branch2")
+
+		r, err := NewRestack(&stack, stack.First(), gr)
+		require.NoError(t, err)
+		require.ErrorIs(t, r.Run(gr), ErrRestackConflict)
+
+		saved, err := LoadRestack("restack")
+		require.NoError(t, err)
+		require.NoError(t, saved.Abort(gr))
+
+		assert.False(t, RestackInProgress("restack"))
+		assert.Equal(t, origHead, gitOutput(t, "rev-parse", "Branch2"))
+		assert.Equal(t, "Branch1", gitOutput(t, "branch", "--show-current"))
+
+		_, err = LoadRestack("restack")
+		assert.True(t, errors.Is(err, os.ErrNotExist))
+	})
+}
+
+func Test_rebaseInProgress_worktree(t *testing.T) {
+	setupRestackStack(t, true)
+	gr := StandardGitCommand{}
+
+	worktree := filepath.Join(t.TempDir(), "worktree")
+	gitOutput(t, "worktree", "add", worktree, "Branch2")
+	t.Chdir(worktree)
+
+	inProgress, err := rebaseInProgress(gr)
+	require.NoError(t, err)
+	assert.False(t, inProgress)
+
+	// .git is a file in a worktree, and the rebase state is in the Git directory of the worktree
+	_, err = gr.Git("rebase", "Branch1")
+	require.Error(t, err)
+
+	inProgress, err = rebaseInProgress(gr)
+	require.NoError(t, err)
+	assert.True(t, inProgress)
+}
@@ -0,0 +1,166 @@
+package git
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gitlab.com/gitlab-org/cli/internal/run"
+	"gitlab.com/gitlab-org/cli/internal/utils"
+)
+
+// StackMetadataRefPrefix is where stack metadata is stored in Git, so it can be pushed and fetched.
+const StackMetadataRefPrefix = "refs/glab/stacks/"
+
+const stackMetadataFile = "stack.json"
+
+// stackRefIDPattern matches the IDs of stack refs, which name their files in .git/stacked.
+var stackRefIDPattern = regexp.MustCompile(`^[0-9a-f]{8}$`)
+
+// ExportedStack is the metadata of a stack in a form that can be shared with other machines.
+type ExportedStack struct {
+	Title string     `json:"title"`
+	Base  string     `json:"base"`
+	Refs  []StackRef `json:"refs"`
+}
+
+// ExportStack returns the metadata of the stack, with the refs in stack order.
+func ExportStack(stack *Stack, gr GitRunner) (ExportedStack, error) {
+	base, err := stack.BaseBranch(gr)
+	if err != nil {
+		return ExportedStack{}, err
+	}
+
+	exported := ExportedStack{Title: stack.Title, Base: base, Refs: []StackRef{}}
+	for ref := range stack.Iter() {
+		exported.Refs = append(exported.Refs, ref)
+	}
+
+	return exported, nil
+}
+
+// Branches returns the branches of the exported stack, in order.
+func (e ExportedStack) Branches() []string {
+	branches := make([]string, len(e.Refs))
+	for i, ref := range e.Refs {
+		branches[i] = ref.Branch
+	}
+
+	return branches
+}
+
+// StackMetadataRef returns the Git ref that stores the metadata of the stack.
+func StackMetadataRef(title string) string {
+	return StackMetadataRefPrefix + title
+}
+
+// PushStackMetadata commits the exported stack to its metadata ref, and pushes the ref to the remote.
+// The commit is based on the last pushed or fetched metadata, so the push fails
+// if someone else pushed changes to the stack in the meantime.
+func PushStackMetadata(exported ExportedStack, remote string) error {
+	data, err := json.MarshalIndent(exported, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling data: %v", err)
+	}
+
+	blob, err := gitWithInput(data, "hash-object", "-w", "--stdin")
+	if err != nil {
+		return fmt.Errorf("error storing stack metadata: %w", err)
+	}
+
+	tree, err := gitWithInput([]byte(fmt.Sprintf("100644 blob %s\t%s\n", blob, stackMetadataFile)), "mktree")
+	if err != nil {
+		return fmt.Errorf("error storing stack metadata: %w", err)
+	}
+
+	ref := StackMetadataRef(exported.Title)
+	args := []string{"commit-tree", tree, "-m", "Update stack " + exported.Title}
+	if parent, err := gitWithInput(nil, "rev-parse", "--verify", "--quiet", ref); err == nil && parent != "" {
+		args = append(args, "-p", parent)
+	}
+
+	commit, err := gitWithInput(nil, args...)
+	if err != nil {
+		return fmt.Errorf("error storing stack metadata: %w", err)
+	}
+
+	if _, err := gitWithInput(nil, "update-ref", ref, commit); err != nil {
+		return fmt.Errorf("error updating %s: %w", ref, err)
+	}
+
+	if _, err := gitWithInput(nil, "push", remote, ref+":"+ref); err != nil {
+		return fmt.Errorf("error pushing %s. If someone else updated the stack, import it with --from-ref first: %w", ref, err)
+	}
+
+	return nil
+}
+
+// FetchStackMetadata fetches the metadata ref of the stack from the remote, and returns the exported stack.
+func FetchStackMetadata(title, remote string) (ExportedStack, error) {
+	ref := StackMetadataRef(title)
+
+	if _, err := gitWithInput(nil, "fetch", remote, "+"+ref+":"+ref); err != nil {
+		return ExportedStack{}, fmt.Errorf("error fetching %s from %s: %w", ref, remote, err)
+	}
+
+	data, err := gitWithInput(nil, "show", ref+":"+stackMetadataFile)
+	if err != nil {
+		return ExportedStack{}, fmt.Errorf("error reading stack metadata from %s: %w", ref, err)
+	}
+
+	return ParseExportedStack([]byte(data))
+}
+
+// ParseExportedStack reads an exported stack, and checks that its refs are linked in order.
+func ParseExportedStack(data []byte) (ExportedStack, error) {
+	var exported ExportedStack
+	if err := json.Unmarshal(data, &exported); err != nil {
+		return ExportedStack{}, fmt.Errorf("error reading stack metadata: %w", err)
+	}
+
+	if exported.Title == "" || len(exported.Refs) == 0 {
+		return ExportedStack{}, fmt.Errorf("stack metadata has no title or no refs.")
+	}
+
+	// The title names the directory of the stack in .git/stacked, so it must not lead out of it.
+	title := exported.Title
+	if strings.ContainsAny(title, `/\`) || strings.Contains(title, "..") || utils.ReplaceNonAlphaNumericChars(title, "-") != title {
+		return ExportedStack{}, fmt.Errorf("stack metadata has an invalid title %q. Titles can only contain letters, digits, and dashes.", title)
+	}
+
+	for i, ref := range exported.Refs {
+		wantPrev, wantNext := "", ""
+		if i > 0 {
+			wantPrev = exported.Refs[i-1].SHA
+		}
+		if i < len(exported.Refs)-1 {
+			wantNext = exported.Refs[i+1].SHA
+		}
+
+		if !stackRefIDPattern.MatchString(ref.SHA) {
+			return ExportedStack{}, fmt.Errorf("stack metadata has an invalid ID %q at branch %q. Data might be corrupted.", ref.SHA, ref.Branch)
+		}
+		if ref.Branch == "" || ref.Prev != wantPrev || ref.Next != wantNext {
+			return ExportedStack{}, fmt.Errorf("stack metadata is inconsistent at branch %q. Data might be corrupted.", ref.Branch)
+		}
+	}
+
+	return exported, nil
+}
+
+// gitWithInput runs Git with data as standard input, and returns the trimmed output.
+func gitWithInput(data []byte, args ...string) (string, error) {
+	cmd := GitCommand(args...)
+	if data != nil {
+		cmd.Stdin = bytes.NewReader(data)
+	}
+
+	output, err := run.PrepareCmd(cmd).Output()
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
@@ -0,0 +1,62 @@
+//go:build !integration
+
+package git
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackMetadata_pushAndFetch(t *testing.T) {
+	remote := t.TempDir()
+	InitGitRepoWithCommit(t)
+	gitOutput(t, "init", "--bare", remote)
+	gitOutput(t, "remote", "add", "origin", remote)
+
+	exported := ExportedStack{
+		Title: "cool-stack",
+		Base:  "main",
+		Refs: []StackRef{
+			{SHA: "11111111", Next: "22222222", Branch: "Branch1", Description: "First"},
+			{SHA: "22222222", Prev: "11111111", Branch: "Branch2", Description: "Second", MR: "https://gitlab.com/OWNER/REPO/-/merge_requests/2"},
+		},
+	}
+
+	require.NoError(t, PushStackMetadata(exported, "origin"))
+	first := gitOutput(t, "rev-parse", StackMetadataRef("cool-stack"))
+
+	// a second push builds on the first one
+	exported.Refs[0].Description = "First, updated"
+	require.NoError(t, PushStackMetadata(exported, "origin"))
+	assert.Equal(t, first, gitOutput(t, "rev-parse", StackMetadataRef("cool-stack")+"^"))
+
+	gitOutput(t, "update-ref", "-d", StackMetadataRef("cool-stack"))
+
+	fetched, err := FetchStackMetadata("cool-stack", "origin")
+	require.NoError(t, err)
+	assert.Equal(t, exported, fetched)
+}
+
+func TestParseExportedStack(t *testing.T) {
+	_, err := ParseExportedStack([]byte(`{"title": "cool-stack", "base": "main", "refs": [
+		{"sha": "11111111", "next": "22222222", "branch": "Branch1"},
+		{"sha": "22222222", "prev": "33333333", "branch": "Branch2"}
+	]}`))
+	require.ErrorContains(t, err, `stack metadata is inconsistent at branch "Branch2"`)
+
+	_, err = ParseExportedStack([]byte(`{"title": "cool-stack", "refs": []}`))
+	require.ErrorContains(t, err, "stack metadata has no title or no refs")
+
+	for _, title := range []string{"../../x", "..", "a/b", `a\b`, "cool stack"} {
+		_, err = ParseExportedStack([]byte(`{"title": "` + strings.ReplaceAll(title, `\`, `\\`) + `", "refs": [{"sha": "11111111", "branch": "Branch1"}]}`))
+		require.ErrorContains(t, err, "stack metadata has an invalid title", title)
+	}
+
+	for _, sha := range []string{"../../hooks/x", "1", "1111111G", "111111111"} {
+		_, err = ParseExportedStack([]byte(`{"title": "cool-stack", "refs": [{"sha": "` + sha + `", "branch": "Branch1"}]}`))
+		require.ErrorContains(t, err, "stack metadata has an invalid ID", sha)
+	}
+}
@@ -269,6 +269,62 @@ func AddStackBaseBranch(title string, branch string) error {
 	return nil
 }
 
+// AddStackMRToClose records a merge request that no longer belongs to the stack,
+// for example because its entry was folded. The next sync closes it.
+func AddStackMRToClose(title string, mr string) error {
+	root, err := StackRootDir(title)
+	if err != nil {
+		return fmt.Errorf("could not determine stack root: %w", err)
+	}
+
+	filename := filepath.Join(root, CloseMRsFile)
+	file, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("error opening metadata file %v: %v", filename, err)
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintln(file, mr)
+	if err != nil {
+		return fmt.Errorf("error adding merge request to metadata file %v: %v", filename, err)
+	}
+
+	return nil
+}
+
+// StackMRsToClose returns the merge requests recorded with AddStackMRToClose.
+func StackMRsToClose(title string) ([]string, error) {
+	root, err := StackRootDir(title)
+	if err != nil {
+		return nil, fmt.Errorf("could not determine stack root: %w", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(root, CloseMRsFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read merge requests to close: %w", err)
+	}
+
+	return strings.Fields(string(data)), nil
+}
+
+// ClearStackMRsToClose forgets the merge requests recorded with AddStackMRToClose.
+func ClearStackMRsToClose(title string) error {
+	root, err := StackRootDir(title)
+	if err != nil {
+		return fmt.Errorf("could not determine stack root: %w", err)
+	}
+
+	err = os.Remove(filepath.Join(root, CloseMRsFile))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
 func GatherStackRefs(title string) (Stack, error) {
 	stack := Stack{Title: title}
 	stack.Refs = make(map[string]StackRef)
@@ -13,7 +13,10 @@ import (
 
 var StackLocation = filepath.Join(".git", "stacked")
 
-const BaseBranchFile = "BASE_BRANCH"
+const (
+	BaseBranchFile = "BASE_BRANCH"
+	CloseMRsFile   = "CLOSE_MRS"
+)
 
 type GitRunner interface {
 	Git(args ...string) (string, error)
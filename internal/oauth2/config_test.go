@@ -32,6 +32,7 @@ func (s stubConfig) Set(host string, key string, value string) error {
 }
 
 func (s stubConfig) Hosts() ([]string, error)              { return nil, nil }
+func (s stubConfig) Profiles(string) ([]string, error)     { return nil, nil }
 func (s stubConfig) Aliases() (*config.AliasConfig, error) { return nil, nil }
 func (s stubConfig) Local() (*config.LocalConfig, error)   { return nil, nil }
 func (s stubConfig) Write() error                          { return nil }
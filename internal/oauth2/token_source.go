@@ -2,17 +2,24 @@ package oauth2
 
 import (
 	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
 	"net/http"
 	"sync"
+	"time"
 
 	"golang.org/x/oauth2"
 
 	"gitlab.com/gitlab-org/api/client-go/gitlaboauth2"
 
 	"gitlab.com/gitlab-org/cli/internal/config"
+	"gitlab.com/gitlab-org/cli/internal/filemutex"
 )
 
+// refreshLockTimeout is how long a process waits for another glab process to finish refreshing a token.
+const refreshLockTimeout = 30 * time.Second
+
 type configTokenSource struct {
 	cfg        config.Config
 	httpClient *http.Client
@@ -25,52 +32,115 @@ type configTokenSource struct {
 }
 
 func NewConfigTokenSource(cfg config.Config, httpClient *http.Client, protocol, hostname string) (oauth2.TokenSource, error) {
-	clientID, err := oauthClientID(cfg, hostname)
+	src, err := newConfigTokenSource(cfg, httpClient, protocol, hostname)
 	if err != nil {
 		return nil, err
 	}
 
-	oauth2Config := gitlaboauth2.NewOAuth2Config(fmt.Sprintf("%s://%s", protocol, hostname), clientID, redirectURL, scopes)
-
 	token, err := unmarshal(hostname, cfg)
 	if err != nil {
 		return nil, err
 	}
 
-	src := &configTokenSource{
+	return oauth2.ReuseTokenSource(token, src), nil
+}
+
+// Refresh refreshes the OAuth 2.0 token of the host unconditionally and saves it to the configuration.
+func Refresh(ctx context.Context, cfg config.Config, httpClient *http.Client, protocol, hostname string) (*oauth2.Token, error) {
+	src, err := newConfigTokenSource(cfg, httpClient, protocol, hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	src.mu.Lock()
+	defer src.mu.Unlock()
+
+	return src.refresh(ctx, true)
+}
+
+func newConfigTokenSource(cfg config.Config, httpClient *http.Client, protocol, hostname string) (*configTokenSource, error) {
+	clientID, err := oauthClientID(cfg, hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	oauth2Config := gitlaboauth2.NewOAuth2Config(fmt.Sprintf("%s://%s", protocol, hostname), clientID, redirectURL, scopes)
+
+	return &configTokenSource{
 		cfg:          cfg,
 		oauth2Config: oauth2Config,
 		httpClient:   httpClient,
 		hostname:     hostname,
-	}
-
-	return oauth2.ReuseTokenSource(token, src), nil
+	}, nil
 }
 
 func (c *configTokenSource) Token() (*oauth2.Token, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	token, err := unmarshal(c.hostname, c.cfg)
+	return c.refresh(context.Background(), false)
+}
+
+// refresh exchanges the refresh token for a new token while holding a lock shared by all glab processes.
+// Refresh tokens are single-use, so two processes refreshing at the same time would invalidate each other's tokens.
+// Unless force is set, a token that another process refreshed while this one was waiting is reused instead.
+func (c *configTokenSource) refresh(ctx context.Context, force bool) (*oauth2.Token, error) {
+	profile, _ := config.ActiveProfile(c.cfg, c.hostname)
+	lockID := "oauth2-" + base64.RawURLEncoding.EncodeToString([]byte(config.KeyringService(c.hostname, profile)))
+
+	ctx, cancel := context.WithTimeout(ctx, refreshLockTimeout)
+	defer cancel()
+
+	token, err := filemutex.WithLock(ctx, lockID, func() (*oauth2.Token, error) {
+		// Read the configuration again, because another process may have refreshed the token.
+		cfg, err := config.Reload(c.cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		token, err := unmarshal(c.hostname, cfg)
+		if err != nil {
+			return nil, err
+		}
+
+		if !force && token.Valid() {
+			return token, c.save(cfg, token, false)
+		}
+
+		refreshCtx := context.WithValue(ctx, oauth2.HTTPClient, c.httpClient)
+		// Clear the access token so that the refresh token is used even if the access token is still valid.
+		refreshedToken, err := c.oauth2Config.TokenSource(refreshCtx, &oauth2.Token{RefreshToken: token.RefreshToken}).Token()
+		if err != nil {
+			return nil, err
+		}
+
+		return refreshedToken, c.save(cfg, refreshedToken, true)
+	})
 	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, fmt.Errorf("timed out refreshing the OAuth 2.0 token for %s: %w", c.hostname, err)
+		}
 		return nil, err
 	}
 
-	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, c.httpClient)
-	refreshedToken, err := c.oauth2Config.TokenSource(ctx, token).Token()
-	if err != nil {
-		return nil, err
+	return token, nil
+}
+
+// save stores the token in the in-memory configuration and, if write is set, in the
+// freshly loaded configuration on disk. Writing the reloaded configuration keeps changes
+// made by other processes.
+func (c *configTokenSource) save(reloaded config.Config, token *oauth2.Token, write bool) error {
+	if err := marshal(c.hostname, c.cfg, token); err != nil {
+		return err
 	}
 
-	err = marshal(c.hostname, c.cfg, refreshedToken)
-	if err != nil {
-		return nil, err
+	if !write {
+		return nil
 	}
 
-	err = c.cfg.Write()
-	if err != nil {
-		return nil, err
+	if err := marshal(c.hostname, reloaded, token); err != nil {
+		return err
 	}
 
-	return refreshedToken, nil
+	return reloaded.Write()
 }
@@ -0,0 +1,119 @@
+//go:build !integration
+
+package oauth2
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTokenServer(t *testing.T, calls *atomic.Int32) *url.URL {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/oauth/token", r.URL.Path)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+
+		n := calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"new_access_token_%d","refresh_token":"new_refresh_token","token_type":"bearer","expires_in":7200}`, n)
+	}))
+	t.Cleanup(server.Close)
+
+	u, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	return u
+}
+
+func Test_configTokenSource_reusesValidToken(t *testing.T) {
+	var calls atomic.Int32
+	u := newTokenServer(t, &calls)
+
+	// The token in the configuration was refreshed by another process after this process read it.
+	cfg := stubConfig{
+		hosts: map[string]map[string]string{
+			u.Host: {
+				"client_id":            "client",
+				"is_oauth2":            "true",
+				"oauth2_refresh_token": "refresh_token",
+				"token":                "access_token",
+				"oauth2_expiry_date":   time.Now().Add(time.Hour).Format(time.RFC822),
+			},
+		},
+	}
+
+	src, err := newConfigTokenSource(cfg, http.DefaultClient, u.Scheme, u.Host)
+	require.NoError(t, err)
+
+	token, err := src.Token()
+	require.NoError(t, err)
+
+	assert.Equal(t, "access_token", token.AccessToken)
+	assert.Equal(t, int32(0), calls.Load())
+}
+
+func Test_configTokenSource_refreshesExpiredToken(t *testing.T) {
+	var calls atomic.Int32
+	u := newTokenServer(t, &calls)
+
+	cfg := stubConfig{
+		hosts: map[string]map[string]string{
+			u.Host: {
+				"client_id":            "client",
+				"is_oauth2":            "true",
+				"oauth2_refresh_token": "refresh_token",
+				"token":                "access_token",
+				"oauth2_expiry_date":   time.Now().Add(-time.Hour).Format(time.RFC822),
+			},
+		},
+	}
+
+	ts, err := NewConfigTokenSource(cfg, http.DefaultClient, u.Scheme, u.Host)
+	require.NoError(t, err)
+
+	token, err := ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "new_access_token_1", token.AccessToken)
+
+	// The refreshed token is reused for subsequent calls.
+	token, err = ts.Token()
+	require.NoError(t, err)
+	assert.Equal(t, "new_access_token_1", token.AccessToken)
+	assert.Equal(t, int32(1), calls.Load())
+
+	assert.Equal(t, "new_access_token_1", cfg.hosts[u.Host]["token"])
+	assert.Equal(t, "new_refresh_token", cfg.hosts[u.Host]["oauth2_refresh_token"])
+}
+
+func Test_Refresh_forcesRefresh(t *testing.T) {
+	var calls atomic.Int32
+	u := newTokenServer(t, &calls)
+
+	cfg := stubConfig{
+		hosts: map[string]map[string]string{
+			u.Host: {
+				"client_id":            "client",
+				"is_oauth2":            "true",
+				"oauth2_refresh_token": "refresh_token",
+				"token":                "access_token",
+				"oauth2_expiry_date":   time.Now().Add(time.Hour).Format(time.RFC822),
+			},
+		},
+	}
+
+	token, err := Refresh(t.Context(), cfg, http.DefaultClient, u.Scheme, u.Host)
+	require.NoError(t, err)
+
+	assert.Equal(t, "new_access_token_1", token.AccessToken)
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Equal(t, "new_access_token_1", cfg.hosts[u.Host]["token"])
+}
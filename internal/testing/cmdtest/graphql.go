@@ -0,0 +1,36 @@
+package cmdtest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+	gitlabtesting "gitlab.com/gitlab-org/api/client-go/testing"
+)
+
+// ExpectGraphQL expects a GraphQL query on the test client, and responds with body, the JSON
+// of the response. When check isn't nil, it's called with the query first. Expectations match
+// in the order they're declared, so a test of several queries can expect each of them in turn.
+func ExpectGraphQL(tc *gitlabtesting.TestClient, body string, check func(query gitlab.GraphQLQuery)) *gitlabtesting.MockGraphQLInterfaceDoCall {
+	return tc.MockGraphQL.EXPECT().
+		Do(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(query gitlab.GraphQLQuery, response any, options ...gitlab.RequestOptionFunc) (*gitlab.Response, error) {
+			if check != nil {
+				check(query)
+			}
+			return nil, json.Unmarshal([]byte(body), response)
+		})
+}
+
+// GraphQLVariables returns a check for ExpectGraphQL that asserts the variables of the query
+// are exactly want.
+func GraphQLVariables(t *testing.T, want map[string]any) func(query gitlab.GraphQLQuery) {
+	t.Helper()
+
+	return func(query gitlab.GraphQLQuery) {
+		assert.Equal(t, want, query.Variables)
+	}
+}
@@ -134,6 +134,33 @@ func FmtDuration(d time.Duration) string {
 	return fmt.Sprintf("%02dm %02ds", m, s)
 }
 
+// FmtTimeTracking formats seconds of estimated or spent time the way GitLab does, with
+// 8-hour days and 5-day weeks, like "1w 2d 3h 30m".
+func FmtTimeTracking(seconds int64) string {
+	units := []struct {
+		suffix  string
+		seconds int64
+	}{
+		{"w", 5 * 8 * 3600},
+		{"d", 8 * 3600},
+		{"h", 3600},
+		{"m", 60},
+	}
+
+	var parts []string
+	for _, u := range units {
+		if n := seconds / u.seconds; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", n, u.suffix))
+			seconds -= n * u.seconds
+		}
+	}
+	if len(parts) == 0 {
+		return "0h"
+	}
+
+	return strings.Join(parts, " ")
+}
+
 func Humanize(s string) string {
 	// Replaces - and _ with spaces.
 	replace := "_-"
@@ -66,6 +66,24 @@ func Test_Pluralize(t *testing.T) {
 	}
 }
 
+func Test_FmtTimeTracking(t *testing.T) {
+	testCases := []struct {
+		seconds int64
+		want    string
+	}{
+		{0, "0h"},
+		{30, "0h"},
+		{90 * 60, "1h 30m"},
+		{8 * 3600, "1d"},
+		{(40+16+3)*3600 + 30*60, "1w 2d 3h 30m"},
+	}
+	for _, tC := range testCases {
+		t.Run(tC.want, func(t *testing.T) {
+			require.Equal(t, tC.want, FmtTimeTracking(tC.seconds))
+		})
+	}
+}
+
 func Test_PresentInStringSlice(t *testing.T) {
 	testCases := []struct {
 		name   string